@@ -0,0 +1,99 @@
+package storefront
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// ErrMissingTypename indicates that an object of a union or interface type was
+// decoded without its __typename, which must be selected for the object's
+// concrete type to be determined.
+var ErrMissingTypename = errors.New("storefront: __typename must be selected on union and interface types")
+
+// abstractDecoders maps the Go interface types generated for GraphQL unions
+// and interfaces to the functions which decode them.
+var abstractDecoders = map[reflect.Type]func([]byte) (interface{}, error){}
+
+// registerAbstract registers decode as the function which decodes the
+// abstract type T.
+func registerAbstract[T any](decode func([]byte) (T, error)) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+
+	abstractDecoders[typ] = func(data []byte) (interface{}, error) {
+		return decode(data)
+	}
+}
+
+// unmarshalAbstract decodes data into v, using the decoder registered for T if
+// there is one. A null value leaves v unchanged.
+func unmarshalAbstract[T any](data []byte, v *T) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	decode, ok := abstractDecoders[reflect.TypeOf(v).Elem()]
+	if !ok {
+		return json.Unmarshal(data, v)
+	}
+
+	val, err := decode(data)
+	if err != nil {
+		return err
+	}
+
+	if val != nil {
+		*v = val.(T)
+	}
+
+	return nil
+}
+
+// unmarshalAbstractList decodes data, a JSON array, into v, decoding each of
+// its elements as unmarshalAbstract does.
+func unmarshalAbstractList[T any](data []byte, v *[]T) error {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+
+	list := make([]T, len(raws))
+	for i, raw := range raws {
+		if err := unmarshalAbstract(raw, &list[i]); err != nil {
+			return err
+		}
+	}
+
+	*v = list
+	return nil
+}
+
+// unmarshalTypename returns the __typename of the JSON object data. If the
+// object is empty, as when none of the fragments selected on it apply, it
+// returns an empty string.
+func unmarshalTypename(data []byte) (string, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return "", err
+	}
+
+	raw, ok := obj["__typename"]
+	if !ok {
+		if len(obj) == 0 {
+			return "", nil
+		}
+
+		return "", ErrMissingTypename
+	}
+
+	var typename string
+	if err := json.Unmarshal(raw, &typename); err != nil {
+		return "", err
+	}
+
+	return typename, nil
+}
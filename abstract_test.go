@@ -0,0 +1,132 @@
+package storefront
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalUnion(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Member", func(t *testing.T) {
+		var m Metafield
+
+		assert.NoError(json.Unmarshal([]byte(`{
+			"key": "related",
+			"reference": {"__typename": "Product", "title": "Example Product"},
+			"parentResource": {"__typename": "Collection", "handle": "frontpage"}
+		}`), &m))
+
+		assert.Equal("related", m.Key)
+
+		if p, ok := m.Reference.(*Product); assert.True(ok) {
+			assert.Equal("Example Product", p.Title)
+		}
+
+		if c, ok := m.ParentResource.(*Collection); assert.True(ok) {
+			assert.Equal("frontpage", c.Handle)
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		var m Metafield
+
+		assert.NoError(json.Unmarshal([]byte(`{"reference": null}`), &m))
+		assert.Nil(m.Reference)
+	})
+
+	t.Run("NoFragmentsApply", func(t *testing.T) {
+		var m Metafield
+
+		assert.NoError(json.Unmarshal([]byte(`{"reference": {}}`), &m))
+		assert.Nil(m.Reference)
+	})
+
+	t.Run("UnknownTypename", func(t *testing.T) {
+		var m Metafield
+
+		assert.NoError(json.Unmarshal([]byte(`{"reference": {"__typename": "Video", "id": "1"}}`), &m))
+		assert.Nil(m.Reference)
+	})
+
+	t.Run("MissingTypename", func(t *testing.T) {
+		var m Metafield
+
+		err := json.Unmarshal([]byte(`{"reference": {"title": "Example Product"}}`), &m)
+		assert.ErrorIs(err, ErrMissingTypename)
+	})
+}
+
+func TestUnmarshalInterface(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Nodes", func(t *testing.T) {
+		var set Set
+
+		assert.NoError(json.Unmarshal([]byte(`{"data": {
+			"node": {"__typename": "Collection", "id": "gid://shopify/Collection/1"},
+			"nodes": [
+				{"__typename": "Product", "id": "gid://shopify/Product/1", "title": "Example Product"},
+				null,
+				{"__typename": "Page", "id": "gid://shopify/Page/1", "title": "About"}
+			]
+		}}`), &set))
+
+		assert.IsType(&Collection{}, set.Data.Node)
+
+		if assert.Len(set.Data.Nodes, 3) {
+			assert.Equal("Example Product", set.Data.Nodes[0].(*Product).Title)
+			assert.Nil(set.Data.Nodes[1])
+			assert.Equal("About", set.Data.Nodes[2].(*Page).Title)
+		}
+	})
+
+	t.Run("SharedFields", func(t *testing.T) {
+		var set Set
+
+		assert.NoError(json.Unmarshal([]byte(`{"data": {
+			"node": {"__typename": "Collection", "id": "gid://shopify/Collection/1"},
+			"product": {"media": {"edges": [
+				{"node": {"__typename": "Video", "alt": "Trailer", "mediaContentType": "VIDEO"}}
+			]}}
+		}}`), &set))
+
+		if assert.NotNil(set.Data.Node) {
+			assert.Equal("gid://shopify/Collection/1", set.Data.Node.GetId())
+		}
+
+		if assert.NotNil(set.Data.Product) && assert.Len(set.Data.Product.Media.Edges, 1) {
+			media := set.Data.Product.Media.Edges[0].Node
+			if assert.NotNil(media.GetAlt()) {
+				assert.Equal("Trailer", *media.GetAlt())
+			}
+
+			assert.Equal(MediaContentTypeVideo, media.GetMediaContentType())
+		}
+	})
+
+	t.Run("MissingTypename", func(t *testing.T) {
+		var set Set
+
+		err := json.Unmarshal([]byte(`{"data": {"node": {"id": "gid://shopify/Collection/1"}}}`), &set)
+		assert.ErrorIs(err, ErrMissingTypename)
+	})
+
+	t.Run("Connection", func(t *testing.T) {
+		var p Product
+
+		assert.NoError(json.Unmarshal([]byte(`{"media": {"edges": [
+			{"cursor": "a", "node": {"__typename": "Video", "sources": [{"url": "https://example.com/a.mp4"}]}},
+			{"cursor": "b", "node": {"__typename": "ExternalVideo", "embeddedUrl": "https://youtube.com/embed/a"}}
+		]}}`), &p))
+
+		edges := p.Media.Edges
+		if assert.Len(edges, 2) {
+			assert.Equal("a", edges[0].Cursor)
+			assert.Equal("https://example.com/a.mp4", edges[0].Node.(*Video).Sources[0].URL)
+			assert.Equal("https://youtube.com/embed/a", edges[1].Node.(*ExternalVideo).EmbeddedURL)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package storefront
+
+import (
+	"fmt"
+	"strings"
+)
+
+// queryBuilder is an operation under construction by the selection types
+// generated for each composite type, such as ProductSelection, recording the
+// variables through which arguments are passed.
+type queryBuilder struct {
+	// typ is "query" or "mutation".
+	typ    string
+	root   *selection
+	params []string
+	vars   map[string]interface{}
+}
+
+// selection is a selection set under construction.
+type selection struct {
+	op     *queryBuilder
+	fields []*selectedField
+}
+
+// selectedField is a field, or an inline fragment, selected within a
+// selection set.
+type selectedField struct {
+	op *queryBuilder
+	// head is the selection without its arguments or selection set, such as
+	// "title" or "... on Product".
+	head string
+	args []string
+	// sel is the selection set of a field of a composite type, or of a
+	// fragment, and nil for a field of a scalar or enum type.
+	sel *selection
+}
+
+// newQueryBuilder returns the root selection set of a new operation of the
+// type typ.
+func newQueryBuilder(typ string) *selection {
+	op := &queryBuilder{typ: typ, vars: map[string]interface{}{}}
+	op.root = &selection{op: op}
+
+	return op.root
+}
+
+// field selects the field named name, returning it so that arguments can be
+// given.
+func (s *selection) field(name string) *selectedField {
+	f := &selectedField{op: s.op, head: name}
+	s.fields = append(s.fields, f)
+
+	return f
+}
+
+// fragment selects an inline fragment on the type typeCondition, returning
+// its selection set. __typename is also selected, as it's required to decode
+// values of abstract types.
+func (s *selection) fragment(typeCondition string) *selection {
+	hasTypename := false
+	for _, f := range s.fields {
+		if f.head == "__typename" {
+			hasTypename = true
+		}
+	}
+
+	if !hasTypename {
+		s.field("__typename")
+	}
+
+	f := s.field("... on " + typeCondition)
+	return f.selection()
+}
+
+// arg passes value as the argument name of f, by way of a variable of the
+// GraphQL type typ.
+func (f *selectedField) arg(name, typ string, value interface{}) {
+	// Variables are named after the argument, numbered if the name's taken.
+	v := name
+	for i := 2; ; i++ {
+		if _, ok := f.op.vars[v]; !ok {
+			break
+		}
+
+		v = fmt.Sprintf("%s%d", name, i)
+	}
+
+	f.op.vars[v] = value
+	f.op.params = append(f.op.params, fmt.Sprintf("$%s: %s", v, typ))
+	f.args = append(f.args, fmt.Sprintf("%s: $%s", name, v))
+}
+
+// selection returns the selection set of f, creating it if necessary.
+func (f *selectedField) selection() *selection {
+	if f.sel == nil {
+		f.sel = &selection{op: f.op}
+	}
+
+	return f.sel
+}
+
+// write writes the selection set s to b. An empty selection set selects just
+// __typename, so that the document remains valid.
+func (s *selection) write(b *strings.Builder) {
+	b.WriteString("{")
+
+	if len(s.fields) == 0 {
+		b.WriteString(" __typename")
+	}
+
+	for _, f := range s.fields {
+		b.WriteString(" " + f.head)
+
+		if len(f.args) != 0 {
+			b.WriteString("(" + strings.Join(f.args, ", ") + ")")
+		}
+
+		if f.sel != nil {
+			b.WriteString(" ")
+			f.sel.write(b)
+		}
+	}
+
+	b.WriteString(" }")
+}
+
+// build returns the document of the operation s belongs to, and the values
+// of the variables it uses.
+func (s *selection) build() (string, map[string]interface{}) {
+	var b strings.Builder
+
+	b.WriteString(s.op.typ)
+	if len(s.op.params) != 0 {
+		b.WriteString("(" + strings.Join(s.op.params, ", ") + ")")
+	}
+
+	b.WriteString(" ")
+	s.op.root.write(&b)
+
+	vars := make(map[string]interface{}, len(s.op.vars))
+	for k, v := range s.op.vars {
+		vars[k] = v
+	}
+
+	return b.String(), vars
+}
+
+// request returns a Request executing the operation s belongs to.
+func (s *selection) request() *Request {
+	q, vars := s.build()
+	if len(vars) == 0 {
+		vars = nil
+	}
+
+	return &Request{Query: q, Variables: vars}
+}
@@ -0,0 +1,95 @@
+package storefront
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Arguments", func(t *testing.T) {
+		q, vars := NewQuery().
+			Products(QueryRootProductsArgs{First: Ptr(10), SortKey: Ptr(ProductSortKeysBestSelling)}, func(p *ProductConnectionSelection) {
+				p.Edges(func(e *ProductEdgeSelection) {
+					e.Cursor().Node(func(p *ProductSelection) {
+						p.Title().Handle()
+						p.Variants(ProductVariantsArgs{First: Ptr(5)}, func(v *ProductVariantConnectionSelection) {
+							v.Edges(func(e *ProductVariantEdgeSelection) {
+								e.Node(func(v *ProductVariantSelection) { v.SKU() })
+							})
+						})
+					})
+				})
+			}).
+			Collections(QueryRootCollectionsArgs{First: Ptr(1)}, nil).
+			Build()
+
+		assert.Equal(`query($first: Int, $sortKey: ProductSortKeys, $first2: Int, $first3: Int) {`+
+			` products(first: $first, sortKey: $sortKey) { edges { cursor node { title handle variants(first: $first2) { edges { node { sku } } } } } }`+
+			` collections(first: $first3) { __typename } }`, q)
+		assert.Equal(map[string]interface{}{"first": 10, "sortKey": ProductSortKeysBestSelling, "first2": 5, "first3": 1}, vars)
+		assert.NoError(Validate(q))
+	})
+
+	t.Run("Fragments", func(t *testing.T) {
+		q, vars := NewQuery().
+			Node(QueryRootNodeArgs{Id: "gid://shopify/Product/1"}, func(n *NodeSelection) {
+				n.Id().
+					OnProduct(func(p *ProductSelection) { p.Title() }).
+					OnCollection(func(c *CollectionSelection) { c.Handle() })
+			}).
+			Build()
+
+		assert.Equal(`query($id: ID!) { node(id: $id) { id __typename ... on Product { title } ... on Collection { handle } } }`, q)
+		assert.Equal(map[string]interface{}{"id": "gid://shopify/Product/1"}, vars)
+		assert.NoError(Validate(q))
+	})
+
+	t.Run("Mutation", func(t *testing.T) {
+		q, vars := NewMutation().
+			CartCreate(MutationCartCreateArgs{Input: &CartInput{Note: Some("Gift")}}, func(p *CartCreatePayloadSelection) {
+				p.Cart(func(c *CartSelection) { c.Id() }).
+					UserErrors(func(e *CartUserErrorSelection) { e.Field().Message() })
+			}).
+			Build()
+
+		assert.Equal(`mutation($input: CartInput) { cartCreate(input: $input) { cart { id } userErrors { field message } } }`, q)
+		assert.Equal(map[string]interface{}{"input": CartInput{Note: Some("Gift")}}, vars)
+		assert.NoError(Validate(q))
+	})
+}
+
+func TestQueryRootSelection_Request(t *testing.T) {
+	assert := assert.New(t)
+
+	var req Request
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Write([]byte(`{"data": {"productByHandle": {"title": "Hat"}}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("example.myshopify.com", "token")
+	c.endpoint = srv.URL
+
+	var res struct {
+		Data struct {
+			ProductByHandle *Product `json:"productByHandle"`
+		} `json:"data"`
+	}
+
+	q := NewQuery().ProductByHandle(QueryRootProductByHandleArgs{Handle: "hat"}, func(p *ProductSelection) { p.Title() })
+
+	if assert.NoError(c.Do(q.Request(), &res)) && assert.NotNil(res.Data.ProductByHandle) {
+		assert.Equal("Hat", res.Data.ProductByHandle.Title)
+	}
+
+	assert.Equal(`query($handle: String!) { productByHandle(handle: $handle) { title } }`, req.Query)
+	assert.Equal(map[string]interface{}{"handle": "hat"}, req.Variables)
+}
@@ -0,0 +1,51 @@
+package storefront
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+// buyerIPKey is the context key under which the buyer's IP address is stored.
+type buyerIPKey struct{}
+
+// ContextWithBuyerIP returns a copy of ctx carrying the IP address of the buyer
+// on whose behalf requests are made. Requests made with the returned context
+// send it in the Shopify-Storefront-Buyer-IP header, so that the API
+// attributes them, and applies its rate limits, to the buyer rather than to
+// the server making them.
+//
+// Shopify only honors the header for requests authenticated with a private
+// access token (see WithPrivateToken).
+func ContextWithBuyerIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, buyerIPKey{}, ip)
+}
+
+// BuyerIPFromContext returns the buyer's IP address carried by ctx, if any.
+func BuyerIPFromContext(ctx context.Context) (string, bool) {
+	ip, ok := ctx.Value(buyerIPKey{}).(string)
+	return ip, ok && ip != ""
+}
+
+// BuyerIP returns the IP address of the client which made r, as given by its
+// RemoteAddr. If the server sits behind a proxy or load balancer, RemoteAddr
+// is the proxy's address; in that case, determine the buyer's address from
+// the headers the proxy sets (which only it can be trusted to set) instead.
+func BuyerIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// BuyerIPHandler wraps next such that each request's context carries the IP
+// address of the client which made it, as returned by BuyerIP. Storefront
+// requests made with that context then forward it to the API.
+func BuyerIPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := ContextWithBuyerIP(r.Context(), BuyerIP(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
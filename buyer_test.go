@@ -0,0 +1,72 @@
+package storefront
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuyerIP(t *testing.T) {
+	assert := assert.New(t)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	r.RemoteAddr = "203.0.113.7:52144"
+	assert.Equal("203.0.113.7", BuyerIP(r))
+
+	r.RemoteAddr = "[2001:db8::1]:52144"
+	assert.Equal("2001:db8::1", BuyerIP(r))
+}
+
+func TestBuyerIPHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	var got string
+
+	h := BuyerIPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = BuyerIPFromContext(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "203.0.113.7:52144"
+
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal("203.0.113.7", got)
+}
+
+func TestClient_Do_PrivateToken(t *testing.T) {
+	assert := assert.New(t)
+
+	var header http.Header
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		fmt.Fprint(w, `{"data":{"shop":{"name":"Shop name"}}}`)
+	}))
+	defer srv.Close()
+
+	c, err := New("DOMAIN", "", WithPrivateToken("PRIVATE_TOKEN"), WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var set Set
+
+	t.Run("WithoutBuyerIP", func(t *testing.T) {
+		assert.NoError(c.Query("{ shop { name } }", &set))
+		assert.Equal("PRIVATE_TOKEN", header.Get("Shopify-Storefront-Private-Token"))
+		assert.NotContains(header, "X-Shopify-Storefront-Access-Token")
+		assert.NotContains(header, "Shopify-Storefront-Buyer-Ip")
+	})
+
+	t.Run("WithBuyerIP", func(t *testing.T) {
+		ctx := ContextWithBuyerIP(context.Background(), "203.0.113.7")
+
+		assert.NoError(c.QueryContext(ctx, "{ shop { name } }", &set))
+		assert.Equal("203.0.113.7", header.Get("Shopify-Storefront-Buyer-IP"))
+	})
+}
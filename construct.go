@@ -0,0 +1,663 @@
+package storefront
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/boatilus/storefront-go/internal/graphql"
+)
+
+// ErrInvalidSelection indicates that a value given to ConstructQuery or
+// QueryStruct can't describe a selection, as it isn't a struct, includes a
+// field of an interface type other than those generated for the schema's
+// unions and interfaces, or includes itself.
+var ErrInvalidSelection = errors.New("storefront: invalid selection")
+
+// pkgPath is the import path of this package. Its own types may describe
+// fields of later API versions than the embedded schema, which aren't
+// selected.
+var pkgPath = reflect.TypeOf(Client{}).PkgPath()
+
+var (
+	jsonUnmarshaler = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	rawMessage      = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// selectionField describes how a field of a struct is selected.
+type selectionField struct {
+	// head is the selection of the field without its selection set, such as
+	// "products(first: $first)" or "... on Product". It's empty for embedded
+	// structs without a graphql tag, whose fields are selected as if they
+	// were the enclosing struct's own.
+	head string
+	// name is the name of the field in the schema, and responseName its name
+	// in the response, which differs if it's aliased. Both are empty for
+	// fragments.
+	name         string
+	responseName string
+	// typeCondition is the type condition of a fragment.
+	typeCondition string
+	// explicit is whether the selection is given by a graphql tag.
+	explicit bool
+}
+
+// parseSelectionField returns how the struct field f is selected, or false if
+// it isn't.
+func parseSelectionField(f reflect.StructField) (selectionField, bool) {
+	if !f.IsExported() {
+		return selectionField{}, false
+	}
+
+	tag, explicit := f.Tag.Lookup("graphql")
+	tag = strings.TrimSpace(tag)
+
+	switch {
+	case tag == "-":
+		return selectionField{}, false
+	case !explicit && f.Anonymous && indirect(f.Type).Kind() == reflect.Struct:
+		return selectionField{}, true
+	case !explicit:
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			return selectionField{}, false
+		case "":
+			name = lowerCamel(f.Name)
+		}
+
+		return selectionField{head: name, name: name, responseName: name}, true
+	case strings.HasPrefix(tag, "..."):
+		// The type condition is the name following "on", before any
+		// directives.
+		cond := strings.TrimSpace(strings.TrimPrefix(tag, "..."))
+		cond = strings.TrimSpace(strings.TrimPrefix(cond, "on"))
+		if i := strings.IndexAny(cond, " @"); i >= 0 {
+			cond = cond[:i]
+		}
+
+		return selectionField{head: tag, typeCondition: cond, explicit: true}, true
+	}
+
+	// The name ends at any arguments or directives, and may be preceded by an
+	// alias.
+	name := tag
+	if i := strings.IndexAny(name, "(@"); i >= 0 {
+		name = name[:i]
+	}
+
+	name = strings.TrimSpace(name)
+	responseName := name
+
+	if alias, n, ok := strings.Cut(name, ":"); ok {
+		responseName, name = strings.TrimSpace(alias), strings.TrimSpace(n)
+	}
+
+	return selectionField{head: tag, name: name, responseName: responseName, explicit: true}, true
+}
+
+// lowerCamel returns the name of a Go identifier in lower camel case, as
+// "ID" becomes "id" and "URLValue" becomes "urlValue".
+func lowerCamel(s string) string {
+	r := []rune(s)
+	for i := 0; i < len(r) && unicode.IsUpper(r[i]); i++ {
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+
+		r[i] = unicode.ToLower(r[i])
+	}
+
+	return string(r)
+}
+
+// indirect returns the type t points to, or t if it isn't a pointer.
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+// elemType returns the type of the values a field of type t holds, stripped of
+// pointers, slices and arrays.
+func elemType(t reflect.Type) reflect.Type {
+	for {
+		switch t.Kind() {
+		case reflect.Slice, reflect.Array:
+			if isLeaf(t) {
+				return t
+			}
+
+			t = t.Elem()
+		case reflect.Ptr:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
+
+// isLeaf reports whether values of the type t are decoded from scalars, or
+// are otherwise opaque, and so have no selection set. Structs are leaves only
+// if they decode themselves and have no exported fields, like Decimal and
+// time.Time.
+func isLeaf(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Struct:
+		p := reflect.PointerTo(t)
+		if !p.Implements(jsonUnmarshaler) && !p.Implements(textUnmarshaler) {
+			return false
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Ptr, reflect.Interface:
+		return false
+	case reflect.Slice, reflect.Array:
+		return t == rawMessage || t.Elem().Kind() == reflect.Uint8
+	}
+
+	return true
+}
+
+// selectionBuilder builds the selection set described by a struct.
+type selectionBuilder struct {
+	schema   *graphql.Schema
+	b        strings.Builder
+	visiting map[reflect.Type]bool
+}
+
+// selectionSet writes the selection set of the struct type t, which describes
+// the schema type parent, if known.
+func (sb *selectionBuilder) selectionSet(t reflect.Type, parent *graphql.Type) error {
+	sb.b.WriteString("{")
+	if err := sb.fields(t, parent, nil); err != nil {
+		return err
+	}
+
+	sb.b.WriteString(" }")
+	return nil
+}
+
+// fields writes the selections of the fields of the struct type t. Fields
+// without a graphql tag are given the aliases their names map to in aliases.
+func (sb *selectionBuilder) fields(t reflect.Type, parent *graphql.Type, aliases map[string]string) error {
+	if sb.visiting[t] {
+		return fmt.Errorf("%w: %s selects itself", ErrInvalidSelection, t)
+	}
+
+	sb.visiting[t] = true
+	defer delete(sb.visiting, t)
+
+	// Fragments require __typename to tell which of them apply when decoding.
+	var hasFragments bool
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		sf, ok := parseSelectionField(f)
+		if !ok {
+			continue
+		}
+
+		ft := elemType(f.Type)
+
+		switch {
+		case sf.head == "":
+			if err := sb.fields(ft, parent, aliases); err != nil {
+				return err
+			}
+
+			continue
+		case sf.typeCondition != "":
+			if ft.Kind() != reflect.Struct {
+				return fmt.Errorf("%w: fragment %s.%s isn't a struct", ErrInvalidSelection, t, f.Name)
+			}
+
+			hasFragments = true
+
+			sb.b.WriteString(" " + sf.head + " ")
+			if err := sb.selectionSet(ft, sb.schema.Type(sf.typeCondition)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		var def *graphql.Field
+		if parent != nil && sf.name != "__typename" {
+			def = sb.schema.FieldDef(parent, sf.name)
+
+			// Leave out the fields of this package's types which the schema
+			// doesn't have, as they're from later API versions, along with
+			// those which can't be selected without arguments. Any other
+			// unknown fields are left to validation to report.
+			if !sf.explicit && t.PkgPath() == pkgPath && (def == nil || needsArguments(def)) {
+				continue
+			}
+		}
+
+		var child *graphql.Type
+		if def != nil {
+			child = sb.schema.Type(def.Type.Named().Name)
+		}
+
+		if alias, ok := aliases[sf.name]; ok && !sf.explicit {
+			sb.b.WriteString(" " + alias + ":")
+		}
+
+		sb.b.WriteString(" " + sf.head)
+
+		switch {
+		case ft.Kind() == reflect.Interface:
+			if _, ok := abstractDecoders[ft]; !ok || child == nil {
+				return fmt.Errorf("%w: %s.%s is an interface; select its types with fragments instead", ErrInvalidSelection, t, f.Name)
+			}
+
+			if err := sb.abstractSelectionSet(ft, child); err != nil {
+				return err
+			}
+
+			continue
+		case ft.Kind() != reflect.Struct || isLeaf(ft):
+			continue
+		}
+
+		sb.b.WriteString(" ")
+		if err := sb.selectionSet(ft, child); err != nil {
+			return err
+		}
+	}
+
+	if hasFragments {
+		sb.b.WriteString(" __typename")
+	}
+
+	return nil
+}
+
+// abstractSelectionSet writes the selection set of a field of the interface
+// type t, generated for the union or interface parent: __typename, and an
+// inline fragment on each possible type of parent selecting the fields of the
+// type generated for it. Fields of the same name but different types in more
+// than one possible type can't be merged, so they're given aliases, which
+// decodeSelection undoes.
+func (sb *selectionBuilder) abstractSelectionSet(t reflect.Type, parent *graphql.Type) error {
+	types := map[string]string{}
+	conflicting := map[string]bool{}
+
+	for _, m := range parent.PossibleTypes {
+		if mt := sb.schema.Type(m.Name); mt != nil {
+			for _, f := range mt.Fields {
+				if typ, ok := types[f.Name]; ok && typ != f.Type.String() {
+					conflicting[f.Name] = true
+				}
+
+				types[f.Name] = f.Type.String()
+			}
+		}
+	}
+
+	sb.b.WriteString(" { __typename")
+
+	for _, m := range parent.PossibleTypes {
+		mt := memberType(t, m.Name)
+		if mt == nil || sb.visiting[mt] {
+			continue
+		}
+
+		aliases := map[string]string{}
+		for name := range conflicting {
+			aliases[name] = memberAlias(m.Name, name)
+		}
+
+		sb.b.WriteString(" ... on " + m.Name + " {")
+		if err := sb.fields(mt, sb.schema.Type(m.Name), aliases); err != nil {
+			return err
+		}
+
+		sb.b.WriteString(" }")
+	}
+
+	sb.b.WriteString(" }")
+	return nil
+}
+
+// memberAlias returns the alias of the field name of the possible type
+// typename of an abstract type, where it conflicts with that of another.
+func memberAlias(typename, name string) string {
+	return typename + "__" + name
+}
+
+// memberType returns the struct type the decoder registered for the abstract
+// type t decodes objects of the type typename into, or nil if there isn't one.
+func memberType(t reflect.Type, typename string) reflect.Type {
+	decode, ok := abstractDecoders[t]
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(map[string]string{"__typename": typename})
+	if err != nil {
+		return nil
+	}
+
+	v, err := decode(data)
+	if err != nil || v == nil {
+		return nil
+	}
+
+	return indirect(reflect.TypeOf(v))
+}
+
+// needsArguments reports whether the field f can't be selected without
+// arguments, as it requires some or is paginated, for which the API requires
+// first or last.
+func needsArguments(f *graphql.Field) bool {
+	for _, a := range f.Args {
+		if (a.Type.Kind == "NON_NULL" && a.DefaultValue == nil) || a.Name == "first" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConstructQuery returns a query selecting the fields of v, a struct or a
+// pointer to one, which can then decode the response's data.
+//
+// Each exported field selects the field of the schema named by its json tag,
+// such as those of the types generated in this package, or else its name in
+// lower camel case. A graphql tag gives the selection explicitly, including
+// any alias, arguments and directives, such as
+// `graphql:"first: products(first: $first, query: $q)"`. A tag of the form
+// `graphql:"... on Product"` makes the field an inline fragment, which should
+// be a pointer if it may not apply. Untagged embedded structs are selected as
+// if their fields were the enclosing struct's own, and fields tagged
+// `graphql:"-"` are skipped. Structs such as Connection[T] are selected
+// recursively. Fields of the interface types generated for unions and
+// interfaces, such as Node, select each of their possible types with inline
+// fragments. Fields of this package's types which require arguments, or are
+// paginated, are left out unless tagged.
+//
+// The variables the query uses are defined with the types the schema expects
+// of them, and the query is validated against the schema.
+func ConstructQuery(v interface{}) (string, error) {
+	s, err := loadSchema()
+	if err != nil {
+		return "", err
+	}
+
+	t := reflect.TypeOf(v)
+	if t == nil || indirect(t).Kind() != reflect.Struct {
+		return "", fmt.Errorf("%w: %T isn't a struct", ErrInvalidSelection, v)
+	}
+
+	sb := &selectionBuilder{schema: s, visiting: map[reflect.Type]bool{}}
+	if err := sb.selectionSet(indirect(t), s.Type(s.QueryType.Name)); err != nil {
+		return "", err
+	}
+
+	query := sb.b.String()
+
+	doc, err := parseQuery(query)
+	if err != nil {
+		return "", err
+	}
+
+	if defs := graphql.InferVariables(s, doc, doc.Operations[0]); len(defs) != 0 {
+		params := make([]string, len(defs))
+		for i, def := range defs {
+			params[i] = fmt.Sprintf("$%s: %s", def.Name, def.Type)
+		}
+
+		query = "query(" + strings.Join(params, ", ") + ") " + query
+
+		if doc, err = parseQuery(query); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := validateDocument(s, doc); err != nil {
+		return "", err
+	}
+
+	return query, nil
+}
+
+// QueryStruct executes the query ConstructQuery constructs from v, a pointer
+// to a struct, supplying vars as the values of its variables, and decodes the
+// response's data into v. As with Do, errors in the response are returned as
+// GraphQLErrors after decoding any partial data.
+func (c *Client) QueryStruct(v interface{}, vars map[string]interface{}) error {
+	return c.QueryStructContext(context.Background(), v, vars)
+}
+
+// QueryStructContext is as QueryStruct, bound to the lifetime of ctx.
+func (c *Client) QueryStructContext(ctx context.Context, v interface{}, vars map[string]interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("%w: %T isn't a non-nil pointer", ErrInvalidSelection, v)
+	}
+
+	q, err := ConstructQuery(v)
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	err = c.DoContext(ctx, &Request{Query: q, Variables: vars}, &res)
+
+	if len(res.Data) != 0 {
+		if err := decodeSelection(res.Data, rv.Elem()); err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+// decodeSelection decodes data, the response to the selection described by
+// the type of v, into v. Fields are matched by their names in the response,
+// which may be aliases, and fragments are decoded from the object enclosing
+// them.
+func decodeSelection(data []byte, v reflect.Value) error {
+	if string(data) == "null" {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	switch t := v.Type(); {
+	case t.Kind() == reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+
+		return decodeSelection(data, v.Elem())
+	case t.Kind() == reflect.Interface:
+		decode, ok := abstractDecoders[t]
+		if !ok {
+			return json.Unmarshal(data, v.Addr().Interface())
+		}
+
+		data, err := unaliasMember(data)
+		if err != nil {
+			return err
+		}
+
+		val, err := decode(data)
+		if err != nil {
+			return err
+		}
+
+		if val == nil {
+			v.Set(reflect.Zero(t))
+		} else {
+			v.Set(reflect.ValueOf(val))
+		}
+
+		return nil
+	case isLeaf(t):
+		return json.Unmarshal(data, v.Addr().Interface())
+	case t.Kind() == reflect.Slice:
+		var raws []json.RawMessage
+		if err := json.Unmarshal(data, &raws); err != nil {
+			return err
+		}
+
+		list := reflect.MakeSlice(t, len(raws), len(raws))
+		for i, raw := range raws {
+			if err := decodeSelection(raw, list.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		v.Set(list)
+		return nil
+	case t.Kind() == reflect.Array:
+		var raws []json.RawMessage
+		if err := json.Unmarshal(data, &raws); err != nil {
+			return err
+		}
+
+		for i := 0; i < len(raws) && i < v.Len(); i++ {
+			if err := decodeSelection(raws[i], v.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	return decodeFields(obj, v)
+}
+
+// unaliasMember returns data, an object of an abstract type, with the fields
+// aliased by abstractSelectionSet renamed to their names.
+func unaliasMember(data []byte) ([]byte, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil || typename == "" {
+		return data, err
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	prefix := memberAlias(typename, "")
+	for key, raw := range obj {
+		if name := strings.TrimPrefix(key, prefix); name != key {
+			obj[name] = raw
+			delete(obj, key)
+		}
+	}
+
+	return json.Marshal(obj)
+}
+
+// decodeFields decodes the fields of the struct v from obj, a response
+// object.
+func decodeFields(obj map[string]json.RawMessage, v reflect.Value) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		sf, ok := parseSelectionField(t.Field(i))
+		if !ok {
+			continue
+		}
+
+		fv := v.Field(i)
+
+		if sf.head != "" && sf.typeCondition == "" {
+			raw, ok := obj[sf.responseName]
+			if !ok {
+				continue
+			}
+
+			if err := decodeSelection(raw, fv); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// Embedded structs and fragments are decoded from obj itself. A
+		// fragment which doesn't apply to the object is left nil, if it's a
+		// pointer.
+		if fv.Kind() == reflect.Ptr {
+			if sf.typeCondition != "" && !fragmentApplies(obj, sf.typeCondition) {
+				fv.Set(reflect.Zero(fv.Type()))
+				continue
+			}
+
+			for fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					fv.Set(reflect.New(fv.Type().Elem()))
+				}
+
+				fv = fv.Elem()
+			}
+		}
+
+		if err := decodeFields(obj, fv); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fragmentApplies reports whether a fragment on the type typeCondition
+// applies to obj, according to its __typename.
+func fragmentApplies(obj map[string]json.RawMessage, typeCondition string) bool {
+	raw, ok := obj["__typename"]
+	if !ok {
+		return false
+	}
+
+	var typename string
+	if err := json.Unmarshal(raw, &typename); err != nil {
+		return false
+	}
+
+	if typename == typeCondition {
+		return true
+	}
+
+	s, err := loadSchema()
+	if err != nil {
+		return false
+	}
+
+	if t := s.Type(typeCondition); t != nil {
+		for _, pt := range t.PossibleTypes {
+			if pt.Name == typename {
+				return true
+			}
+		}
+	}
+
+	return false
+}
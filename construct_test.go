@@ -0,0 +1,220 @@
+package storefront
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testSelection exercises each of the ways a struct can describe a
+// selection.
+type testSelection struct {
+	Shop struct {
+		Name          string
+		PrimaryDomain Domain
+	}
+	Products Connection[struct {
+		Title      string
+		Handle     string
+		PriceRange struct {
+			MinVariantPrice MoneyV2
+		}
+	}] `graphql:"products(first: $first, query: $q)"`
+	Frontpage *struct {
+		Title string
+	} `graphql:"frontpage: collectionByHandle(handle: \"frontpage\")"`
+	Node *struct {
+		ID         string
+		Product    *struct{ Title string }  `graphql:"... on Product"`
+		Collection *struct{ Handle string } `graphql:"... on Collection"`
+	} `graphql:"node(id: $id)"`
+	Ignored string `graphql:"-"`
+}
+
+func TestConstructQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Selection", func(t *testing.T) {
+		q, err := ConstructQuery(&testSelection{})
+		assert.NoError(err)
+		assert.Equal(`query($first: Int, $q: String, $id: ID!) {`+
+			` shop { name primaryDomain { host sslEnabled url } }`+
+			` products(first: $first, query: $q) { edges { cursor node { title handle priceRange { minVariantPrice { amount currencyCode } } } } pageInfo { hasNextPage hasPreviousPage } }`+
+			` frontpage: collectionByHandle(handle: "frontpage") { title }`+
+			` node(id: $id) { id ... on Product { title } ... on Collection { handle } __typename } }`, q)
+	})
+
+	t.Run("Embedded", func(t *testing.T) {
+		type Names struct {
+			Name string
+		}
+
+		var q struct {
+			Shop struct {
+				Names
+				Description *string
+			}
+		}
+
+		query, err := ConstructQuery(q)
+		assert.NoError(err)
+		assert.Equal(`{ shop { name description } }`, query)
+	})
+
+	t.Run("Generated", func(t *testing.T) {
+		for _, v := range []interface{}{
+			struct{ Shop Shop }{},
+			struct {
+				Product *Product `graphql:"product(handle: $h)"`
+			}{},
+			struct {
+				Cart *Cart `graphql:"cart(id: $id)"`
+			}{},
+			struct {
+				Collection *struct {
+					Products Connection[Product] `graphql:"products(first: $first)"`
+				} `graphql:"collection(handle: $h)"`
+			}{},
+			struct {
+				Product *struct {
+					Variants Connection[ProductVariant] `graphql:"variants(first: $first)"`
+				} `graphql:"product(handle: $h)"`
+			}{},
+		} {
+			_, err := ConstructQuery(v)
+			assert.NoError(err, "%T", v)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var unknown struct {
+			Shop struct{ Nme string }
+		}
+
+		_, err := ConstructQuery(&unknown)
+		assert.True(errors.Is(err, ErrInvalidQuery))
+		assert.EqualError(err, `storefront: 1:10: Cannot query field "nme" on type "Shop".`)
+
+		var iface struct {
+			Node interface{} `graphql:"node(id: $id)"`
+		}
+
+		_, err = ConstructQuery(&iface)
+		assert.True(errors.Is(err, ErrInvalidSelection))
+
+		type cyclic struct {
+			Node *cyclic `graphql:"node(id: $id)"`
+		}
+
+		_, err = ConstructQuery(cyclic{})
+		assert.True(errors.Is(err, ErrInvalidSelection))
+
+		_, err = ConstructQuery("shop")
+		assert.True(errors.Is(err, ErrInvalidSelection))
+	})
+}
+
+func TestClient_QueryStruct_Generated(t *testing.T) {
+	assert := assert.New(t)
+
+	var req Request
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Write([]byte(`{"data": {
+			"product": {"title": "Hat", "handle": "hat", "priceRange": {"minVariantPrice": {"amount": "9.50", "currencyCode": "USD"}}},
+			"node": {"__typename": "Page", "title": "About", "Page__body": "<p>Hi</p>"}
+		}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("example.myshopify.com", "token")
+	c.endpoint = srv.URL
+
+	var q struct {
+		Product *Product `graphql:"product(handle: $h)"`
+		Node    Node     `graphql:"node(id: $id)"`
+	}
+
+	if !assert.NoError(c.QueryStruct(&q, map[string]interface{}{"h": "hat", "id": "gid://shopify/Page/1"})) {
+		return
+	}
+
+	assert.Contains(req.Query, "product(handle: $h) { availableForSale")
+	// Fields of the possible types of Node whose types differ between them are
+	// aliased, and those requiring arguments are left out.
+	assert.Contains(req.Query, "... on Page { Page__body: body")
+	assert.NotContains(req.Query, "metafield")
+
+	if assert.NotNil(q.Product) {
+		assert.Equal("Hat", q.Product.Title)
+		assert.Equal("9.50", q.Product.PriceRange.MinVariantPrice.Amount.String())
+	}
+
+	if p, ok := q.Node.(*Page); assert.True(ok) {
+		assert.Equal("About", p.Title)
+		assert.Equal("<p>Hi</p>", p.Body)
+	}
+}
+
+func TestClient_QueryStruct(t *testing.T) {
+	assert := assert.New(t)
+
+	var req Request
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Write([]byte(`{"data": {
+			"shop": {"name": "Shop", "primaryDomain": {"host": "example.com", "sslEnabled": true, "url": "https://example.com"}},
+			"products": {
+				"edges": [{"cursor": "c1", "node": {"title": "Hat", "handle": "hat", "priceRange": {"minVariantPrice": {"amount": "9.50", "currencyCode": "USD"}}}}],
+				"pageInfo": {"hasNextPage": true, "hasPreviousPage": false}
+			},
+			"frontpage": null,
+			"node": {"id": "gid://shopify/Product/1", "title": "Hat", "__typename": "Product"}
+		}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("example.myshopify.com", "token")
+	c.endpoint = srv.URL
+
+	var q testSelection
+	q.Frontpage = &struct{ Title string }{"Stale"}
+
+	if !assert.NoError(c.QueryStruct(&q, map[string]interface{}{"first": 1, "id": "gid://shopify/Product/1"})) {
+		return
+	}
+
+	assert.Contains(req.Query, "products(first: $first, query: $q)")
+	assert.Equal(map[string]interface{}{"first": float64(1), "id": "gid://shopify/Product/1"}, req.Variables)
+
+	assert.Equal("Shop", q.Shop.Name)
+	assert.True(q.Shop.PrimaryDomain.SSLEnabled)
+
+	if assert.Len(q.Products.Edges, 1) {
+		node := q.Products.Nodes()[0]
+		assert.Equal("Hat", node.Title)
+		assert.Equal("9.50", node.PriceRange.MinVariantPrice.Amount.String())
+		assert.Equal(CurrencyCodeUsd, node.PriceRange.MinVariantPrice.CurrencyCode)
+		assert.Equal("c1", q.Products.LastCursor())
+	}
+
+	assert.True(q.Products.HasNext())
+	assert.Nil(q.Frontpage)
+
+	if assert.NotNil(q.Node) {
+		assert.Equal("gid://shopify/Product/1", q.Node.ID)
+		if assert.NotNil(q.Node.Product) {
+			assert.Equal("Hat", q.Node.Product.Title)
+		}
+
+		assert.Nil(q.Node.Collection)
+	}
+}
@@ -0,0 +1,290 @@
+package storefront
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidDecimal indicates that a string couldn't be parsed as a Decimal.
+var ErrInvalidDecimal = errors.New("storefront: invalid decimal")
+
+// maxDecimalExponent bounds the magnitude of the exponents, and of the scales,
+// of the decimals ParseDecimal accepts. Amounts never come close, while
+// unbounded exponents would let a response make us compute vast powers of ten.
+const maxDecimalExponent = 1000
+
+// Decimal is an exact, arbitrary-precision decimal number, as used for the
+// API's Decimal and Money scalars. Its value is an integer coefficient scaled
+// by a power of ten, so amounts such as 0.1 are represented exactly, unlike
+// with float64.
+//
+// The zero value is 0. Decimals are immutable, and are safe to copy.
+type Decimal struct {
+	// coef is the unscaled coefficient. A nil coef represents zero.
+	coef *big.Int
+	// scale is the number of digits after the decimal point, such that the
+	// value is coef × 10^-scale. It's never negative.
+	scale int32
+}
+
+// NewDecimal returns the Decimal coef × 10^-scale, such that NewDecimal(1250, 2)
+// is 12.50. A negative scale multiplies coef by a power of ten.
+func NewDecimal(coef int64, scale int32) Decimal {
+	d := Decimal{coef: big.NewInt(coef), scale: scale}
+	if scale < 0 {
+		d.coef.Mul(d.coef, pow10(-scale))
+		d.scale = 0
+	}
+
+	return d
+}
+
+// ParseDecimal parses a decimal number, such as "12.50", "-3", ".5" or
+// "1.5e3". The number of digits after the decimal point is retained. Numbers
+// with exponents, or digits after the decimal point, numbering more than a
+// thousand are rejected as out of range.
+func ParseDecimal(s string) (Decimal, error) {
+	mantissa, exp := s, int64(0)
+
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.ParseInt(s[i+1:], 10, 32); err != nil {
+			return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+		}
+
+		mantissa = s[:i]
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+
+	digits := strings.TrimLeft(intPart, "+-")
+	if len(intPart)-len(digits) > 1 || digits+fracPart == "" || !isDigits(digits) || !isDigits(fracPart) {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+
+	coef, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, s)
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if exp < -maxDecimalExponent || exp > maxDecimalExponent || scale > maxDecimalExponent {
+		return Decimal{}, fmt.Errorf("%w: %q is out of range", ErrInvalidDecimal, s)
+	}
+
+	d := Decimal{coef: coef}
+
+	switch {
+	case scale < 0:
+		d.coef.Mul(d.coef, pow10(int32(-scale)))
+		d.scale = 0
+	default:
+		d.scale = int32(scale)
+	}
+
+	return d, nil
+}
+
+// MustParseDecimal is as ParseDecimal, but panics if s can't be parsed.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// isDigits reports whether s consists solely of ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pow10 returns 10^n as a *big.Int.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// coefficient returns the coefficient of d, treating a nil coefficient as 0.
+func (d Decimal) coefficient() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+
+	return d.coef
+}
+
+// rescale returns the coefficient of d scaled up to scale, which must be at
+// least that of d.
+func (d Decimal) rescale(scale int32) *big.Int {
+	coef := new(big.Int).Set(d.coefficient())
+	if scale > d.scale {
+		coef.Mul(coef, pow10(scale-d.scale))
+	}
+
+	return coef
+}
+
+// align returns the coefficients of d and o at the greater of their scales,
+// along with that scale.
+func (d Decimal) align(o Decimal) (*big.Int, *big.Int, int32) {
+	scale := d.scale
+	if o.scale > scale {
+		scale = o.scale
+	}
+
+	return d.rescale(scale), o.rescale(scale), scale
+}
+
+// Add returns d + o.
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b, scale := d.align(o)
+	return Decimal{coef: a.Add(a, b), scale: scale}
+}
+
+// Sub returns d - o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	a, b, scale := d.align(o)
+	return Decimal{coef: a.Sub(a, b), scale: scale}
+}
+
+// Mul returns d × o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	coef := new(big.Int).Mul(d.coefficient(), o.coefficient())
+	return Decimal{coef: coef, scale: d.scale + o.scale}
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.coefficient()), scale: d.scale}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.coefficient()), scale: d.scale}
+}
+
+// Cmp compares d and o, returning -1 if d < o, 0 if d == o and +1 if d > o.
+// Trailing zeros are insignificant, so 1.5 and 1.50 compare equal.
+func (d Decimal) Cmp(o Decimal) int {
+	a, b, _ := d.align(o)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and o are numerically equal.
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// Sign returns -1 if d < 0, 0 if d == 0 and +1 if d > 0.
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+// IsZero reports whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Scale returns the number of digits after the decimal point of d.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Round returns d rounded to the given number of digits after the decimal
+// point, with halves rounded away from zero. If d has fewer digits, it's
+// padded with zeros, so the result always has exactly places digits.
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 {
+		places = 0
+	}
+
+	if places >= d.scale {
+		return Decimal{coef: d.rescale(places), scale: places}
+	}
+
+	divisor := pow10(d.scale - places)
+	q, r := new(big.Int).QuoRem(d.coefficient(), divisor, new(big.Int))
+
+	// Round away from zero if the remainder is at least half the divisor.
+	if r.Abs(r).Lsh(r, 1).Cmp(divisor) >= 0 {
+		q.Add(q, big.NewInt(int64(d.Sign())))
+	}
+
+	return Decimal{coef: q, scale: places}
+}
+
+// Float64 returns the nearest float64 to d. It's intended for display and
+// similar purposes; arithmetic should be done with Decimal.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns d in plain decimal notation, with as many digits after the
+// decimal point as its scale, such as "12.50".
+func (d Decimal) String() string {
+	coef := d.coefficient()
+
+	digits := new(big.Int).Abs(coef).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+
+		point := len(digits) - int(d.scale)
+		digits = digits[:point] + "." + digits[point:]
+	}
+
+	if coef.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// StringFixed returns d rounded to, and formatted with, the given number of
+// digits after the decimal point.
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places).String()
+}
+
+// MarshalJSON implements json.Marshaler, encoding d as a string, as the API
+// does, to avoid loss of precision.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either a string or a
+// number.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	s := string(data)
+	if len(data) != 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+
+	*d = v
+	return nil
+}
@@ -0,0 +1,108 @@
+package storefront
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"0", "0"},
+		{"12.50", "12.50"},
+		{"-3", "-3"},
+		{"+3.1", "3.1"},
+		{".5", "0.5"},
+		{"-0.05", "-0.05"},
+		{"1.5e3", "1500"},
+		{"15e-4", "0.0015"},
+	}
+
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		if assert.NoError(err, tt.in) {
+			assert.Equal(tt.want, d.String(), tt.in)
+		}
+	}
+
+	for _, in := range []string{"", "-", ".", "1.2.3", "1,5", "--1", "1e", "abc", "0x10", "1e-2147483648", "1e999999999", "1e1001", "1e-1001"} {
+		_, err := ParseDecimal(in)
+		assert.ErrorIs(err, ErrInvalidDecimal, in)
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	assert := assert.New(t)
+
+	// The classic float64 failure: 0.1 + 0.2 != 0.3.
+	sum := MustParseDecimal("0.1").Add(MustParseDecimal("0.2"))
+	assert.True(sum.Equal(MustParseDecimal("0.3")))
+	assert.Equal("0.3", sum.String())
+
+	assert.Equal("7.45", MustParseDecimal("10").Sub(MustParseDecimal("2.55")).String())
+	assert.Equal("37.4850", MustParseDecimal("12.495").Mul(MustParseDecimal("3.0")).String())
+	assert.Equal("-1.5", MustParseDecimal("1.5").Neg().String())
+	assert.Equal("1.5", MustParseDecimal("-1.5").Abs().String())
+
+	assert.Equal(0, MustParseDecimal("1.5").Cmp(MustParseDecimal("1.50")))
+	assert.Equal(-1, MustParseDecimal("1.49").Cmp(MustParseDecimal("1.5")))
+	assert.Equal(1, MustParseDecimal("2").Cmp(MustParseDecimal("-3")))
+
+	var zero Decimal
+	assert.True(zero.IsZero())
+	assert.Equal("0", zero.String())
+	assert.Equal("2.5", zero.Add(MustParseDecimal("2.5")).String())
+	assert.Equal("12.50", NewDecimal(1250, 2).String())
+	assert.Equal("1200", NewDecimal(12, -2).String())
+}
+
+func TestDecimal_Round(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		in     string
+		places int32
+		want   string
+	}{
+		{"1.005", 2, "1.01"},
+		{"1.004", 2, "1.00"},
+		{"-1.005", 2, "-1.01"},
+		{"2.5", 0, "3"},
+		{"-2.5", 0, "-3"},
+		{"2", 2, "2.00"},
+		{"0.0049", 2, "0.00"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(tt.want, MustParseDecimal(tt.in).Round(tt.places).String(), tt.in)
+	}
+
+	assert.Equal("19.99", MustParseDecimal("19.989").StringFixed(2))
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	assert := assert.New(t)
+
+	var m MoneyV2
+
+	assert.NoError(json.Unmarshal([]byte(`{"amount": "19.90", "currencyCode": "USD"}`), &m))
+	assert.Equal("19.90", m.Amount.String())
+
+	bs, err := json.Marshal(m)
+	if assert.NoError(err) {
+		assert.JSONEq(`{"amount": "19.90", "currencyCode": "USD"}`, string(bs))
+	}
+
+	var d Decimal
+
+	assert.NoError(json.Unmarshal([]byte(`12.5`), &d))
+	assert.Equal("12.5", d.String())
+
+	assert.ErrorIs(json.Unmarshal([]byte(`"twelve"`), &d), ErrInvalidDecimal)
+}
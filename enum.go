@@ -0,0 +1,315 @@
+package storefront
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/boatilus/storefront-go/internal/graphql"
+)
+
+// ErrInvalidEnum indicates a value which isn't one of the values of its enum,
+// either when decoding a response strictly, or when encoding a request.
+var ErrInvalidEnum = errors.New("storefront: invalid enum value")
+
+// StrictEnums controls how values of an enum which aren't known to this
+// version of the package, such as those added in a newer API version, are
+// decoded. If false, the default, they're decoded as the enum's Unknown
+// sentinel, such as ProductSortKeysUnknown. If true, decoding fails with an
+// error wrapping ErrInvalidEnum.
+//
+// Deprecated: Changing StrictEnums affects all decoding, and races with any
+// concurrent decoding. Use WithStrictEnums to have an individual client
+// reject unknown values instead.
+var StrictEnums = false
+
+// enum is the set of types generated for GraphQL enums.
+type enum interface {
+	~string
+	IsValid() bool
+}
+
+// unmarshalEnum decodes data into e, following StrictEnums for unknown values.
+// A null value leaves e unchanged.
+func unmarshalEnum[T enum](data []byte, e *T) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	v := T(s)
+	if !v.IsValid() {
+		if StrictEnums {
+			return fmt.Errorf("%w: %q for %T", ErrInvalidEnum, s, v)
+		}
+
+		// The Unknown sentinel of every enum is its zero value.
+		v = *new(T)
+	}
+
+	*e = v
+	return nil
+}
+
+// marshalEnum encodes e. The zero value, which is also the Unknown sentinel,
+// is encoded as null, so that unselected or unknown fields of a decoded
+// response can be encoded again. Values sent in requests are checked by
+// checkVariables instead.
+func marshalEnum[T enum](e T) ([]byte, error) {
+	if e == *new(T) {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(string(e))
+}
+
+// validator is implemented by each enum, for use with reflection.
+var validator = reflect.TypeOf((*interface{ IsValid() bool })(nil)).Elem()
+
+// checkVariables returns an error wrapping ErrInvalidEnum if any of the values
+// of vars hold a value of an enum which isn't valid, including the Unknown
+// sentinel, such as a misspelt sort key or a required enum left unset.
+func checkVariables(vars map[string]interface{}) error {
+	for name, v := range vars {
+		if err := checkEnumValues(reflect.ValueOf(v)); err != nil {
+			return fmt.Errorf("%w in $%s", err, name)
+		}
+	}
+
+	return nil
+}
+
+// checkEnumValues returns an error wrapping ErrInvalidEnum if v, or any value
+// within it, is an enum value which isn't valid. Optionals are only checked if
+// set to a value, and only the exported fields of other structs are checked.
+func checkEnumValues(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		if !v.Type().Implements(validator) {
+			return nil
+		}
+
+		// v may have been reached through unexported fields, so it's copied
+		// before its method is called.
+		e := reflect.New(v.Type()).Elem()
+		e.SetString(v.String())
+
+		if !e.Interface().(interface{ IsValid() bool }).IsValid() {
+			return fmt.Errorf("%w: %q for %s", ErrInvalidEnum, v.String(), v.Type())
+		}
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			return checkEnumValues(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := checkEnumValues(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := checkEnumValues(iter.Value()); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		if v.Type().Implements(reflect.TypeOf((*optional)(nil)).Elem()) {
+			if optionalState(v.FieldByName("state").Uint()) != optionalValue {
+				return nil
+			}
+
+			return checkEnumValues(v.FieldByName("value"))
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				if err := checkEnumValues(v.Field(i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkEnums returns an error wrapping ErrInvalidEnum if the data of body, the
+// response to r, holds a value of an enum which isn't known to the embedded
+// schema. Values are found by following the selections of r's operation, so
+// they're checked whatever they're decoded into.
+func checkEnums(r *Request, body []byte) error {
+	s, err := loadSchema()
+	if err != nil {
+		return err
+	}
+
+	// The API reports invalid documents itself, and returns no data for them.
+	doc, err := graphql.ParseQuery(r.Query)
+	if err != nil {
+		return nil
+	}
+
+	op := doc.Operation(r.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	var root *graphql.TypeName
+	switch op.Type {
+	case "query":
+		root = s.QueryType
+	case "mutation":
+		root = s.MutationType
+	}
+
+	if root == nil {
+		return nil
+	}
+
+	var res struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &res); err != nil {
+		return err
+	}
+
+	ec := enumChecker{schema: s, doc: doc}
+	return ec.selections(s.Type(root.Name), op.SelectionSet, res.Data)
+}
+
+// enumChecker checks the values of enums in the data of a response to an
+// operation of doc.
+type enumChecker struct {
+	schema *graphql.Schema
+	doc    *graphql.Document
+}
+
+// selections checks the fields sels select from obj, an object of the
+// composite type t.
+func (ec *enumChecker) selections(t *graphql.Type, sels []graphql.Selection, obj map[string]json.RawMessage) error {
+	for _, sel := range sels {
+		var err error
+
+		switch sel := sel.(type) {
+		case *graphql.FieldSelection:
+			raw, ok := obj[sel.ResponseName()]
+			if !ok {
+				continue
+			}
+
+			// Fields without definitions, such as __typename, are never of enum
+			// types.
+			if def := ec.schema.FieldDef(t, sel.Name); def != nil {
+				err = ec.value(def.Type, sel.SelectionSet, raw)
+			}
+		case *graphql.InlineFragment:
+			err = ec.fragment(t, sel.TypeCondition, sel.SelectionSet, obj)
+		case *graphql.FragmentSpread:
+			if f := ec.doc.Fragment(sel.Name); f != nil {
+				err = ec.fragment(t, f.TypeCondition, f.SelectionSet, obj)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fragment checks the fields a fragment on the type named cond selects from
+// obj, an object of the composite type t, if the fragment applies to it. An
+// object of an abstract type without its __typename is only checked against
+// fragments which apply to every object of t.
+func (ec *enumChecker) fragment(t *graphql.Type, cond string, sels []graphql.Selection, obj map[string]json.RawMessage) error {
+	condType := t
+	if cond != "" {
+		if condType = ec.schema.Type(cond); condType == nil {
+			return nil
+		}
+	}
+
+	typename := t.Name
+	if raw, ok := obj["__typename"]; ok {
+		if err := json.Unmarshal(raw, &typename); err != nil {
+			return err
+		}
+	}
+
+	applies := typename == condType.Name
+	for _, pt := range condType.PossibleTypes {
+		applies = applies || pt.Name == typename
+	}
+
+	if !applies {
+		return nil
+	}
+
+	return ec.selections(condType, sels, obj)
+}
+
+// value checks raw, a value of the type t, and the fields sels select from it
+// if it's of a composite type.
+func (ec *enumChecker) value(t graphql.TypeRef, sels []graphql.Selection, raw json.RawMessage) error {
+	if bytes.Equal(raw, []byte("null")) {
+		return nil
+	}
+
+	switch t.Kind {
+	case "NON_NULL":
+		return ec.value(*t.OfType, sels, raw)
+	case "LIST":
+		var raws []json.RawMessage
+		if err := json.Unmarshal(raw, &raws); err != nil {
+			return err
+		}
+
+		for _, r := range raws {
+			if err := ec.value(*t.OfType, sels, r); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	typ := ec.schema.Type(t.Name)
+	if typ == nil {
+		return nil
+	}
+
+	switch typ.Kind {
+	case "ENUM":
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+
+		for _, v := range typ.EnumValues {
+			if v.Name == s {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w: %q for %s", ErrInvalidEnum, s, typ.Name)
+	case "OBJECT", "INTERFACE", "UNION":
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return err
+		}
+
+		return ec.selections(typ, sels, obj)
+	}
+
+	return nil
+}
@@ -0,0 +1,1074 @@
+package storefront
+
+/*
+CurrencyCode: The three-letter currency codes that represent the world currencies used in stores. These include standard ISO 4217 codes, legacy codes,
+and non-standard codes.
+*/
+type CurrencyCode string
+
+const (
+	// CurrencyCodeUnknown is the zero value of CurrencyCode. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	CurrencyCodeUnknown CurrencyCode = ""
+	CurrencyCodeUsd     CurrencyCode = "USD"
+	CurrencyCodeEur     CurrencyCode = "EUR"
+	CurrencyCodeGbp     CurrencyCode = "GBP"
+	CurrencyCodeCad     CurrencyCode = "CAD"
+	CurrencyCodeAfn     CurrencyCode = "AFN"
+	CurrencyCodeAll     CurrencyCode = "ALL"
+	CurrencyCodeDzd     CurrencyCode = "DZD"
+	CurrencyCodeAoa     CurrencyCode = "AOA"
+	CurrencyCodeArs     CurrencyCode = "ARS"
+	CurrencyCodeAmd     CurrencyCode = "AMD"
+	CurrencyCodeAwg     CurrencyCode = "AWG"
+	CurrencyCodeAud     CurrencyCode = "AUD"
+	CurrencyCodeBbd     CurrencyCode = "BBD"
+	CurrencyCodeAzn     CurrencyCode = "AZN"
+	CurrencyCodeBdt     CurrencyCode = "BDT"
+	CurrencyCodeBsd     CurrencyCode = "BSD"
+	CurrencyCodeBhd     CurrencyCode = "BHD"
+	CurrencyCodeBif     CurrencyCode = "BIF"
+	CurrencyCodeBzd     CurrencyCode = "BZD"
+	CurrencyCodeBmd     CurrencyCode = "BMD"
+	CurrencyCodeBtn     CurrencyCode = "BTN"
+	CurrencyCodeBam     CurrencyCode = "BAM"
+	CurrencyCodeBrl     CurrencyCode = "BRL"
+	CurrencyCodeBob     CurrencyCode = "BOB"
+	CurrencyCodeBwp     CurrencyCode = "BWP"
+	CurrencyCodeBnd     CurrencyCode = "BND"
+	CurrencyCodeBgn     CurrencyCode = "BGN"
+	CurrencyCodeMmk     CurrencyCode = "MMK"
+	CurrencyCodeKhr     CurrencyCode = "KHR"
+	CurrencyCodeCve     CurrencyCode = "CVE"
+	CurrencyCodeKyd     CurrencyCode = "KYD"
+	CurrencyCodeXaf     CurrencyCode = "XAF"
+	CurrencyCodeClp     CurrencyCode = "CLP"
+	CurrencyCodeCny     CurrencyCode = "CNY"
+	CurrencyCodeCop     CurrencyCode = "COP"
+	CurrencyCodeKmf     CurrencyCode = "KMF"
+	CurrencyCodeCdf     CurrencyCode = "CDF"
+	CurrencyCodeCrc     CurrencyCode = "CRC"
+	CurrencyCodeHrk     CurrencyCode = "HRK"
+	CurrencyCodeCzk     CurrencyCode = "CZK"
+	CurrencyCodeDkk     CurrencyCode = "DKK"
+	CurrencyCodeDop     CurrencyCode = "DOP"
+	CurrencyCodeXcd     CurrencyCode = "XCD"
+	CurrencyCodeEgp     CurrencyCode = "EGP"
+	CurrencyCodeEtb     CurrencyCode = "ETB"
+	CurrencyCodeXpf     CurrencyCode = "XPF"
+	CurrencyCodeFjd     CurrencyCode = "FJD"
+	CurrencyCodeGmd     CurrencyCode = "GMD"
+	CurrencyCodeGhs     CurrencyCode = "GHS"
+	CurrencyCodeGtq     CurrencyCode = "GTQ"
+	CurrencyCodeGyd     CurrencyCode = "GYD"
+	CurrencyCodeGel     CurrencyCode = "GEL"
+	CurrencyCodeHtg     CurrencyCode = "HTG"
+	CurrencyCodeHnl     CurrencyCode = "HNL"
+	CurrencyCodeHkd     CurrencyCode = "HKD"
+	CurrencyCodeHuf     CurrencyCode = "HUF"
+	CurrencyCodeIsk     CurrencyCode = "ISK"
+	CurrencyCodeInr     CurrencyCode = "INR"
+	CurrencyCodeIdr     CurrencyCode = "IDR"
+	CurrencyCodeIls     CurrencyCode = "ILS"
+	CurrencyCodeIqd     CurrencyCode = "IQD"
+	CurrencyCodeJmd     CurrencyCode = "JMD"
+	CurrencyCodeJpy     CurrencyCode = "JPY"
+	CurrencyCodeJep     CurrencyCode = "JEP"
+	CurrencyCodeJod     CurrencyCode = "JOD"
+	CurrencyCodeKzt     CurrencyCode = "KZT"
+	CurrencyCodeKes     CurrencyCode = "KES"
+	CurrencyCodeKwd     CurrencyCode = "KWD"
+	CurrencyCodeKgs     CurrencyCode = "KGS"
+	CurrencyCodeLak     CurrencyCode = "LAK"
+	CurrencyCodeLvl     CurrencyCode = "LVL"
+	CurrencyCodeLbp     CurrencyCode = "LBP"
+	CurrencyCodeLsl     CurrencyCode = "LSL"
+	CurrencyCodeLrd     CurrencyCode = "LRD"
+	CurrencyCodeLtl     CurrencyCode = "LTL"
+	CurrencyCodeMga     CurrencyCode = "MGA"
+	CurrencyCodeMkd     CurrencyCode = "MKD"
+	CurrencyCodeMop     CurrencyCode = "MOP"
+	CurrencyCodeMwk     CurrencyCode = "MWK"
+	CurrencyCodeMvr     CurrencyCode = "MVR"
+	CurrencyCodeMxn     CurrencyCode = "MXN"
+	CurrencyCodeMyr     CurrencyCode = "MYR"
+	CurrencyCodeMur     CurrencyCode = "MUR"
+	CurrencyCodeMdl     CurrencyCode = "MDL"
+	CurrencyCodeMad     CurrencyCode = "MAD"
+	CurrencyCodeMnt     CurrencyCode = "MNT"
+	CurrencyCodeMzn     CurrencyCode = "MZN"
+	CurrencyCodeNad     CurrencyCode = "NAD"
+	CurrencyCodeNpr     CurrencyCode = "NPR"
+	CurrencyCodeAng     CurrencyCode = "ANG"
+	CurrencyCodeNzd     CurrencyCode = "NZD"
+	CurrencyCodeNio     CurrencyCode = "NIO"
+	CurrencyCodeNgn     CurrencyCode = "NGN"
+	CurrencyCodeNok     CurrencyCode = "NOK"
+	CurrencyCodeOmr     CurrencyCode = "OMR"
+	CurrencyCodePab     CurrencyCode = "PAB"
+	CurrencyCodePkr     CurrencyCode = "PKR"
+	CurrencyCodePgk     CurrencyCode = "PGK"
+	CurrencyCodePyg     CurrencyCode = "PYG"
+	CurrencyCodePen     CurrencyCode = "PEN"
+	CurrencyCodePhp     CurrencyCode = "PHP"
+	CurrencyCodePln     CurrencyCode = "PLN"
+	CurrencyCodeQar     CurrencyCode = "QAR"
+	CurrencyCodeRon     CurrencyCode = "RON"
+	CurrencyCodeRub     CurrencyCode = "RUB"
+	CurrencyCodeRwf     CurrencyCode = "RWF"
+	CurrencyCodeWst     CurrencyCode = "WST"
+	CurrencyCodeSar     CurrencyCode = "SAR"
+	CurrencyCodeStd     CurrencyCode = "STD"
+	CurrencyCodeRsd     CurrencyCode = "RSD"
+	CurrencyCodeScr     CurrencyCode = "SCR"
+	CurrencyCodeSgd     CurrencyCode = "SGD"
+	CurrencyCodeSdg     CurrencyCode = "SDG"
+	CurrencyCodeSyp     CurrencyCode = "SYP"
+	CurrencyCodeZar     CurrencyCode = "ZAR"
+	CurrencyCodeKrw     CurrencyCode = "KRW"
+	CurrencyCodeSsp     CurrencyCode = "SSP"
+	CurrencyCodeSbd     CurrencyCode = "SBD"
+	CurrencyCodeLkr     CurrencyCode = "LKR"
+	CurrencyCodeSrd     CurrencyCode = "SRD"
+	CurrencyCodeSzl     CurrencyCode = "SZL"
+	CurrencyCodeSek     CurrencyCode = "SEK"
+	CurrencyCodeChf     CurrencyCode = "CHF"
+	CurrencyCodeTwd     CurrencyCode = "TWD"
+	CurrencyCodeThb     CurrencyCode = "THB"
+	CurrencyCodeTzs     CurrencyCode = "TZS"
+	CurrencyCodeTtd     CurrencyCode = "TTD"
+	CurrencyCodeTnd     CurrencyCode = "TND"
+	CurrencyCodeTry     CurrencyCode = "TRY"
+	CurrencyCodeTmt     CurrencyCode = "TMT"
+	CurrencyCodeUgx     CurrencyCode = "UGX"
+	CurrencyCodeUah     CurrencyCode = "UAH"
+	CurrencyCodeAed     CurrencyCode = "AED"
+	CurrencyCodeUyu     CurrencyCode = "UYU"
+	CurrencyCodeUzs     CurrencyCode = "UZS"
+	CurrencyCodeVuv     CurrencyCode = "VUV"
+	CurrencyCodeVnd     CurrencyCode = "VND"
+	CurrencyCodeXof     CurrencyCode = "XOF"
+	CurrencyCodeYer     CurrencyCode = "YER"
+	CurrencyCodeZmw     CurrencyCode = "ZMW"
+	CurrencyCodeByn     CurrencyCode = "BYN"
+	CurrencyCodeByr     CurrencyCode = "BYR"
+	CurrencyCodeDjf     CurrencyCode = "DJF"
+	CurrencyCodeErn     CurrencyCode = "ERN"
+	CurrencyCodeFkp     CurrencyCode = "FKP"
+	CurrencyCodeGip     CurrencyCode = "GIP"
+	CurrencyCodeGnf     CurrencyCode = "GNF"
+	CurrencyCodeIrr     CurrencyCode = "IRR"
+	CurrencyCodeKid     CurrencyCode = "KID"
+	CurrencyCodeLyd     CurrencyCode = "LYD"
+	CurrencyCodeMru     CurrencyCode = "MRU"
+	CurrencyCodeSll     CurrencyCode = "SLL"
+	CurrencyCodeShp     CurrencyCode = "SHP"
+	CurrencyCodeSos     CurrencyCode = "SOS"
+	CurrencyCodeTjs     CurrencyCode = "TJS"
+	CurrencyCodeTop     CurrencyCode = "TOP"
+	CurrencyCodeVef     CurrencyCode = "VEF"
+	CurrencyCodeVes     CurrencyCode = "VES"
+	CurrencyCodeXxx     CurrencyCode = "XXX"
+)
+
+// Values returns all the values of CurrencyCode, in schema order.
+func (CurrencyCode) Values() []CurrencyCode {
+	return []CurrencyCode{
+		CurrencyCodeUsd,
+		CurrencyCodeEur,
+		CurrencyCodeGbp,
+		CurrencyCodeCad,
+		CurrencyCodeAfn,
+		CurrencyCodeAll,
+		CurrencyCodeDzd,
+		CurrencyCodeAoa,
+		CurrencyCodeArs,
+		CurrencyCodeAmd,
+		CurrencyCodeAwg,
+		CurrencyCodeAud,
+		CurrencyCodeBbd,
+		CurrencyCodeAzn,
+		CurrencyCodeBdt,
+		CurrencyCodeBsd,
+		CurrencyCodeBhd,
+		CurrencyCodeBif,
+		CurrencyCodeBzd,
+		CurrencyCodeBmd,
+		CurrencyCodeBtn,
+		CurrencyCodeBam,
+		CurrencyCodeBrl,
+		CurrencyCodeBob,
+		CurrencyCodeBwp,
+		CurrencyCodeBnd,
+		CurrencyCodeBgn,
+		CurrencyCodeMmk,
+		CurrencyCodeKhr,
+		CurrencyCodeCve,
+		CurrencyCodeKyd,
+		CurrencyCodeXaf,
+		CurrencyCodeClp,
+		CurrencyCodeCny,
+		CurrencyCodeCop,
+		CurrencyCodeKmf,
+		CurrencyCodeCdf,
+		CurrencyCodeCrc,
+		CurrencyCodeHrk,
+		CurrencyCodeCzk,
+		CurrencyCodeDkk,
+		CurrencyCodeDop,
+		CurrencyCodeXcd,
+		CurrencyCodeEgp,
+		CurrencyCodeEtb,
+		CurrencyCodeXpf,
+		CurrencyCodeFjd,
+		CurrencyCodeGmd,
+		CurrencyCodeGhs,
+		CurrencyCodeGtq,
+		CurrencyCodeGyd,
+		CurrencyCodeGel,
+		CurrencyCodeHtg,
+		CurrencyCodeHnl,
+		CurrencyCodeHkd,
+		CurrencyCodeHuf,
+		CurrencyCodeIsk,
+		CurrencyCodeInr,
+		CurrencyCodeIdr,
+		CurrencyCodeIls,
+		CurrencyCodeIqd,
+		CurrencyCodeJmd,
+		CurrencyCodeJpy,
+		CurrencyCodeJep,
+		CurrencyCodeJod,
+		CurrencyCodeKzt,
+		CurrencyCodeKes,
+		CurrencyCodeKwd,
+		CurrencyCodeKgs,
+		CurrencyCodeLak,
+		CurrencyCodeLvl,
+		CurrencyCodeLbp,
+		CurrencyCodeLsl,
+		CurrencyCodeLrd,
+		CurrencyCodeLtl,
+		CurrencyCodeMga,
+		CurrencyCodeMkd,
+		CurrencyCodeMop,
+		CurrencyCodeMwk,
+		CurrencyCodeMvr,
+		CurrencyCodeMxn,
+		CurrencyCodeMyr,
+		CurrencyCodeMur,
+		CurrencyCodeMdl,
+		CurrencyCodeMad,
+		CurrencyCodeMnt,
+		CurrencyCodeMzn,
+		CurrencyCodeNad,
+		CurrencyCodeNpr,
+		CurrencyCodeAng,
+		CurrencyCodeNzd,
+		CurrencyCodeNio,
+		CurrencyCodeNgn,
+		CurrencyCodeNok,
+		CurrencyCodeOmr,
+		CurrencyCodePab,
+		CurrencyCodePkr,
+		CurrencyCodePgk,
+		CurrencyCodePyg,
+		CurrencyCodePen,
+		CurrencyCodePhp,
+		CurrencyCodePln,
+		CurrencyCodeQar,
+		CurrencyCodeRon,
+		CurrencyCodeRub,
+		CurrencyCodeRwf,
+		CurrencyCodeWst,
+		CurrencyCodeSar,
+		CurrencyCodeStd,
+		CurrencyCodeRsd,
+		CurrencyCodeScr,
+		CurrencyCodeSgd,
+		CurrencyCodeSdg,
+		CurrencyCodeSyp,
+		CurrencyCodeZar,
+		CurrencyCodeKrw,
+		CurrencyCodeSsp,
+		CurrencyCodeSbd,
+		CurrencyCodeLkr,
+		CurrencyCodeSrd,
+		CurrencyCodeSzl,
+		CurrencyCodeSek,
+		CurrencyCodeChf,
+		CurrencyCodeTwd,
+		CurrencyCodeThb,
+		CurrencyCodeTzs,
+		CurrencyCodeTtd,
+		CurrencyCodeTnd,
+		CurrencyCodeTry,
+		CurrencyCodeTmt,
+		CurrencyCodeUgx,
+		CurrencyCodeUah,
+		CurrencyCodeAed,
+		CurrencyCodeUyu,
+		CurrencyCodeUzs,
+		CurrencyCodeVuv,
+		CurrencyCodeVnd,
+		CurrencyCodeXof,
+		CurrencyCodeYer,
+		CurrencyCodeZmw,
+		CurrencyCodeByn,
+		CurrencyCodeByr,
+		CurrencyCodeDjf,
+		CurrencyCodeErn,
+		CurrencyCodeFkp,
+		CurrencyCodeGip,
+		CurrencyCodeGnf,
+		CurrencyCodeIrr,
+		CurrencyCodeKid,
+		CurrencyCodeLyd,
+		CurrencyCodeMru,
+		CurrencyCodeSll,
+		CurrencyCodeShp,
+		CurrencyCodeSos,
+		CurrencyCodeTjs,
+		CurrencyCodeTop,
+		CurrencyCodeVef,
+		CurrencyCodeVes,
+		CurrencyCodeXxx,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e CurrencyCode) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of CurrencyCode.
+func (e CurrencyCode) IsValid() bool {
+	switch e {
+	case CurrencyCodeUsd, CurrencyCodeEur, CurrencyCodeGbp, CurrencyCodeCad, CurrencyCodeAfn, CurrencyCodeAll, CurrencyCodeDzd, CurrencyCodeAoa, CurrencyCodeArs, CurrencyCodeAmd, CurrencyCodeAwg, CurrencyCodeAud, CurrencyCodeBbd, CurrencyCodeAzn, CurrencyCodeBdt, CurrencyCodeBsd, CurrencyCodeBhd, CurrencyCodeBif, CurrencyCodeBzd, CurrencyCodeBmd, CurrencyCodeBtn, CurrencyCodeBam, CurrencyCodeBrl, CurrencyCodeBob, CurrencyCodeBwp, CurrencyCodeBnd, CurrencyCodeBgn, CurrencyCodeMmk, CurrencyCodeKhr, CurrencyCodeCve, CurrencyCodeKyd, CurrencyCodeXaf, CurrencyCodeClp, CurrencyCodeCny, CurrencyCodeCop, CurrencyCodeKmf, CurrencyCodeCdf, CurrencyCodeCrc, CurrencyCodeHrk, CurrencyCodeCzk, CurrencyCodeDkk, CurrencyCodeDop, CurrencyCodeXcd, CurrencyCodeEgp, CurrencyCodeEtb, CurrencyCodeXpf, CurrencyCodeFjd, CurrencyCodeGmd, CurrencyCodeGhs, CurrencyCodeGtq, CurrencyCodeGyd, CurrencyCodeGel, CurrencyCodeHtg, CurrencyCodeHnl, CurrencyCodeHkd, CurrencyCodeHuf, CurrencyCodeIsk, CurrencyCodeInr, CurrencyCodeIdr, CurrencyCodeIls, CurrencyCodeIqd, CurrencyCodeJmd, CurrencyCodeJpy, CurrencyCodeJep, CurrencyCodeJod, CurrencyCodeKzt, CurrencyCodeKes, CurrencyCodeKwd, CurrencyCodeKgs, CurrencyCodeLak, CurrencyCodeLvl, CurrencyCodeLbp, CurrencyCodeLsl, CurrencyCodeLrd, CurrencyCodeLtl, CurrencyCodeMga, CurrencyCodeMkd, CurrencyCodeMop, CurrencyCodeMwk, CurrencyCodeMvr, CurrencyCodeMxn, CurrencyCodeMyr, CurrencyCodeMur, CurrencyCodeMdl, CurrencyCodeMad, CurrencyCodeMnt, CurrencyCodeMzn, CurrencyCodeNad, CurrencyCodeNpr, CurrencyCodeAng, CurrencyCodeNzd, CurrencyCodeNio, CurrencyCodeNgn, CurrencyCodeNok, CurrencyCodeOmr, CurrencyCodePab, CurrencyCodePkr, CurrencyCodePgk, CurrencyCodePyg, CurrencyCodePen, CurrencyCodePhp, CurrencyCodePln, CurrencyCodeQar, CurrencyCodeRon, CurrencyCodeRub, CurrencyCodeRwf, CurrencyCodeWst, CurrencyCodeSar, CurrencyCodeStd, CurrencyCodeRsd, CurrencyCodeScr, CurrencyCodeSgd, CurrencyCodeSdg, CurrencyCodeSyp, CurrencyCodeZar, CurrencyCodeKrw, CurrencyCodeSsp, CurrencyCodeSbd, CurrencyCodeLkr, CurrencyCodeSrd, CurrencyCodeSzl, CurrencyCodeSek, CurrencyCodeChf, CurrencyCodeTwd, CurrencyCodeThb, CurrencyCodeTzs, CurrencyCodeTtd, CurrencyCodeTnd, CurrencyCodeTry, CurrencyCodeTmt, CurrencyCodeUgx, CurrencyCodeUah, CurrencyCodeAed, CurrencyCodeUyu, CurrencyCodeUzs, CurrencyCodeVuv, CurrencyCodeVnd, CurrencyCodeXof, CurrencyCodeYer, CurrencyCodeZmw, CurrencyCodeByn, CurrencyCodeByr, CurrencyCodeDjf, CurrencyCodeErn, CurrencyCodeFkp, CurrencyCodeGip, CurrencyCodeGnf, CurrencyCodeIrr, CurrencyCodeKid, CurrencyCodeLyd, CurrencyCodeMru, CurrencyCodeSll, CurrencyCodeShp, CurrencyCodeSos, CurrencyCodeTjs, CurrencyCodeTop, CurrencyCodeVef, CurrencyCodeVes, CurrencyCodeXxx:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e CurrencyCode) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// CurrencyCodeUnknown, or returning an error if StrictEnums is set.
+func (e *CurrencyCode) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+// MinorUnits returns the ISO 4217 exponent of the currency's minor unit: the
+// number of digits after the decimal point of its amounts, such as 2 for USD
+// (cents) or 0 for JPY.
+func (e CurrencyCode) MinorUnits() int32 {
+	switch e {
+	case CurrencyCodeBif, CurrencyCodeXaf, CurrencyCodeClp, CurrencyCodeKmf, CurrencyCodeXpf, CurrencyCodeIsk, CurrencyCodeJpy, CurrencyCodePyg, CurrencyCodeRwf, CurrencyCodeKrw, CurrencyCodeUgx, CurrencyCodeVuv, CurrencyCodeVnd, CurrencyCodeXof, CurrencyCodeByr, CurrencyCodeDjf, CurrencyCodeGnf, CurrencyCodeXxx:
+		return 0
+	case CurrencyCodeBhd, CurrencyCodeIqd, CurrencyCodeJod, CurrencyCodeKwd, CurrencyCodeOmr, CurrencyCodeTnd, CurrencyCodeLyd:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// UnitPriceMeasurementMeasuredType: The accepted types of unit of measurement.
+type UnitPriceMeasurementMeasuredType string
+
+const (
+	// UnitPriceMeasurementMeasuredTypeUnknown is the zero value of UnitPriceMeasurementMeasuredType. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	UnitPriceMeasurementMeasuredTypeUnknown UnitPriceMeasurementMeasuredType = ""
+	UnitPriceMeasurementMeasuredTypeVolume  UnitPriceMeasurementMeasuredType = "VOLUME"
+	UnitPriceMeasurementMeasuredTypeWeight  UnitPriceMeasurementMeasuredType = "WEIGHT"
+	UnitPriceMeasurementMeasuredTypeLength  UnitPriceMeasurementMeasuredType = "LENGTH"
+	UnitPriceMeasurementMeasuredTypeArea    UnitPriceMeasurementMeasuredType = "AREA"
+)
+
+// Values returns all the values of UnitPriceMeasurementMeasuredType, in schema order.
+func (UnitPriceMeasurementMeasuredType) Values() []UnitPriceMeasurementMeasuredType {
+	return []UnitPriceMeasurementMeasuredType{
+		UnitPriceMeasurementMeasuredTypeVolume,
+		UnitPriceMeasurementMeasuredTypeWeight,
+		UnitPriceMeasurementMeasuredTypeLength,
+		UnitPriceMeasurementMeasuredTypeArea,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e UnitPriceMeasurementMeasuredType) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of UnitPriceMeasurementMeasuredType.
+func (e UnitPriceMeasurementMeasuredType) IsValid() bool {
+	switch e {
+	case UnitPriceMeasurementMeasuredTypeVolume, UnitPriceMeasurementMeasuredTypeWeight, UnitPriceMeasurementMeasuredTypeLength, UnitPriceMeasurementMeasuredTypeArea:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e UnitPriceMeasurementMeasuredType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// UnitPriceMeasurementMeasuredTypeUnknown, or returning an error if StrictEnums is set.
+func (e *UnitPriceMeasurementMeasuredType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+// UnitPriceMeasurementMeasuredUnit: The valid units of measurement for a unit price measurement.
+type UnitPriceMeasurementMeasuredUnit string
+
+const (
+	// UnitPriceMeasurementMeasuredUnitUnknown is the zero value of UnitPriceMeasurementMeasuredUnit. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	UnitPriceMeasurementMeasuredUnitUnknown UnitPriceMeasurementMeasuredUnit = ""
+	UnitPriceMeasurementMeasuredUnitMl      UnitPriceMeasurementMeasuredUnit = "ML"
+	UnitPriceMeasurementMeasuredUnitCl      UnitPriceMeasurementMeasuredUnit = "CL"
+	UnitPriceMeasurementMeasuredUnitL       UnitPriceMeasurementMeasuredUnit = "L"
+	UnitPriceMeasurementMeasuredUnitM3      UnitPriceMeasurementMeasuredUnit = "M3"
+	UnitPriceMeasurementMeasuredUnitMg      UnitPriceMeasurementMeasuredUnit = "MG"
+	UnitPriceMeasurementMeasuredUnitG       UnitPriceMeasurementMeasuredUnit = "G"
+	UnitPriceMeasurementMeasuredUnitKg      UnitPriceMeasurementMeasuredUnit = "KG"
+	UnitPriceMeasurementMeasuredUnitMm      UnitPriceMeasurementMeasuredUnit = "MM"
+	UnitPriceMeasurementMeasuredUnitCm      UnitPriceMeasurementMeasuredUnit = "CM"
+	UnitPriceMeasurementMeasuredUnitM       UnitPriceMeasurementMeasuredUnit = "M"
+	UnitPriceMeasurementMeasuredUnitM2      UnitPriceMeasurementMeasuredUnit = "M2"
+)
+
+// Values returns all the values of UnitPriceMeasurementMeasuredUnit, in schema order.
+func (UnitPriceMeasurementMeasuredUnit) Values() []UnitPriceMeasurementMeasuredUnit {
+	return []UnitPriceMeasurementMeasuredUnit{
+		UnitPriceMeasurementMeasuredUnitMl,
+		UnitPriceMeasurementMeasuredUnitCl,
+		UnitPriceMeasurementMeasuredUnitL,
+		UnitPriceMeasurementMeasuredUnitM3,
+		UnitPriceMeasurementMeasuredUnitMg,
+		UnitPriceMeasurementMeasuredUnitG,
+		UnitPriceMeasurementMeasuredUnitKg,
+		UnitPriceMeasurementMeasuredUnitMm,
+		UnitPriceMeasurementMeasuredUnitCm,
+		UnitPriceMeasurementMeasuredUnitM,
+		UnitPriceMeasurementMeasuredUnitM2,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e UnitPriceMeasurementMeasuredUnit) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of UnitPriceMeasurementMeasuredUnit.
+func (e UnitPriceMeasurementMeasuredUnit) IsValid() bool {
+	switch e {
+	case UnitPriceMeasurementMeasuredUnitMl, UnitPriceMeasurementMeasuredUnitCl, UnitPriceMeasurementMeasuredUnitL, UnitPriceMeasurementMeasuredUnitM3, UnitPriceMeasurementMeasuredUnitMg, UnitPriceMeasurementMeasuredUnitG, UnitPriceMeasurementMeasuredUnitKg, UnitPriceMeasurementMeasuredUnitMm, UnitPriceMeasurementMeasuredUnitCm, UnitPriceMeasurementMeasuredUnitM, UnitPriceMeasurementMeasuredUnitM2:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e UnitPriceMeasurementMeasuredUnit) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// UnitPriceMeasurementMeasuredUnitUnknown, or returning an error if StrictEnums is set.
+func (e *UnitPriceMeasurementMeasuredUnit) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+// WeightUnit: Units of measurement for weight.
+type WeightUnit string
+
+const (
+	// WeightUnitUnknown is the zero value of WeightUnit. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	WeightUnitUnknown   WeightUnit = ""
+	WeightUnitKilograms WeightUnit = "KILOGRAMS"
+	WeightUnitGrams     WeightUnit = "GRAMS"
+	WeightUnitPounds    WeightUnit = "POUNDS"
+	WeightUnitOunces    WeightUnit = "OUNCES"
+)
+
+// Values returns all the values of WeightUnit, in schema order.
+func (WeightUnit) Values() []WeightUnit {
+	return []WeightUnit{
+		WeightUnitKilograms,
+		WeightUnitGrams,
+		WeightUnitPounds,
+		WeightUnitOunces,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e WeightUnit) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of WeightUnit.
+func (e WeightUnit) IsValid() bool {
+	switch e {
+	case WeightUnitKilograms, WeightUnitGrams, WeightUnitPounds, WeightUnitOunces:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e WeightUnit) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// WeightUnitUnknown, or returning an error if StrictEnums is set.
+func (e *WeightUnit) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+// CountryCode: ISO 3166-1 alpha-2 country codes with some differences.
+type CountryCode string
+
+const (
+	// CountryCodeUnknown is the zero value of CountryCode. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	CountryCodeUnknown CountryCode = ""
+	CountryCodeAf      CountryCode = "AF"
+	CountryCodeAx      CountryCode = "AX"
+	CountryCodeAl      CountryCode = "AL"
+	CountryCodeDz      CountryCode = "DZ"
+	CountryCodeAd      CountryCode = "AD"
+	CountryCodeAo      CountryCode = "AO"
+	CountryCodeAi      CountryCode = "AI"
+	CountryCodeAg      CountryCode = "AG"
+	CountryCodeAr      CountryCode = "AR"
+	CountryCodeAm      CountryCode = "AM"
+	CountryCodeAw      CountryCode = "AW"
+	CountryCodeAc      CountryCode = "AC"
+	CountryCodeAu      CountryCode = "AU"
+	CountryCodeAt      CountryCode = "AT"
+	CountryCodeAz      CountryCode = "AZ"
+	CountryCodeBs      CountryCode = "BS"
+	CountryCodeBh      CountryCode = "BH"
+	CountryCodeBd      CountryCode = "BD"
+	CountryCodeBb      CountryCode = "BB"
+	CountryCodeBy      CountryCode = "BY"
+	CountryCodeBe      CountryCode = "BE"
+	CountryCodeBz      CountryCode = "BZ"
+	CountryCodeBj      CountryCode = "BJ"
+	CountryCodeBm      CountryCode = "BM"
+	CountryCodeBt      CountryCode = "BT"
+	CountryCodeBo      CountryCode = "BO"
+	CountryCodeBa      CountryCode = "BA"
+	CountryCodeBw      CountryCode = "BW"
+	CountryCodeBv      CountryCode = "BV"
+	CountryCodeBr      CountryCode = "BR"
+	CountryCodeIo      CountryCode = "IO"
+	CountryCodeBn      CountryCode = "BN"
+	CountryCodeBg      CountryCode = "BG"
+	CountryCodeBf      CountryCode = "BF"
+	CountryCodeBi      CountryCode = "BI"
+	CountryCodeKh      CountryCode = "KH"
+	CountryCodeCa      CountryCode = "CA"
+	CountryCodeCv      CountryCode = "CV"
+	CountryCodeBq      CountryCode = "BQ"
+	CountryCodeKy      CountryCode = "KY"
+	CountryCodeCf      CountryCode = "CF"
+	CountryCodeTd      CountryCode = "TD"
+	CountryCodeCl      CountryCode = "CL"
+	CountryCodeCn      CountryCode = "CN"
+	CountryCodeCx      CountryCode = "CX"
+	CountryCodeCc      CountryCode = "CC"
+	CountryCodeCo      CountryCode = "CO"
+	CountryCodeKm      CountryCode = "KM"
+	CountryCodeCg      CountryCode = "CG"
+	CountryCodeCd      CountryCode = "CD"
+	CountryCodeCk      CountryCode = "CK"
+	CountryCodeCr      CountryCode = "CR"
+	CountryCodeHr      CountryCode = "HR"
+	CountryCodeCu      CountryCode = "CU"
+	CountryCodeCw      CountryCode = "CW"
+	CountryCodeCy      CountryCode = "CY"
+	CountryCodeCz      CountryCode = "CZ"
+	CountryCodeCi      CountryCode = "CI"
+	CountryCodeDk      CountryCode = "DK"
+	CountryCodeDj      CountryCode = "DJ"
+	CountryCodeDm      CountryCode = "DM"
+	CountryCodeDo      CountryCode = "DO"
+	CountryCodeEc      CountryCode = "EC"
+	CountryCodeEg      CountryCode = "EG"
+	CountryCodeSv      CountryCode = "SV"
+	CountryCodeGq      CountryCode = "GQ"
+	CountryCodeEr      CountryCode = "ER"
+	CountryCodeEe      CountryCode = "EE"
+	CountryCodeSz      CountryCode = "SZ"
+	CountryCodeEt      CountryCode = "ET"
+	CountryCodeFk      CountryCode = "FK"
+	CountryCodeFo      CountryCode = "FO"
+	CountryCodeFj      CountryCode = "FJ"
+	CountryCodeFi      CountryCode = "FI"
+	CountryCodeFr      CountryCode = "FR"
+	CountryCodeGf      CountryCode = "GF"
+	CountryCodePf      CountryCode = "PF"
+	CountryCodeTf      CountryCode = "TF"
+	CountryCodeGa      CountryCode = "GA"
+	CountryCodeGm      CountryCode = "GM"
+	CountryCodeGe      CountryCode = "GE"
+	CountryCodeDe      CountryCode = "DE"
+	CountryCodeGh      CountryCode = "GH"
+	CountryCodeGi      CountryCode = "GI"
+	CountryCodeGr      CountryCode = "GR"
+	CountryCodeGl      CountryCode = "GL"
+	CountryCodeGd      CountryCode = "GD"
+	CountryCodeGp      CountryCode = "GP"
+	CountryCodeGt      CountryCode = "GT"
+	CountryCodeGg      CountryCode = "GG"
+	CountryCodeGn      CountryCode = "GN"
+	CountryCodeGw      CountryCode = "GW"
+	CountryCodeGy      CountryCode = "GY"
+	CountryCodeHt      CountryCode = "HT"
+	CountryCodeHm      CountryCode = "HM"
+	CountryCodeVa      CountryCode = "VA"
+	CountryCodeHn      CountryCode = "HN"
+	CountryCodeHk      CountryCode = "HK"
+	CountryCodeHu      CountryCode = "HU"
+	CountryCodeIs      CountryCode = "IS"
+	CountryCodeIn      CountryCode = "IN"
+	CountryCodeId      CountryCode = "ID"
+	CountryCodeIr      CountryCode = "IR"
+	CountryCodeIq      CountryCode = "IQ"
+	CountryCodeIe      CountryCode = "IE"
+	CountryCodeIm      CountryCode = "IM"
+	CountryCodeIl      CountryCode = "IL"
+	CountryCodeIt      CountryCode = "IT"
+	CountryCodeJm      CountryCode = "JM"
+	CountryCodeJp      CountryCode = "JP"
+	CountryCodeJe      CountryCode = "JE"
+	CountryCodeJo      CountryCode = "JO"
+	CountryCodeKz      CountryCode = "KZ"
+	CountryCodeKe      CountryCode = "KE"
+	CountryCodeKi      CountryCode = "KI"
+	CountryCodeKp      CountryCode = "KP"
+	CountryCodeXk      CountryCode = "XK"
+	CountryCodeKw      CountryCode = "KW"
+	CountryCodeKg      CountryCode = "KG"
+	CountryCodeLa      CountryCode = "LA"
+	CountryCodeLv      CountryCode = "LV"
+	CountryCodeLb      CountryCode = "LB"
+	CountryCodeLs      CountryCode = "LS"
+	CountryCodeLr      CountryCode = "LR"
+	CountryCodeLy      CountryCode = "LY"
+	CountryCodeLi      CountryCode = "LI"
+	CountryCodeLt      CountryCode = "LT"
+	CountryCodeLu      CountryCode = "LU"
+	CountryCodeMo      CountryCode = "MO"
+	CountryCodeMg      CountryCode = "MG"
+	CountryCodeMw      CountryCode = "MW"
+	CountryCodeMy      CountryCode = "MY"
+	CountryCodeMv      CountryCode = "MV"
+	CountryCodeMl      CountryCode = "ML"
+	CountryCodeMt      CountryCode = "MT"
+	CountryCodeMq      CountryCode = "MQ"
+	CountryCodeMr      CountryCode = "MR"
+	CountryCodeMu      CountryCode = "MU"
+	CountryCodeYt      CountryCode = "YT"
+	CountryCodeMx      CountryCode = "MX"
+	CountryCodeMd      CountryCode = "MD"
+	CountryCodeMc      CountryCode = "MC"
+	CountryCodeMn      CountryCode = "MN"
+	CountryCodeMe      CountryCode = "ME"
+	CountryCodeMs      CountryCode = "MS"
+	CountryCodeMa      CountryCode = "MA"
+	CountryCodeMz      CountryCode = "MZ"
+	CountryCodeMm      CountryCode = "MM"
+	CountryCodeNa      CountryCode = "NA"
+	CountryCodeNr      CountryCode = "NR"
+	CountryCodeNp      CountryCode = "NP"
+	CountryCodeNl      CountryCode = "NL"
+	CountryCodeAn      CountryCode = "AN"
+	CountryCodeNc      CountryCode = "NC"
+	CountryCodeNz      CountryCode = "NZ"
+	CountryCodeNi      CountryCode = "NI"
+	CountryCodeNe      CountryCode = "NE"
+	CountryCodeNg      CountryCode = "NG"
+	CountryCodeNu      CountryCode = "NU"
+	CountryCodeNf      CountryCode = "NF"
+	CountryCodeMk      CountryCode = "MK"
+	CountryCodeNo      CountryCode = "NO"
+	CountryCodeOm      CountryCode = "OM"
+	CountryCodePk      CountryCode = "PK"
+	CountryCodePs      CountryCode = "PS"
+	CountryCodePa      CountryCode = "PA"
+	CountryCodePg      CountryCode = "PG"
+	CountryCodePy      CountryCode = "PY"
+	CountryCodePe      CountryCode = "PE"
+	CountryCodePh      CountryCode = "PH"
+	CountryCodePn      CountryCode = "PN"
+	CountryCodePl      CountryCode = "PL"
+	CountryCodePt      CountryCode = "PT"
+	CountryCodeQa      CountryCode = "QA"
+	CountryCodeCm      CountryCode = "CM"
+	CountryCodeRe      CountryCode = "RE"
+	CountryCodeRo      CountryCode = "RO"
+	CountryCodeRu      CountryCode = "RU"
+	CountryCodeRw      CountryCode = "RW"
+	CountryCodeBl      CountryCode = "BL"
+	CountryCodeSh      CountryCode = "SH"
+	CountryCodeKn      CountryCode = "KN"
+	CountryCodeLc      CountryCode = "LC"
+	CountryCodeMf      CountryCode = "MF"
+	CountryCodePm      CountryCode = "PM"
+	CountryCodeWs      CountryCode = "WS"
+	CountryCodeSm      CountryCode = "SM"
+	CountryCodeSt      CountryCode = "ST"
+	CountryCodeSa      CountryCode = "SA"
+	CountryCodeSn      CountryCode = "SN"
+	CountryCodeRs      CountryCode = "RS"
+	CountryCodeSc      CountryCode = "SC"
+	CountryCodeSl      CountryCode = "SL"
+	CountryCodeSg      CountryCode = "SG"
+	CountryCodeSx      CountryCode = "SX"
+	CountryCodeSk      CountryCode = "SK"
+	CountryCodeSi      CountryCode = "SI"
+	CountryCodeSb      CountryCode = "SB"
+	CountryCodeSo      CountryCode = "SO"
+	CountryCodeZa      CountryCode = "ZA"
+	CountryCodeGs      CountryCode = "GS"
+	CountryCodeKr      CountryCode = "KR"
+	CountryCodeSs      CountryCode = "SS"
+	CountryCodeEs      CountryCode = "ES"
+	CountryCodeLk      CountryCode = "LK"
+	CountryCodeVc      CountryCode = "VC"
+	CountryCodeSd      CountryCode = "SD"
+	CountryCodeSr      CountryCode = "SR"
+	CountryCodeSj      CountryCode = "SJ"
+	CountryCodeSe      CountryCode = "SE"
+	CountryCodeCh      CountryCode = "CH"
+	CountryCodeSy      CountryCode = "SY"
+	CountryCodeTw      CountryCode = "TW"
+	CountryCodeTj      CountryCode = "TJ"
+	CountryCodeTz      CountryCode = "TZ"
+	CountryCodeTh      CountryCode = "TH"
+	CountryCodeTl      CountryCode = "TL"
+	CountryCodeTg      CountryCode = "TG"
+	CountryCodeTk      CountryCode = "TK"
+	CountryCodeTo      CountryCode = "TO"
+	CountryCodeTt      CountryCode = "TT"
+	CountryCodeTa      CountryCode = "TA"
+	CountryCodeTn      CountryCode = "TN"
+	CountryCodeTr      CountryCode = "TR"
+	CountryCodeTm      CountryCode = "TM"
+	CountryCodeTc      CountryCode = "TC"
+	CountryCodeTv      CountryCode = "TV"
+	CountryCodeUg      CountryCode = "UG"
+	CountryCodeUa      CountryCode = "UA"
+	CountryCodeAe      CountryCode = "AE"
+	CountryCodeGb      CountryCode = "GB"
+	CountryCodeUs      CountryCode = "US"
+	CountryCodeUm      CountryCode = "UM"
+	CountryCodeUy      CountryCode = "UY"
+	CountryCodeUz      CountryCode = "UZ"
+	CountryCodeVu      CountryCode = "VU"
+	CountryCodeVe      CountryCode = "VE"
+	CountryCodeVn      CountryCode = "VN"
+	CountryCodeVg      CountryCode = "VG"
+	CountryCodeWf      CountryCode = "WF"
+	CountryCodeEh      CountryCode = "EH"
+	CountryCodeYe      CountryCode = "YE"
+	CountryCodeZm      CountryCode = "ZM"
+	CountryCodeZw      CountryCode = "ZW"
+	CountryCodeZz      CountryCode = "ZZ"
+)
+
+// Values returns all the values of CountryCode, in schema order.
+func (CountryCode) Values() []CountryCode {
+	return []CountryCode{
+		CountryCodeAf,
+		CountryCodeAx,
+		CountryCodeAl,
+		CountryCodeDz,
+		CountryCodeAd,
+		CountryCodeAo,
+		CountryCodeAi,
+		CountryCodeAg,
+		CountryCodeAr,
+		CountryCodeAm,
+		CountryCodeAw,
+		CountryCodeAc,
+		CountryCodeAu,
+		CountryCodeAt,
+		CountryCodeAz,
+		CountryCodeBs,
+		CountryCodeBh,
+		CountryCodeBd,
+		CountryCodeBb,
+		CountryCodeBy,
+		CountryCodeBe,
+		CountryCodeBz,
+		CountryCodeBj,
+		CountryCodeBm,
+		CountryCodeBt,
+		CountryCodeBo,
+		CountryCodeBa,
+		CountryCodeBw,
+		CountryCodeBv,
+		CountryCodeBr,
+		CountryCodeIo,
+		CountryCodeBn,
+		CountryCodeBg,
+		CountryCodeBf,
+		CountryCodeBi,
+		CountryCodeKh,
+		CountryCodeCa,
+		CountryCodeCv,
+		CountryCodeBq,
+		CountryCodeKy,
+		CountryCodeCf,
+		CountryCodeTd,
+		CountryCodeCl,
+		CountryCodeCn,
+		CountryCodeCx,
+		CountryCodeCc,
+		CountryCodeCo,
+		CountryCodeKm,
+		CountryCodeCg,
+		CountryCodeCd,
+		CountryCodeCk,
+		CountryCodeCr,
+		CountryCodeHr,
+		CountryCodeCu,
+		CountryCodeCw,
+		CountryCodeCy,
+		CountryCodeCz,
+		CountryCodeCi,
+		CountryCodeDk,
+		CountryCodeDj,
+		CountryCodeDm,
+		CountryCodeDo,
+		CountryCodeEc,
+		CountryCodeEg,
+		CountryCodeSv,
+		CountryCodeGq,
+		CountryCodeEr,
+		CountryCodeEe,
+		CountryCodeSz,
+		CountryCodeEt,
+		CountryCodeFk,
+		CountryCodeFo,
+		CountryCodeFj,
+		CountryCodeFi,
+		CountryCodeFr,
+		CountryCodeGf,
+		CountryCodePf,
+		CountryCodeTf,
+		CountryCodeGa,
+		CountryCodeGm,
+		CountryCodeGe,
+		CountryCodeDe,
+		CountryCodeGh,
+		CountryCodeGi,
+		CountryCodeGr,
+		CountryCodeGl,
+		CountryCodeGd,
+		CountryCodeGp,
+		CountryCodeGt,
+		CountryCodeGg,
+		CountryCodeGn,
+		CountryCodeGw,
+		CountryCodeGy,
+		CountryCodeHt,
+		CountryCodeHm,
+		CountryCodeVa,
+		CountryCodeHn,
+		CountryCodeHk,
+		CountryCodeHu,
+		CountryCodeIs,
+		CountryCodeIn,
+		CountryCodeId,
+		CountryCodeIr,
+		CountryCodeIq,
+		CountryCodeIe,
+		CountryCodeIm,
+		CountryCodeIl,
+		CountryCodeIt,
+		CountryCodeJm,
+		CountryCodeJp,
+		CountryCodeJe,
+		CountryCodeJo,
+		CountryCodeKz,
+		CountryCodeKe,
+		CountryCodeKi,
+		CountryCodeKp,
+		CountryCodeXk,
+		CountryCodeKw,
+		CountryCodeKg,
+		CountryCodeLa,
+		CountryCodeLv,
+		CountryCodeLb,
+		CountryCodeLs,
+		CountryCodeLr,
+		CountryCodeLy,
+		CountryCodeLi,
+		CountryCodeLt,
+		CountryCodeLu,
+		CountryCodeMo,
+		CountryCodeMg,
+		CountryCodeMw,
+		CountryCodeMy,
+		CountryCodeMv,
+		CountryCodeMl,
+		CountryCodeMt,
+		CountryCodeMq,
+		CountryCodeMr,
+		CountryCodeMu,
+		CountryCodeYt,
+		CountryCodeMx,
+		CountryCodeMd,
+		CountryCodeMc,
+		CountryCodeMn,
+		CountryCodeMe,
+		CountryCodeMs,
+		CountryCodeMa,
+		CountryCodeMz,
+		CountryCodeMm,
+		CountryCodeNa,
+		CountryCodeNr,
+		CountryCodeNp,
+		CountryCodeNl,
+		CountryCodeAn,
+		CountryCodeNc,
+		CountryCodeNz,
+		CountryCodeNi,
+		CountryCodeNe,
+		CountryCodeNg,
+		CountryCodeNu,
+		CountryCodeNf,
+		CountryCodeMk,
+		CountryCodeNo,
+		CountryCodeOm,
+		CountryCodePk,
+		CountryCodePs,
+		CountryCodePa,
+		CountryCodePg,
+		CountryCodePy,
+		CountryCodePe,
+		CountryCodePh,
+		CountryCodePn,
+		CountryCodePl,
+		CountryCodePt,
+		CountryCodeQa,
+		CountryCodeCm,
+		CountryCodeRe,
+		CountryCodeRo,
+		CountryCodeRu,
+		CountryCodeRw,
+		CountryCodeBl,
+		CountryCodeSh,
+		CountryCodeKn,
+		CountryCodeLc,
+		CountryCodeMf,
+		CountryCodePm,
+		CountryCodeWs,
+		CountryCodeSm,
+		CountryCodeSt,
+		CountryCodeSa,
+		CountryCodeSn,
+		CountryCodeRs,
+		CountryCodeSc,
+		CountryCodeSl,
+		CountryCodeSg,
+		CountryCodeSx,
+		CountryCodeSk,
+		CountryCodeSi,
+		CountryCodeSb,
+		CountryCodeSo,
+		CountryCodeZa,
+		CountryCodeGs,
+		CountryCodeKr,
+		CountryCodeSs,
+		CountryCodeEs,
+		CountryCodeLk,
+		CountryCodeVc,
+		CountryCodeSd,
+		CountryCodeSr,
+		CountryCodeSj,
+		CountryCodeSe,
+		CountryCodeCh,
+		CountryCodeSy,
+		CountryCodeTw,
+		CountryCodeTj,
+		CountryCodeTz,
+		CountryCodeTh,
+		CountryCodeTl,
+		CountryCodeTg,
+		CountryCodeTk,
+		CountryCodeTo,
+		CountryCodeTt,
+		CountryCodeTa,
+		CountryCodeTn,
+		CountryCodeTr,
+		CountryCodeTm,
+		CountryCodeTc,
+		CountryCodeTv,
+		CountryCodeUg,
+		CountryCodeUa,
+		CountryCodeAe,
+		CountryCodeGb,
+		CountryCodeUs,
+		CountryCodeUm,
+		CountryCodeUy,
+		CountryCodeUz,
+		CountryCodeVu,
+		CountryCodeVe,
+		CountryCodeVn,
+		CountryCodeVg,
+		CountryCodeWf,
+		CountryCodeEh,
+		CountryCodeYe,
+		CountryCodeZm,
+		CountryCodeZw,
+		CountryCodeZz,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e CountryCode) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of CountryCode.
+func (e CountryCode) IsValid() bool {
+	switch e {
+	case CountryCodeAf, CountryCodeAx, CountryCodeAl, CountryCodeDz, CountryCodeAd, CountryCodeAo, CountryCodeAi, CountryCodeAg, CountryCodeAr, CountryCodeAm, CountryCodeAw, CountryCodeAc, CountryCodeAu, CountryCodeAt, CountryCodeAz, CountryCodeBs, CountryCodeBh, CountryCodeBd, CountryCodeBb, CountryCodeBy, CountryCodeBe, CountryCodeBz, CountryCodeBj, CountryCodeBm, CountryCodeBt, CountryCodeBo, CountryCodeBa, CountryCodeBw, CountryCodeBv, CountryCodeBr, CountryCodeIo, CountryCodeBn, CountryCodeBg, CountryCodeBf, CountryCodeBi, CountryCodeKh, CountryCodeCa, CountryCodeCv, CountryCodeBq, CountryCodeKy, CountryCodeCf, CountryCodeTd, CountryCodeCl, CountryCodeCn, CountryCodeCx, CountryCodeCc, CountryCodeCo, CountryCodeKm, CountryCodeCg, CountryCodeCd, CountryCodeCk, CountryCodeCr, CountryCodeHr, CountryCodeCu, CountryCodeCw, CountryCodeCy, CountryCodeCz, CountryCodeCi, CountryCodeDk, CountryCodeDj, CountryCodeDm, CountryCodeDo, CountryCodeEc, CountryCodeEg, CountryCodeSv, CountryCodeGq, CountryCodeEr, CountryCodeEe, CountryCodeSz, CountryCodeEt, CountryCodeFk, CountryCodeFo, CountryCodeFj, CountryCodeFi, CountryCodeFr, CountryCodeGf, CountryCodePf, CountryCodeTf, CountryCodeGa, CountryCodeGm, CountryCodeGe, CountryCodeDe, CountryCodeGh, CountryCodeGi, CountryCodeGr, CountryCodeGl, CountryCodeGd, CountryCodeGp, CountryCodeGt, CountryCodeGg, CountryCodeGn, CountryCodeGw, CountryCodeGy, CountryCodeHt, CountryCodeHm, CountryCodeVa, CountryCodeHn, CountryCodeHk, CountryCodeHu, CountryCodeIs, CountryCodeIn, CountryCodeId, CountryCodeIr, CountryCodeIq, CountryCodeIe, CountryCodeIm, CountryCodeIl, CountryCodeIt, CountryCodeJm, CountryCodeJp, CountryCodeJe, CountryCodeJo, CountryCodeKz, CountryCodeKe, CountryCodeKi, CountryCodeKp, CountryCodeXk, CountryCodeKw, CountryCodeKg, CountryCodeLa, CountryCodeLv, CountryCodeLb, CountryCodeLs, CountryCodeLr, CountryCodeLy, CountryCodeLi, CountryCodeLt, CountryCodeLu, CountryCodeMo, CountryCodeMg, CountryCodeMw, CountryCodeMy, CountryCodeMv, CountryCodeMl, CountryCodeMt, CountryCodeMq, CountryCodeMr, CountryCodeMu, CountryCodeYt, CountryCodeMx, CountryCodeMd, CountryCodeMc, CountryCodeMn, CountryCodeMe, CountryCodeMs, CountryCodeMa, CountryCodeMz, CountryCodeMm, CountryCodeNa, CountryCodeNr, CountryCodeNp, CountryCodeNl, CountryCodeAn, CountryCodeNc, CountryCodeNz, CountryCodeNi, CountryCodeNe, CountryCodeNg, CountryCodeNu, CountryCodeNf, CountryCodeMk, CountryCodeNo, CountryCodeOm, CountryCodePk, CountryCodePs, CountryCodePa, CountryCodePg, CountryCodePy, CountryCodePe, CountryCodePh, CountryCodePn, CountryCodePl, CountryCodePt, CountryCodeQa, CountryCodeCm, CountryCodeRe, CountryCodeRo, CountryCodeRu, CountryCodeRw, CountryCodeBl, CountryCodeSh, CountryCodeKn, CountryCodeLc, CountryCodeMf, CountryCodePm, CountryCodeWs, CountryCodeSm, CountryCodeSt, CountryCodeSa, CountryCodeSn, CountryCodeRs, CountryCodeSc, CountryCodeSl, CountryCodeSg, CountryCodeSx, CountryCodeSk, CountryCodeSi, CountryCodeSb, CountryCodeSo, CountryCodeZa, CountryCodeGs, CountryCodeKr, CountryCodeSs, CountryCodeEs, CountryCodeLk, CountryCodeVc, CountryCodeSd, CountryCodeSr, CountryCodeSj, CountryCodeSe, CountryCodeCh, CountryCodeSy, CountryCodeTw, CountryCodeTj, CountryCodeTz, CountryCodeTh, CountryCodeTl, CountryCodeTg, CountryCodeTk, CountryCodeTo, CountryCodeTt, CountryCodeTa, CountryCodeTn, CountryCodeTr, CountryCodeTm, CountryCodeTc, CountryCodeTv, CountryCodeUg, CountryCodeUa, CountryCodeAe, CountryCodeGb, CountryCodeUs, CountryCodeUm, CountryCodeUy, CountryCodeUz, CountryCodeVu, CountryCodeVe, CountryCodeVn, CountryCodeVg, CountryCodeWf, CountryCodeEh, CountryCodeYe, CountryCodeZm, CountryCodeZw, CountryCodeZz:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e CountryCode) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// CountryCodeUnknown, or returning an error if StrictEnums is set.
+func (e *CountryCode) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
@@ -0,0 +1,186 @@
+package storefront
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnums(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(CurrencyCodeUsd.IsValid())
+	assert.True(CountryCode("CA").IsValid())
+	assert.True(WeightUnit("KILOGRAMS").IsValid())
+	assert.False(CurrencyCode("usd").IsValid())
+	assert.False(CountryCode("QQ").IsValid())
+	assert.Equal("GBP", CurrencyCodeGbp.String())
+
+	var s Shop
+
+	assert.NoError(json.Unmarshal([]byte(`{"paymentSettings": {"countryCode": "CA", "currencyCode": "CAD"}}`), &s))
+	assert.Equal(CountryCodeCa, s.PaymentSettings.CountryCode)
+	assert.Equal(CurrencyCodeCad, s.PaymentSettings.CurrencyCode)
+}
+
+func TestCurrencyCode_MinorUnits(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(int32(2), CurrencyCodeUsd.MinorUnits())
+	assert.Equal(int32(0), CurrencyCodeJpy.MinorUnits())
+	assert.Equal(int32(0), CurrencyCodeKrw.MinorUnits())
+	assert.Equal(int32(3), CurrencyCodeKwd.MinorUnits())
+	assert.Equal(int32(2), CurrencyCode("ZZZ").MinorUnits())
+}
+
+func TestEnum_Values(t *testing.T) {
+	assert := assert.New(t)
+
+	values := ProductSortKeys("").Values()
+	assert.Contains(values, ProductSortKeysBestSelling)
+	assert.NotContains(values, ProductSortKeysUnknown)
+
+	for _, v := range values {
+		assert.True(v.IsValid(), v)
+	}
+
+	assert.False(ProductSortKeysUnknown.IsValid())
+	assert.False(ProductSortKeys("BEST_SELLER").IsValid())
+}
+
+func TestEnum_JSON(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		var e ProductSortKeys
+
+		assert.NoError(json.Unmarshal([]byte(`"PRICE"`), &e))
+		assert.Equal(ProductSortKeysPrice, e)
+
+		assert.NoError(json.Unmarshal([]byte(`null`), &e))
+		assert.Equal(ProductSortKeysPrice, e)
+
+		assert.NoError(json.Unmarshal([]byte(`"POPULARITY"`), &e))
+		assert.Equal(ProductSortKeysUnknown, e)
+	})
+
+	t.Run("Strict", func(t *testing.T) {
+		StrictEnums = true
+		defer func() { StrictEnums = false }()
+
+		var e ProductSortKeys
+
+		assert.NoError(json.Unmarshal([]byte(`"PRICE"`), &e))
+		assert.ErrorIs(json.Unmarshal([]byte(`"POPULARITY"`), &e), ErrInvalidEnum)
+		assert.Equal(ProductSortKeysPrice, e)
+	})
+
+	t.Run("StrictClient", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"data": {
+				"shop": {"paymentSettings": {"cc": "CA", "currencyCode": "CAD"}},
+				"node": {"__typename": "Product", "options": [{"name": "Size"}]},
+				"localization": {"country": {"isoCode": "QQ"}}
+			}}`))
+		}))
+		defer srv.Close()
+
+		const q = `{
+  shop { paymentSettings { cc: countryCode currencyCode } }
+  node(id: "gid://shopify/Product/1") { __typename ...Options }
+  localization { country { isoCode } }
+}
+
+fragment Options on Product { options { name } }`
+
+		var res struct {
+			Data struct {
+				Localization Localization `json:"localization"`
+			} `json:"data"`
+		}
+
+		lenient, err := New("example.myshopify.com", "token", WithEndpoint(srv.URL))
+		if assert.NoError(err) && assert.NoError(lenient.Query(q, &res)) {
+			assert.Equal(CountryCodeUnknown, res.Data.Localization.Country.IsoCode)
+		}
+
+		strict, err := New("example.myshopify.com", "token", WithEndpoint(srv.URL), WithStrictEnums())
+		if assert.NoError(err) {
+			err = strict.Query(q, &res)
+			assert.ErrorIs(err, ErrInvalidEnum)
+			assert.EqualError(err, `storefront: invalid enum value: "QQ" for CountryCode`)
+		}
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		bs, err := json.Marshal(ProductSortKeysPrice)
+		if assert.NoError(err) {
+			assert.Equal(`"PRICE"`, string(bs))
+		}
+
+		bs, err = json.Marshal(ProductSortKeysUnknown)
+		if assert.NoError(err) {
+			assert.Equal(`null`, string(bs))
+		}
+	})
+
+	t.Run("MarshalResponse", func(t *testing.T) {
+		// Enums which weren't selected are left as the Unknown sentinel, and a
+		// decoded response still encodes and decodes to the same value.
+		var p Product
+		if !assert.NoError(json.Unmarshal([]byte(`{"title": "x", "priceRange": {"minVariantPrice": {"amount": "1.50"}}}`), &p)) {
+			return
+		}
+
+		bs, err := json.Marshal(p)
+		if !assert.NoError(err) {
+			return
+		}
+
+		var again Product
+		if assert.NoError(json.Unmarshal(bs, &again)) {
+			assert.Equal("x", again.Title)
+			assert.Equal(CurrencyCodeUnknown, again.PriceRange.MinVariantPrice.CurrencyCode)
+			assert.Equal("1.50", again.PriceRange.MinVariantPrice.Amount.String())
+		}
+	})
+
+	t.Run("Variables", func(t *testing.T) {
+		var requests int
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Write([]byte(`{"data": {}}`))
+		}))
+		defer srv.Close()
+
+		c, err := New("example.myshopify.com", "token", WithEndpoint(srv.URL))
+		if !assert.NoError(err) {
+			return
+		}
+
+		var res struct{}
+
+		for _, vars := range []map[string]interface{}{
+			{"sortKey": ProductSortKeys("BEST_SELLER")},
+			{"sortKey": Ptr(ProductSortKeysUnknown)},
+			{"input": MoneyInput{Amount: MustParseDecimal("1.00")}},
+			{"input": CartInput{BuyerIdentity: Some(CartBuyerIdentityInput{CountryCode: Some(CountryCode("QQ"))})}},
+		} {
+			assert.ErrorIs(c.Do(&Request{Query: "{ shop { name } }", Variables: vars}, &res), ErrInvalidEnum)
+		}
+
+		assert.Equal(0, requests)
+
+		err = c.Do(&Request{Query: "{ shop { name } }", Variables: map[string]interface{}{
+			"sortKey": ProductSortKeysPrice,
+			"input":   CartInput{Note: Some("Gift")},
+		}}, &res)
+
+		assert.NoError(err)
+		assert.Equal(1, requests)
+	})
+}
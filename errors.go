@@ -0,0 +1,222 @@
+package storefront
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrUnauthorized matches HTTP errors indicating the access token is missing
+	// or invalid.
+	ErrUnauthorized = errors.New("storefront: unauthorized")
+	// ErrShopUnavailable matches HTTP errors indicating the shop is frozen,
+	// locked or otherwise unavailable.
+	ErrShopUnavailable = errors.New("storefront: shop unavailable")
+	// ErrVersionNotFound matches HTTP errors indicating the endpoint doesn't
+	// exist, most commonly because of an unsupported API version.
+	ErrVersionNotFound = errors.New("storefront: API version not found")
+)
+
+// maxErrorBodyLen is the number of bytes of a response body retained by an
+// HTTPError.
+const maxErrorBodyLen = 1024
+
+// HTTPError describes a response from the Storefront API with a non-2xx
+// status code.
+type HTTPError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Header is the set of response headers.
+	Header http.Header
+	// RequestID is the value of the X-Request-Id response header, which Shopify
+	// support may ask for.
+	RequestID string
+	// Body is the response body, truncated to a reasonable length.
+	Body string
+}
+
+// newHTTPError constructs an HTTPError from a response and its body.
+func newHTTPError(res *http.Response, body []byte) *HTTPError {
+	if len(body) > maxErrorBodyLen {
+		body = body[:maxErrorBodyLen]
+	}
+
+	return &HTTPError{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		RequestID:  res.Header.Get("X-Request-Id"),
+		Body:       string(body),
+	}
+}
+
+// Error implements the error interface.
+func (e *HTTPError) Error() string {
+	msg := fmt.Sprintf("storefront: unexpected HTTP status %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+
+	return msg
+}
+
+// Is reports whether the error's status code corresponds to target, such that
+// errors.Is(err, ErrUnauthorized) holds for a 401 or 403 response.
+func (e *HTTPError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrShopUnavailable:
+		return e.StatusCode == http.StatusPaymentRequired || e.StatusCode == http.StatusLocked
+	case ErrVersionNotFound:
+		return e.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
+
+// Error codes the Storefront API reports in the extensions of a GraphQLError.
+const (
+	CodeThrottled           = "THROTTLED"
+	CodeAccessDenied        = "ACCESS_DENIED"
+	CodeShopInactive        = "SHOP_INACTIVE"
+	CodeInternalServerError = "INTERNAL_SERVER_ERROR"
+)
+
+var (
+	// ErrThrottled matches GraphQL errors indicating the request exceeded the
+	// API's rate limits.
+	ErrThrottled = errors.New("storefront: throttled")
+	// ErrAccessDenied matches GraphQL errors indicating the access token lacks
+	// the scope required for a field.
+	ErrAccessDenied = errors.New("storefront: access denied")
+	// ErrShopInactive matches GraphQL errors indicating the shop is not active.
+	ErrShopInactive = errors.New("storefront: shop inactive")
+	// ErrInternalServerError matches GraphQL errors indicating an internal
+	// error on Shopify's end.
+	ErrInternalServerError = errors.New("storefront: internal server error")
+)
+
+// codeErrs maps extension codes to the sentinel errors they match.
+var codeErrs = map[string]error{
+	CodeThrottled:           ErrThrottled,
+	CodeAccessDenied:        ErrAccessDenied,
+	CodeShopInactive:        ErrShopInactive,
+	CodeInternalServerError: ErrInternalServerError,
+}
+
+// ErrorLocation describes a position within the query document to which a
+// GraphQLError refers.
+type ErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLError describes a single entry of the top-level errors array of a
+// GraphQL response.
+type GraphQLError struct {
+	// Message is a description of the error.
+	Message string `json:"message"`
+	// Locations is a list of positions in the query document associated with
+	// the error.
+	Locations []ErrorLocation `json:"locations,omitempty"`
+	// Path is the path of the response field which experienced the error, made
+	// up of field names (strings) and list indices (numbers).
+	Path []interface{} `json:"path,omitempty"`
+	// Extensions is a map of additional, implementation-specific data, such as
+	// the error code.
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Code returns the error code from the error's extensions, or an empty string
+// if there isn't one.
+func (e *GraphQLError) Code() string {
+	code, _ := e.Extensions["code"].(string)
+	return code
+}
+
+// Error implements the error interface.
+func (e *GraphQLError) Error() string {
+	if code := e.Code(); code != "" {
+		return fmt.Sprintf("storefront: %s (%s)", e.Message, code)
+	}
+
+	return "storefront: " + e.Message
+}
+
+// Is reports whether the error's code corresponds to target, such that
+// errors.Is(err, ErrThrottled) holds for an error with the THROTTLED code.
+func (e *GraphQLError) Is(target error) bool {
+	err, ok := codeErrs[e.Code()]
+	return ok && err == target
+}
+
+// GraphQLErrors describes the full list of errors returned in a GraphQL
+// response. Query and its variants return it whenever the response contains
+// errors, in which case any data in the response has still been unmarshaled.
+type GraphQLErrors []*GraphQLError
+
+// Error implements the error interface.
+func (errs GraphQLErrors) Error() string {
+	return joinErrors(errs)
+}
+
+// Is reports whether any of the errors match target.
+func (errs GraphQLErrors) Is(target error) bool {
+	for _, err := range errs {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As assigns the first of the errors to target if it's a **GraphQLError.
+func (errs GraphQLErrors) As(target interface{}) bool {
+	if t, ok := target.(**GraphQLError); ok && len(errs) != 0 {
+		*t = errs[0]
+		return true
+	}
+
+	return false
+}
+
+// joinErrors returns the messages of errs as a single message, stripped of
+// their individual prefixes.
+func joinErrors[E error](errs []E) string {
+	switch len(errs) {
+	case 0:
+		return "storefront: no errors"
+	case 1:
+		return errs[0].Error()
+	}
+
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = strings.TrimPrefix(err.Error(), "storefront: ")
+	}
+
+	return "storefront: " + strings.Join(msgs, "; ")
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Besides the usual array of error
+// objects, the API sometimes responds with a bare string, which is treated as
+// a single error's message.
+func (errs *GraphQLErrors) UnmarshalJSON(data []byte) error {
+	var msg string
+	if err := json.Unmarshal(data, &msg); err == nil {
+		*errs = GraphQLErrors{{Message: msg}}
+		return nil
+	}
+
+	var list []*GraphQLError
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+
+	*errs = list
+	return nil
+}
@@ -0,0 +1,149 @@
+package storefront
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphQLErrors_UnmarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("List", func(t *testing.T) {
+		var errs GraphQLErrors
+
+		assert.NoError(json.Unmarshal([]byte(`[{
+			"message": "Field 'nme' doesn't exist on type 'Shop'",
+			"locations": [{"line": 1, "column": 9}],
+			"path": ["query", "shop", "nme"],
+			"extensions": {"code": "undefinedField"}
+		}]`), &errs))
+
+		assert.Len(errs, 1)
+		assert.Equal("Field 'nme' doesn't exist on type 'Shop'", errs[0].Message)
+		assert.Equal([]ErrorLocation{{Line: 1, Column: 9}}, errs[0].Locations)
+		assert.Equal([]interface{}{"query", "shop", "nme"}, errs[0].Path)
+		assert.Equal("undefinedField", errs[0].Code())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		var errs GraphQLErrors
+
+		assert.NoError(json.Unmarshal([]byte(`"Invalid API key or access token"`), &errs))
+		assert.Len(errs, 1)
+		assert.Equal("Invalid API key or access token", errs[0].Message)
+	})
+}
+
+func TestGraphQLErrors_Is(t *testing.T) {
+	assert := assert.New(t)
+
+	errs := GraphQLErrors{
+		{Message: "Access denied", Extensions: map[string]interface{}{"code": CodeAccessDenied}},
+		{Message: "Throttled", Extensions: map[string]interface{}{"code": CodeThrottled}},
+	}
+
+	var err error = errs
+
+	assert.ErrorIs(err, ErrThrottled)
+	assert.ErrorIs(err, ErrAccessDenied)
+	assert.NotErrorIs(err, ErrShopInactive)
+	assert.ErrorIs(fmt.Errorf("wrapped: %w", err), ErrThrottled)
+}
+
+func TestGraphQLErrors_As(t *testing.T) {
+	assert := assert.New(t)
+
+	var err error = GraphQLErrors{{Message: "Throttled"}}
+
+	var gqlErr *GraphQLError
+	assert.True(errors.As(err, &gqlErr))
+	assert.Equal("Throttled", gqlErr.Message)
+
+	var gqlErrs GraphQLErrors
+	assert.True(errors.As(err, &gqlErrs))
+	assert.Len(gqlErrs, 1)
+}
+
+func TestClient_Do_GraphQLErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"data": {"shop": {"name": "Shop name"}},
+			"errors": [{"message": "Throttled", "extensions": {"code": "THROTTLED"}}]
+		}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("DOMAIN", "API_KEY")
+	c.endpoint = srv.URL
+
+	var set Set
+
+	err := c.Query("{ shop { name } }", &set)
+	assert.ErrorIs(err, ErrThrottled)
+	assert.EqualError(err, "storefront: Throttled (THROTTLED)")
+	assert.Equal("Shop name", set.Data.Shop.Name)
+}
+
+func TestHTTPError_Is(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		status int
+		target error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusPaymentRequired, ErrShopUnavailable},
+		{http.StatusLocked, ErrShopUnavailable},
+		{http.StatusNotFound, ErrVersionNotFound},
+	}
+
+	for _, tt := range tests {
+		err := &HTTPError{StatusCode: tt.status}
+		assert.ErrorIs(err, tt.target, "status %d", tt.status)
+	}
+
+	assert.NotErrorIs(&HTTPError{StatusCode: http.StatusInternalServerError}, ErrUnauthorized)
+}
+
+func TestClient_Do_HTTPError(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc-123")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"errors":"[API] Invalid API key or access token (unrecognized login or wrong password)"}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("DOMAIN", "API_KEY")
+	c.endpoint = srv.URL
+
+	var set Set
+
+	err := c.Query("{ shop { name } }", &set)
+	assert.ErrorIs(err, ErrUnauthorized)
+
+	var httpErr *HTTPError
+	if assert.ErrorAs(err, &httpErr) {
+		assert.Equal(http.StatusUnauthorized, httpErr.StatusCode)
+		assert.Equal("abc-123", httpErr.RequestID)
+		assert.Contains(httpErr.Body, "Invalid API key")
+		assert.EqualError(err, "storefront: unexpected HTTP status 401 Unauthorized (request ID abc-123)")
+	}
+}
+
+func TestNewHTTPError_TruncatesBody(t *testing.T) {
+	res := &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}
+	body := make([]byte, maxErrorBodyLen*2)
+
+	assert.Len(t, newHTTPError(res, body).Body, maxErrorBodyLen)
+}
@@ -0,0 +1,272 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// ErrNoSchema indicates an introspection result without a __schema field.
+var ErrNoSchema = errors.New("graphql: introspection result has no __schema")
+
+// IntrospectionQuery is the standard query introspecting a schema's types,
+// including deprecated fields and enum values, and directives.
+const IntrospectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types {
+      ...FullType
+    }
+    directives {
+      name
+      description
+      locations
+      args {
+        ...InputValue
+      }
+    }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  description
+  fields(includeDeprecated: true) {
+    name
+    description
+    args {
+      ...InputValue
+    }
+    type {
+      ...TypeRef
+    }
+    isDeprecated
+    deprecationReason
+  }
+  inputFields {
+    ...InputValue
+  }
+  interfaces {
+    ...TypeRef
+  }
+  enumValues(includeDeprecated: true) {
+    name
+    description
+    isDeprecated
+    deprecationReason
+  }
+  possibleTypes {
+    ...TypeRef
+  }
+}
+
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+            ofType {
+              kind
+              name
+              ofType {
+                kind
+                name
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+
+// Introspection is the result of IntrospectionQuery, as found in the data
+// field of the response, and in the schema.json files introspection tools
+// write.
+type Introspection struct {
+	Schema *Schema `json:"__schema"`
+}
+
+// ParseIntrospection decodes the schema from the result of IntrospectionQuery,
+// given either as the data of the response, or as the whole response.
+func ParseIntrospection(data []byte) (*Schema, error) {
+	var res struct {
+		Introspection
+		Data *Introspection `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.Schema != nil:
+		return res.Schema, nil
+	case res.Data != nil && res.Data.Schema != nil:
+		return res.Data.Schema, nil
+	default:
+		return nil, ErrNoSchema
+	}
+}
+
+// introspectionSDL defines the types of the introspection system, which every
+// schema implicitly includes, though neither SDL nor, in every case,
+// introspection results define them.
+const introspectionSDL = `
+type __Schema {
+  description: String
+  types: [__Type!]!
+  queryType: __Type!
+  mutationType: __Type
+  subscriptionType: __Type
+  directives: [__Directive!]!
+}
+
+type __Type {
+  kind: __TypeKind!
+  name: String
+  description: String
+  fields(includeDeprecated: Boolean = false): [__Field!]
+  interfaces: [__Type!]
+  possibleTypes: [__Type!]
+  enumValues(includeDeprecated: Boolean = false): [__EnumValue!]
+  inputFields(includeDeprecated: Boolean = false): [__InputValue!]
+  ofType: __Type
+  specifiedByURL: String
+}
+
+enum __TypeKind {
+  SCALAR
+  OBJECT
+  INTERFACE
+  UNION
+  ENUM
+  INPUT_OBJECT
+  LIST
+  NON_NULL
+}
+
+type __Field {
+  name: String!
+  description: String
+  args(includeDeprecated: Boolean = false): [__InputValue!]!
+  type: __Type!
+  isDeprecated: Boolean!
+  deprecationReason: String
+}
+
+type __InputValue {
+  name: String!
+  description: String
+  type: __Type!
+  defaultValue: String
+  isDeprecated: Boolean!
+  deprecationReason: String
+}
+
+type __EnumValue {
+  name: String!
+  description: String
+  isDeprecated: Boolean!
+  deprecationReason: String
+}
+
+type __Directive {
+  name: String!
+  description: String
+  locations: [__DirectiveLocation!]!
+  args(includeDeprecated: Boolean = false): [__InputValue!]!
+  isRepeatable: Boolean!
+}
+
+enum __DirectiveLocation {
+  QUERY
+  MUTATION
+  SUBSCRIPTION
+  FIELD
+  FRAGMENT_DEFINITION
+  FRAGMENT_SPREAD
+  INLINE_FRAGMENT
+  VARIABLE_DEFINITION
+  SCHEMA
+  SCALAR
+  OBJECT
+  FIELD_DEFINITION
+  ARGUMENT_DEFINITION
+  INTERFACE
+  UNION
+  ENUM
+  ENUM_VALUE
+  INPUT_OBJECT
+  INPUT_FIELD_DEFINITION
+}
+`
+
+var (
+	introspectionOnce  sync.Once
+	introspectionTypes map[string]*Type
+)
+
+// introspection returns the types of the introspection system, keyed by name,
+// parsing them from introspectionSDL the first time it's called.
+func introspection() map[string]*Type {
+	introspectionOnce.Do(func() {
+		s, err := ParseSDL(introspectionSDL)
+		if err != nil {
+			panic(err)
+		}
+
+		introspectionTypes = map[string]*Type{}
+		for i := range s.Types {
+			if strings.HasPrefix(s.Types[i].Name, "__") {
+				introspectionTypes[s.Types[i].Name] = &s.Types[i]
+			}
+		}
+	})
+
+	return introspectionTypes
+}
+
+// metaFields are the fields of the query root type through which the schema
+// is introspected, which schemas don't define themselves.
+var metaFields = []Field{
+	{
+		Name:        "__schema",
+		Description: "Access the current type schema of this server.",
+		Type:        TypeRef{Kind: "NON_NULL", OfType: &TypeRef{Kind: "OBJECT", Name: "__Schema"}},
+	},
+	{
+		Name:        "__type",
+		Description: "Request the type information of a single type.",
+		Args: []InputValue{{
+			Name: "name",
+			Type: TypeRef{Kind: "NON_NULL", OfType: &TypeRef{Kind: "SCALAR", Name: "String"}},
+		}},
+		Type: TypeRef{Kind: "OBJECT", Name: "__Type"},
+	},
+}
@@ -0,0 +1,60 @@
+package graphql
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIntrospection(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("File", func(t *testing.T) {
+		bs, err := ioutil.ReadFile(path.Join("..", "..", "schema", "2022-01", "schema.json"))
+		if !assert.NoError(err) {
+			return
+		}
+
+		s, err := ParseIntrospection(bs)
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Equal("QueryRoot", s.QueryType.Name)
+		assert.Equal("Mutation", s.MutationType.Name)
+		assert.Nil(s.SubscriptionType)
+
+		product := s.Type("Product")
+		if assert.NotNil(product) {
+			assert.Equal("OBJECT", product.Kind)
+			assert.Contains(product.Interfaces, TypeRef{Kind: "INTERFACE", Name: "Node"})
+		}
+
+		assert.Nil(s.Type("Video360"))
+	})
+
+	t.Run("Response", func(t *testing.T) {
+		s, err := ParseIntrospection([]byte(`{"data": {"__schema": {"queryType": {"name": "Query"}, "types": []}}}`))
+		if assert.NoError(err) {
+			assert.Equal("Query", s.QueryType.Name)
+		}
+	})
+
+	t.Run("NoSchema", func(t *testing.T) {
+		_, err := ParseIntrospection([]byte(`{"data": null}`))
+		assert.ErrorIs(err, ErrNoSchema)
+	})
+}
+
+func TestTypeRef(t *testing.T) {
+	assert := assert.New(t)
+
+	ref := TypeRef{Kind: "NON_NULL", OfType: &TypeRef{Kind: "LIST", OfType: &TypeRef{
+		Kind: "NON_NULL", OfType: &TypeRef{Kind: "OBJECT", Name: "Product"},
+	}}}
+
+	assert.Equal("[Product!]!", ref.String())
+	assert.Equal(TypeRef{Kind: "OBJECT", Name: "Product"}, ref.Named())
+}
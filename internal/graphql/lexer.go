@@ -0,0 +1,391 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Position is a position in a GraphQL document, as a 1-based line and column.
+type Position struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// String returns p as "line:column".
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// SyntaxError describes a document which isn't valid GraphQL.
+type SyntaxError struct {
+	Pos Position
+	Msg string
+}
+
+// Error implements the error interface.
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("graphql: syntax error at %s: %s", e.Pos, e.Msg)
+}
+
+// tokenKind is the kind of a lexical token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+	tokenBlockString
+)
+
+// token is a lexical token. The value of a string token is the decoded
+// string, rather than its source.
+type token struct {
+	kind  tokenKind
+	value string
+	pos   Position
+	// start and end are the offsets of the token's source.
+	start, end int
+}
+
+// String describes t for use in error messages.
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of document"
+	case tokenString, tokenBlockString:
+		return "string " + strconv.Quote(t.value)
+	default:
+		return strconv.Quote(t.value)
+	}
+}
+
+// lexer splits a document into tokens.
+type lexer struct {
+	src  string
+	i    int
+	line int
+	// lineStart is the offset of the start of the current line.
+	lineStart int
+}
+
+// lex returns the tokens of src, ending with a tokenEOF.
+func lex(src string) ([]token, error) {
+	l := &lexer{src: src, line: 1}
+
+	var toks []token
+	for {
+		t, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+
+		toks = append(toks, t)
+		if t.kind == tokenEOF {
+			return toks, nil
+		}
+	}
+}
+
+// pos returns the position of the current offset.
+func (l *lexer) pos() Position {
+	return Position{Line: l.line, Column: utf8.RuneCountInString(l.src[l.lineStart:l.i]) + 1}
+}
+
+// errorf returns a SyntaxError at pos.
+func (l *lexer) errorf(pos Position, format string, args ...interface{}) error {
+	return &SyntaxError{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// newline records a line break ending at the current offset.
+func (l *lexer) newline() {
+	l.line++
+	l.lineStart = l.i
+}
+
+// skipIgnored skips whitespace, commas and comments.
+func (l *lexer) skipIgnored() {
+	for l.i < len(l.src) {
+		switch c := l.src[l.i]; c {
+		case ' ', '\t', ',':
+			l.i++
+		case '\n':
+			l.i++
+			l.newline()
+		case '\r':
+			l.i++
+			if l.i < len(l.src) && l.src[l.i] == '\n' {
+				l.i++
+			}
+			l.newline()
+		case '#':
+			for l.i < len(l.src) && l.src[l.i] != '\n' && l.src[l.i] != '\r' {
+				l.i++
+			}
+		default:
+			// Skip a byte order mark.
+			if strings.HasPrefix(l.src[l.i:], "\uFEFF") {
+				l.i += len("\uFEFF")
+				continue
+			}
+
+			return
+		}
+	}
+}
+
+// next returns the next token.
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+
+	start := l.i
+	t, err := l.scan(l.pos())
+	t.start, t.end = start, l.i
+
+	return t, err
+}
+
+// scan returns the token at the current offset, which is at pos.
+func (l *lexer) scan(pos Position) (token, error) {
+	if l.i >= len(l.src) {
+		return token{kind: tokenEOF, pos: pos}, nil
+	}
+
+	c := l.src[l.i]
+
+	switch {
+	case strings.IndexByte("!$&()*:=@[]{}|", c) >= 0:
+		l.i++
+		return token{kind: tokenPunct, value: string(c), pos: pos}, nil
+	case c == '.':
+		if !strings.HasPrefix(l.src[l.i:], "...") {
+			return token{}, l.errorf(pos, "unexpected %q", c)
+		}
+
+		l.i += 3
+		return token{kind: tokenPunct, value: "...", pos: pos}, nil
+	case isNameStart(c):
+		start := l.i
+		for l.i < len(l.src) && isNameContinue(l.src[l.i]) {
+			l.i++
+		}
+
+		return token{kind: tokenName, value: l.src[start:l.i], pos: pos}, nil
+	case c == '-' || isDigit(c):
+		return l.number(pos)
+	case strings.HasPrefix(l.src[l.i:], `"""`):
+		return l.blockString(pos)
+	case c == '"':
+		return l.string(pos)
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.src[l.i:])
+	return token{}, l.errorf(pos, "unexpected %q", r)
+}
+
+// number lexes an int or float.
+func (l *lexer) number(pos Position) (token, error) {
+	start := l.i
+	kind := tokenInt
+
+	if l.src[l.i] == '-' {
+		l.i++
+	}
+
+	digits := func() int {
+		n := 0
+		for l.i < len(l.src) && isDigit(l.src[l.i]) {
+			l.i++
+			n++
+		}
+
+		return n
+	}
+
+	if l.i < len(l.src) && l.src[l.i] == '0' {
+		l.i++
+		if l.i < len(l.src) && isDigit(l.src[l.i]) {
+			return token{}, l.errorf(pos, "invalid number, unexpected digit after 0")
+		}
+	} else if digits() == 0 {
+		return token{}, l.errorf(pos, "invalid number %q", l.src[start:l.i])
+	}
+
+	if l.i < len(l.src) && l.src[l.i] == '.' {
+		kind = tokenFloat
+		l.i++
+		if digits() == 0 {
+			return token{}, l.errorf(pos, "invalid number %q", l.src[start:l.i])
+		}
+	}
+
+	if l.i < len(l.src) && (l.src[l.i] == 'e' || l.src[l.i] == 'E') {
+		kind = tokenFloat
+		l.i++
+		if l.i < len(l.src) && (l.src[l.i] == '+' || l.src[l.i] == '-') {
+			l.i++
+		}
+
+		if digits() == 0 {
+			return token{}, l.errorf(pos, "invalid number %q", l.src[start:l.i])
+		}
+	}
+
+	if l.i < len(l.src) && (isNameStart(l.src[l.i]) || l.src[l.i] == '.') {
+		return token{}, l.errorf(pos, "invalid number, unexpected %q", l.src[l.i])
+	}
+
+	return token{kind: kind, value: l.src[start:l.i], pos: pos}, nil
+}
+
+// string lexes a quoted string, decoding its escape sequences. Although the
+// spec forbids it, line breaks are accepted within the string, since some
+// tools, such as Rover, write deprecation reasons containing them.
+func (l *lexer) string(pos Position) (token, error) {
+	l.i++
+
+	var b strings.Builder
+	for {
+		if l.i >= len(l.src) {
+			return token{}, l.errorf(pos, "unterminated string")
+		}
+
+		c := l.src[l.i]
+		switch c {
+		case '\n':
+			b.WriteByte(c)
+			l.i++
+			l.newline()
+		case '"':
+			l.i++
+			return token{kind: tokenString, value: b.String(), pos: pos}, nil
+		case '\\':
+			if l.i+1 >= len(l.src) {
+				return token{}, l.errorf(pos, "unterminated string")
+			}
+
+			esc := l.src[l.i+1]
+			l.i += 2
+
+			switch esc {
+			case '"', '\\', '/':
+				b.WriteByte(esc)
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'u':
+				if l.i+4 > len(l.src) {
+					return token{}, l.errorf(l.pos(), "invalid unicode escape")
+				}
+
+				n, err := strconv.ParseUint(l.src[l.i:l.i+4], 16, 16)
+				if err != nil {
+					return token{}, l.errorf(l.pos(), "invalid unicode escape \\u%s", l.src[l.i:l.i+4])
+				}
+
+				b.WriteRune(rune(n))
+				l.i += 4
+			default:
+				return token{}, l.errorf(l.pos(), "invalid escape \\%c", esc)
+			}
+		default:
+			b.WriteByte(c)
+			l.i++
+		}
+	}
+}
+
+// blockString lexes a block string, removing its common indentation.
+func (l *lexer) blockString(pos Position) (token, error) {
+	l.i += 3
+
+	var b strings.Builder
+	for {
+		if l.i >= len(l.src) {
+			return token{}, l.errorf(pos, "unterminated block string")
+		}
+
+		switch rest := l.src[l.i:]; {
+		case strings.HasPrefix(rest, `"""`):
+			l.i += 3
+			return token{kind: tokenBlockString, value: blockStringValue(b.String()), pos: pos}, nil
+		case strings.HasPrefix(rest, `\"""`):
+			b.WriteString(`"""`)
+			l.i += 4
+		case rest[0] == '\n':
+			b.WriteByte('\n')
+			l.i++
+			l.newline()
+		case rest[0] == '\r':
+			b.WriteByte('\n')
+			l.i++
+			if l.i < len(l.src) && l.src[l.i] == '\n' {
+				l.i++
+			}
+			l.newline()
+		default:
+			b.WriteByte(rest[0])
+			l.i++
+		}
+	}
+}
+
+// blockStringValue returns the value of the raw block string raw, removing
+// the indentation common to all but its first line, and leading and trailing
+// blank lines.
+func blockStringValue(raw string) string {
+	lines := strings.Split(raw, "\n")
+
+	indent := -1
+	for _, line := range lines[1:] {
+		n := len(line) - len(strings.TrimLeft(line, " \t"))
+		if n < len(line) && (indent < 0 || n < indent) {
+			indent = n
+		}
+	}
+
+	if indent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= indent {
+				lines[i] = lines[i][indent:]
+			} else {
+				lines[i] = ""
+			}
+		}
+	}
+
+	isBlank := func(s string) bool { return strings.TrimLeft(s, " \t") == "" }
+
+	for len(lines) != 0 && isBlank(lines[0]) {
+		lines = lines[1:]
+	}
+
+	for len(lines) != 0 && isBlank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isNameContinue(c byte) bool {
+	return isNameStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,260 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// collectedField is a field selected within a selection set, flattened out
+// of any fragments, along with the type it's selected from.
+type collectedField struct {
+	parent *Type
+	sel    *FieldSelection
+	// def is the definition of the field, or nil if it's unknown, which is
+	// reported elsewhere.
+	def *Field
+}
+
+// typenameDef is the definition of __typename, which every composite type
+// implicitly has.
+var typenameDef = &Field{Name: "__typename", Type: TypeRef{Kind: "NON_NULL", OfType: &TypeRef{Kind: "SCALAR", Name: "String"}}}
+
+// validateOverlaps records an error for each pair of fields selected with the
+// same response name which can't be merged, as they're different fields, take
+// different arguments or have conflicting types. The selection sets of each
+// operation and fragment are checked, along with those nested within them.
+func (v *validator) validateOverlaps() {
+	v.conflicts = map[[2]Position]bool{}
+
+	for _, op := range v.doc.Operations {
+		if root := v.rootType(op); root != nil {
+			v.overlapsWithin(root, op.SelectionSet)
+		}
+	}
+
+	for _, f := range v.doc.Fragments {
+		if t, ok := v.types[f.TypeCondition]; ok {
+			v.overlapsWithin(t, f.SelectionSet)
+		}
+	}
+}
+
+// overlapsWithin checks the fields sels select from the composite type t, and
+// those selected by each of their own selection sets. Those of the fragments
+// sels spread are merged with them, but are otherwise left to be checked from
+// their definitions.
+func (v *validator) overlapsWithin(t *Type, sels []Selection) {
+	names, fields := v.collectFields(t, sels)
+
+	for _, name := range names {
+		fs := fields[name]
+		for i := range fs {
+			for j := i + 1; j < len(fs); j++ {
+				v.reportConflict(name, fs[i], fs[j])
+			}
+		}
+	}
+
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *FieldSelection:
+			if sel.SelectionSet == nil || sel.Name == "__typename" {
+				continue
+			}
+
+			if def := v.schema.FieldDef(t, sel.Name); def != nil {
+				if ft, ok := v.types[def.Type.Named().Name]; ok {
+					v.overlapsWithin(ft, sel.SelectionSet)
+				}
+			}
+		case *InlineFragment:
+			cond := t
+			if sel.TypeCondition != "" {
+				var ok bool
+				if cond, ok = v.types[sel.TypeCondition]; !ok {
+					continue
+				}
+			}
+
+			v.overlapsWithin(cond, sel.SelectionSet)
+		}
+	}
+}
+
+// reportConflict records an error if a and b, both selected with the response
+// name name, can't be merged. Each pair is reported once, though it may be
+// found from more than one selection set.
+func (v *validator) reportConflict(name string, a, b collectedField) {
+	reason := v.conflict(a, b, false)
+	if reason == "" {
+		return
+	}
+
+	key := [2]Position{a.sel.Pos, b.sel.Pos}
+	if v.conflicts[key] {
+		return
+	}
+
+	v.conflicts[key] = true
+	v.errorf(a.sel.Pos, "Fields %q conflict because %s. Use different aliases on the fields to fetch both if this was intentional.", name, reason)
+}
+
+// collectFields returns the fields sels select from the composite type t,
+// flattening fragments into them, grouped by response name, along with the
+// response names in order of first selection.
+func (v *validator) collectFields(t *Type, sels []Selection) ([]string, map[string][]collectedField) {
+	var names []string
+	fields := map[string][]collectedField{}
+
+	var collect func(t *Type, sels []Selection, spread map[string]bool)
+	collect = func(t *Type, sels []Selection, spread map[string]bool) {
+		for _, sel := range sels {
+			switch sel := sel.(type) {
+			case *FieldSelection:
+				def := typenameDef
+				if sel.Name != "__typename" {
+					def = v.schema.FieldDef(t, sel.Name)
+				}
+
+				name := sel.ResponseName()
+				if _, ok := fields[name]; !ok {
+					names = append(names, name)
+				}
+
+				fields[name] = append(fields[name], collectedField{parent: t, sel: sel, def: def})
+			case *InlineFragment:
+				cond := t
+				if sel.TypeCondition != "" {
+					var ok bool
+					if cond, ok = v.types[sel.TypeCondition]; !ok {
+						continue
+					}
+				}
+
+				collect(cond, sel.SelectionSet, spread)
+			case *FragmentSpread:
+				// Fragments spreading themselves are reported elsewhere.
+				f := v.doc.Fragment(sel.Name)
+				if f == nil || spread[f.Name] {
+					continue
+				}
+
+				cond, ok := v.types[f.TypeCondition]
+				if !ok {
+					continue
+				}
+
+				spread[f.Name] = true
+				collect(cond, f.SelectionSet, spread)
+				delete(spread, f.Name)
+			}
+		}
+	}
+
+	collect(t, sels, map[string]bool{})
+	return names, fields
+}
+
+// conflict returns the reason that a and b, selected with the same response
+// name, can't be merged, or an empty string if they can. Fields selected from
+// different object types, which can never both apply, need only have
+// compatible types; exclusive is whether a and b are within such fields.
+func (v *validator) conflict(a, b collectedField, exclusive bool) string {
+	exclusive = exclusive || (a.parent != b.parent && a.parent.Kind == "OBJECT" && b.parent.Kind == "OBJECT")
+
+	if !exclusive {
+		if a.sel.Name != b.sel.Name {
+			return fmt.Sprintf("%q and %q are different fields", a.sel.Name, b.sel.Name)
+		}
+
+		if !SameArguments(a.sel.Arguments, b.sel.Arguments) {
+			return "they have differing arguments"
+		}
+	}
+
+	if a.def == nil || b.def == nil {
+		return ""
+	}
+
+	if v.typesConflict(a.def.Type, b.def.Type) {
+		return fmt.Sprintf("they return conflicting types %q and %q", a.def.Type, b.def.Type)
+	}
+
+	if a.sel.SelectionSet == nil || b.sel.SelectionSet == nil {
+		return ""
+	}
+
+	at, aok := v.types[a.def.Type.Named().Name]
+	bt, bok := v.types[b.def.Type.Named().Name]
+	if !aok || !bok {
+		return ""
+	}
+
+	// Only pairs of subfields from either field are compared here, as those
+	// of each field alone are checked along with its own selection set.
+	names, as := v.collectFields(at, a.sel.SelectionSet)
+	_, bs := v.collectFields(bt, b.sel.SelectionSet)
+
+	var reasons []string
+	for _, name := range names {
+		for _, x := range as[name] {
+			for _, y := range bs[name] {
+				if reason := v.conflict(x, y, exclusive); reason != "" {
+					reasons = append(reasons, fmt.Sprintf("subfields %q conflict because %s", name, reason))
+				}
+			}
+		}
+	}
+
+	return strings.Join(reasons, " and ")
+}
+
+// typesConflict reports whether values of the types a and b can't be merged
+// into a single response field: they differ in being lists or non-null, or
+// are of different leaf types.
+func (v *validator) typesConflict(a, b TypeRef) bool {
+	switch {
+	case a.Kind == "LIST" || b.Kind == "LIST":
+		return a.Kind != b.Kind || v.typesConflict(*a.OfType, *b.OfType)
+	case a.Kind == "NON_NULL" || b.Kind == "NON_NULL":
+		return a.Kind != b.Kind || v.typesConflict(*a.OfType, *b.OfType)
+	}
+
+	at, aok := v.types[a.Name]
+	bt, bok := v.types[b.Name]
+	if !aok || !bok {
+		return false
+	}
+
+	if isLeafType(at) || isLeafType(bt) {
+		return at != bt
+	}
+
+	return false
+}
+
+// isLeafType reports whether t is a scalar or enum type.
+func isLeafType(t *Type) bool {
+	return t.Kind == "SCALAR" || t.Kind == "ENUM"
+}
+
+// SameArguments reports whether a and b pass the same values for the same
+// arguments, regardless of order.
+func SameArguments(a, b []Argument) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	values := map[string]string{}
+	for _, arg := range a {
+		values[arg.Name] = arg.Value
+	}
+
+	for _, arg := range b {
+		if value, ok := values[arg.Name]; !ok || value != arg.Value {
+			return false
+		}
+	}
+
+	return true
+}
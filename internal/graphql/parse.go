@@ -0,0 +1,610 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// builtinScalars are the scalars built into GraphQL, which schemas in SDL
+// needn't define.
+var builtinScalars = []string{"String", "Int", "Float", "Boolean", "ID"}
+
+// builtinDirectives defines the directives built into GraphQL, which schemas
+// in SDL needn't define.
+const builtinDirectives = `
+"Directs the executor to include this field or fragment only when the ` + "`if`" + ` argument is true."
+directive @include("Included when true." if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+"Directs the executor to skip this field or fragment when the ` + "`if`" + ` argument is true."
+directive @skip("Skipped when true." if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+"Marks an element of a GraphQL schema as no longer supported."
+directive @deprecated("Explains why this element was deprecated." reason: String = "No longer supported") on FIELD_DEFINITION | ENUM_VALUE | ARGUMENT_DEFINITION | INPUT_FIELD_DEFINITION
+`
+
+// parser parses a sequence of tokens. Its methods panic with a *SyntaxError
+// on unexpected input, which the exported functions recover.
+type parser struct {
+	toks []token
+	i    int
+}
+
+// newParser returns a parser of the tokens of src.
+func newParser(src string) (*parser, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parser{toks: toks}, nil
+}
+
+// recoverSyntaxError recovers a *SyntaxError panicked by a parser, storing it
+// in err.
+func recoverSyntaxError(err *error) {
+	if r := recover(); r != nil {
+		e, ok := r.(*SyntaxError)
+		if !ok {
+			panic(r)
+		}
+
+		*err = e
+	}
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokenEOF {
+		p.i++
+	}
+
+	return t
+}
+
+// errorf panics with a SyntaxError at the position of t.
+func (p *parser) errorf(t token, format string, args ...interface{}) {
+	panic(&SyntaxError{Pos: t.pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+// is reports whether the next token is the punctuator or name s.
+func (p *parser) is(s string) bool {
+	t := p.peek()
+	return (t.kind == tokenPunct || t.kind == tokenName) && t.value == s
+}
+
+// skip consumes the next token if it's the punctuator or name s, reporting
+// whether it was.
+func (p *parser) skip(s string) bool {
+	if p.is(s) {
+		p.next()
+		return true
+	}
+
+	return false
+}
+
+// expect consumes the next token, which must be the punctuator or name s.
+func (p *parser) expect(s string) token {
+	if !p.is(s) {
+		p.errorf(p.peek(), "expected %q, found %s", s, p.peek())
+	}
+
+	return p.next()
+}
+
+// name consumes a name.
+func (p *parser) name() string {
+	t := p.next()
+	if t.kind != tokenName {
+		p.errorf(t, "expected name, found %s", t)
+	}
+
+	return t.value
+}
+
+// description consumes a description, if there's one.
+func (p *parser) description() string {
+	if t := p.peek(); t.kind == tokenString || t.kind == tokenBlockString {
+		p.next()
+		return t.value
+	}
+
+	return ""
+}
+
+// typeRef consumes a type reference. The kinds of named types are resolved
+// later, once all types are known.
+func (p *parser) typeRef() TypeRef {
+	var t TypeRef
+
+	if p.skip("[") {
+		of := p.typeRef()
+		p.expect("]")
+		t = TypeRef{Kind: "LIST", OfType: &of}
+	} else {
+		t = TypeRef{Name: p.name()}
+	}
+
+	if p.skip("!") {
+		of := t
+		t = TypeRef{Kind: "NON_NULL", OfType: &of}
+	}
+
+	return t
+}
+
+// value consumes a value, returning it as a normalized GraphQL literal. If
+// constant, variables aren't permitted.
+func (p *parser) value(constant bool) string {
+	t := p.next()
+
+	switch t.kind {
+	case tokenInt, tokenFloat:
+		return t.value
+	case tokenString, tokenBlockString:
+		return quote(t.value)
+	case tokenName:
+		return t.value
+	}
+
+	switch t.value {
+	case "$":
+		if constant {
+			p.errorf(t, "unexpected variable in constant value")
+		}
+
+		return "$" + p.name()
+	case "[":
+		var values []string
+		for !p.skip("]") {
+			values = append(values, p.value(constant))
+		}
+
+		return "[" + strings.Join(values, ", ") + "]"
+	case "{":
+		var fields []string
+		for !p.skip("}") {
+			name := p.name()
+			p.expect(":")
+			fields = append(fields, name+": "+p.value(constant))
+		}
+
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+
+	p.errorf(t, "unexpected %s", t)
+	return ""
+}
+
+// directives consumes any applied directives.
+func (p *parser) directives(constant bool) []AppliedDirective {
+	var ds []AppliedDirective
+
+	for p.skip("@") {
+		d := AppliedDirective{Name: p.name()}
+
+		if p.skip("(") {
+			for !p.skip(")") {
+				name := p.name()
+				p.expect(":")
+				d.Args = append(d.Args, Argument{Name: name, Value: p.value(constant)})
+			}
+		}
+
+		ds = append(ds, d)
+	}
+
+	return ds
+}
+
+// ParseSDL parses a schema in the GraphQL schema definition language, such as
+// the schema.graphqls files in this repository, into the same model as an
+// introspection result. Built-in scalars and directives are added unless
+// defined, and type extensions are merged into the types they extend.
+func ParseSDL(src string) (s *Schema, err error) {
+	defer recoverSyntaxError(&err)
+
+	s = &Schema{}
+	sp := &sdlParser{schema: s, types: map[string]int{}, defined: map[string]bool{}}
+
+	// The built-in directives follow the schema's own definitions, so as to be
+	// ignored if the schema redefines them.
+	for _, doc := range []string{src, builtinDirectives} {
+		if sp.parser, err = newParser(doc); err != nil {
+			return nil, err
+		}
+
+		for sp.peek().kind != tokenEOF {
+			sp.definition()
+		}
+	}
+
+	if err := sp.resolve(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// sdlParser parses the definitions of a schema.
+type sdlParser struct {
+	*parser
+	schema *Schema
+	// types maps the names of types to their indices in schema.Types.
+	types map[string]int
+	// defined records the names of the directives defined.
+	defined map[string]bool
+	// rootsDefined records whether the schema definition was given.
+	rootsDefined bool
+}
+
+// definition consumes a type system definition or extension.
+func (p *sdlParser) definition() {
+	desc := p.description()
+	start := p.peek()
+
+	extend := p.skip("extend")
+	if extend && desc != "" {
+		p.errorf(start, "unexpected description on extension")
+	}
+
+	keyword := p.peek()
+	switch keyword.value {
+	case "schema":
+		p.next()
+		p.directives(true)
+		p.roots()
+		return
+	case "directive":
+		if extend {
+			p.errorf(keyword, "unexpected directive extension")
+		}
+
+		p.next()
+		p.directiveDefinition(desc)
+		return
+	}
+
+	kinds := map[string]string{
+		"scalar": "SCALAR", "type": "OBJECT", "interface": "INTERFACE",
+		"union": "UNION", "enum": "ENUM", "input": "INPUT_OBJECT",
+	}
+
+	kind, ok := kinds[keyword.value]
+	if keyword.kind != tokenName || !ok {
+		p.errorf(keyword, "expected definition, found %s", keyword)
+	}
+
+	p.next()
+	nameTok := p.peek()
+	t := Type{Kind: kind, Name: p.name(), Description: desc}
+
+	switch kind {
+	case "OBJECT", "INTERFACE":
+		t.Interfaces = p.implements()
+		t.Directives = p.directives(true)
+		t.Fields = p.fields()
+	case "UNION":
+		t.Directives = p.directives(true)
+		if p.skip("=") {
+			p.skip("|")
+			for {
+				t.PossibleTypes = append(t.PossibleTypes, TypeRef{Name: p.name()})
+				if !p.skip("|") {
+					break
+				}
+			}
+		}
+	case "ENUM":
+		t.Directives = p.directives(true)
+		t.EnumValues = p.enumValues()
+	case "INPUT_OBJECT":
+		t.Directives = p.directives(true)
+		if p.is("{") {
+			t.InputFields = p.inputValues("{", "}")
+		}
+	default:
+		t.Directives = p.directives(true)
+	}
+
+	i, exists := p.types[t.Name]
+
+	switch {
+	case extend && !exists:
+		p.errorf(nameTok, "extension of undefined type %s", t.Name)
+	case extend:
+		ext := &p.schema.Types[i]
+		if ext.Kind != t.Kind {
+			p.errorf(nameTok, "extension of %s %s as %s", ext.Kind, t.Name, t.Kind)
+		}
+
+		ext.Fields = append(ext.Fields, t.Fields...)
+		ext.InputFields = append(ext.InputFields, t.InputFields...)
+		ext.Interfaces = append(ext.Interfaces, t.Interfaces...)
+		ext.EnumValues = append(ext.EnumValues, t.EnumValues...)
+		ext.PossibleTypes = append(ext.PossibleTypes, t.PossibleTypes...)
+		ext.Directives = append(ext.Directives, t.Directives...)
+	case exists:
+		p.errorf(nameTok, "type %s is defined more than once", t.Name)
+	default:
+		p.types[t.Name] = len(p.schema.Types)
+		p.schema.Types = append(p.schema.Types, t)
+	}
+}
+
+// roots consumes the operation types of a schema definition.
+func (p *sdlParser) roots() {
+	p.rootsDefined = true
+	p.expect("{")
+
+	for !p.skip("}") {
+		op := p.peek()
+		p.name()
+		p.expect(":")
+		name := &TypeName{Name: p.name()}
+
+		switch op.value {
+		case "query":
+			p.schema.QueryType = name
+		case "mutation":
+			p.schema.MutationType = name
+		case "subscription":
+			p.schema.SubscriptionType = name
+		default:
+			p.errorf(op, "unknown operation type %s", op)
+		}
+	}
+}
+
+// directiveDefinition consumes a directive definition, following the
+// directive keyword. A built-in directive is ignored if the schema has
+// already defined it.
+func (p *sdlParser) directiveDefinition(desc string) {
+	p.expect("@")
+
+	d := Directive{Name: p.name(), Description: desc}
+	if p.is("(") {
+		d.Args = p.inputValues("(", ")")
+	}
+
+	d.IsRepeatable = p.skip("repeatable")
+
+	p.expect("on")
+	p.skip("|")
+	for {
+		d.Locations = append(d.Locations, p.name())
+		if !p.skip("|") {
+			break
+		}
+	}
+
+	if p.defined[d.Name] {
+		return
+	}
+
+	p.defined[d.Name] = true
+	p.schema.Directives = append(p.schema.Directives, d)
+}
+
+// implements consumes an implements clause, if there's one.
+func (p *sdlParser) implements() []TypeRef {
+	var ifaces []TypeRef
+
+	if p.skip("implements") {
+		p.skip("&")
+		for {
+			ifaces = append(ifaces, TypeRef{Name: p.name()})
+			if !p.skip("&") {
+				break
+			}
+		}
+	}
+
+	return ifaces
+}
+
+// fields consumes the field definitions of an object or interface type, if
+// there are any.
+func (p *sdlParser) fields() []Field {
+	var fields []Field
+
+	if !p.skip("{") {
+		return fields
+	}
+
+	for !p.skip("}") {
+		f := Field{Description: p.description(), Name: p.name(), Args: []InputValue{}}
+
+		if p.is("(") {
+			f.Args = p.inputValues("(", ")")
+		}
+
+		p.expect(":")
+		f.Type = p.typeRef()
+		f.Directives, f.IsDeprecated, f.DeprecationReason = deprecation(p.directives(true))
+
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+// inputValues consumes argument or input field definitions, enclosed by open
+// and close.
+func (p *sdlParser) inputValues(open, close string) []InputValue {
+	var values []InputValue
+
+	p.expect(open)
+	for !p.skip(close) {
+		v := InputValue{Description: p.description(), Name: p.name()}
+
+		p.expect(":")
+		v.Type = p.typeRef()
+
+		if p.skip("=") {
+			value := p.value(true)
+			v.DefaultValue = &value
+		}
+
+		v.Directives = p.directives(true)
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// enumValues consumes the value definitions of an enum type, if there are
+// any.
+func (p *sdlParser) enumValues() []EnumValue {
+	var values []EnumValue
+
+	if !p.skip("{") {
+		return values
+	}
+
+	for !p.skip("}") {
+		v := EnumValue{Description: p.description()}
+
+		t := p.peek()
+		v.Name = p.name()
+		if v.Name == "true" || v.Name == "false" || v.Name == "null" {
+			p.errorf(t, "invalid enum value %s", v.Name)
+		}
+
+		v.Directives, v.IsDeprecated, v.DeprecationReason = deprecation(p.directives(true))
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// deprecation separates @deprecated from the directives ds, returning the
+// remainder, and whether and why the element is deprecated.
+func deprecation(ds []AppliedDirective) ([]AppliedDirective, bool, *string) {
+	var rest []AppliedDirective
+	var reason *string
+
+	for _, d := range ds {
+		if d.Name != "deprecated" {
+			rest = append(rest, d)
+			continue
+		}
+
+		r := defaultDeprecationReason
+		for _, a := range d.Args {
+			if a.Name == "reason" {
+				r = unquote(a.Value)
+			}
+		}
+
+		reason = &r
+	}
+
+	return rest, reason != nil, reason
+}
+
+// unquote returns the string value of a literal produced by quote. Other
+// literals, such as null, are returned as they are.
+func unquote(literal string) string {
+	toks, err := lex(literal)
+	if err != nil || len(toks) != 2 || toks[0].kind != tokenString {
+		return literal
+	}
+
+	return toks[0].value
+}
+
+// resolve adds the built-in scalars, and fills in the kinds of type
+// references, the possible types of interfaces and the default root types.
+func (p *sdlParser) resolve() error {
+	for _, name := range builtinScalars {
+		if _, ok := p.types[name]; !ok {
+			p.types[name] = len(p.schema.Types)
+			p.schema.Types = append(p.schema.Types, Type{Kind: "SCALAR", Name: name})
+		}
+	}
+
+	var err error
+	resolveRef := func(ref *TypeRef) {
+		for ref.OfType != nil {
+			ref = ref.OfType
+		}
+
+		i, ok := p.types[ref.Name]
+		if !ok {
+			if err == nil {
+				err = fmt.Errorf("graphql: undefined type %s", ref.Name)
+			}
+
+			return
+		}
+
+		ref.Kind = p.schema.Types[i].Kind
+	}
+
+	resolveValues := func(values []InputValue) {
+		for i := range values {
+			resolveRef(&values[i].Type)
+		}
+	}
+
+	for ti := range p.schema.Types {
+		t := &p.schema.Types[ti]
+
+		for i := range t.Fields {
+			resolveRef(&t.Fields[i].Type)
+			resolveValues(t.Fields[i].Args)
+		}
+
+		resolveValues(t.InputFields)
+
+		for i := range t.Interfaces {
+			resolveRef(&t.Interfaces[i])
+		}
+
+		for i := range t.PossibleTypes {
+			resolveRef(&t.PossibleTypes[i])
+		}
+	}
+
+	for i := range p.schema.Directives {
+		resolveValues(p.schema.Directives[i].Args)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The possible types of an interface are the objects implementing it.
+	for _, t := range p.schema.Types {
+		if t.Kind != "OBJECT" {
+			continue
+		}
+
+		for _, iface := range t.Interfaces {
+			it := &p.schema.Types[p.types[iface.Name]]
+			it.PossibleTypes = append(it.PossibleTypes, TypeRef{Kind: "OBJECT", Name: t.Name})
+		}
+	}
+
+	if !p.rootsDefined {
+		for _, r := range []struct {
+			name string
+			root **TypeName
+		}{
+			{"Query", &p.schema.QueryType},
+			{"Mutation", &p.schema.MutationType},
+			{"Subscription", &p.schema.SubscriptionType},
+		} {
+			if _, ok := p.types[r.name]; ok {
+				*r.root = &TypeName{Name: r.name}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package graphql
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSDL = `
+"""
+The root of queries.
+"""
+type Query {
+  "Fetches a product by ID."
+  product(id: ID!): Product
+  products(first: Int = 10, sortKey: SortKeys = TITLE, filters: [Filter!] = [{available: true}]): [Product!]!
+}
+
+interface Node {
+  id: ID!
+}
+
+type Product implements Node @key(fields: "id") {
+  id: ID!
+  name: String @deprecated
+  handle: String! @deprecated(reason: "Use ` + "`slug`" + ` instead")
+  slug: String!
+  matrix: [[Int!]]!
+}
+
+enum SortKeys {
+  TITLE
+  PRICE @deprecated(reason: "Sort client-side")
+}
+
+input Filter {
+  available: Boolean
+}
+
+union Result = | Product
+
+directive @key(fields: String!) repeatable on OBJECT | INTERFACE
+
+extend type Query {
+  node(id: ID!): Node
+}
+
+extend enum SortKeys {
+  VENDOR
+}
+`
+
+func TestParseSDL(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := ParseSDL(testSDL)
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Equal("Query", s.QueryType.Name)
+	assert.Nil(s.MutationType)
+
+	query := s.Type("Query")
+	if assert.NotNil(query) && assert.Len(query.Fields, 3) {
+		assert.Equal("The root of queries.", query.Description)
+
+		product := query.Fields[0]
+		assert.Equal("Fetches a product by ID.", product.Description)
+		assert.Equal(TypeRef{Kind: "OBJECT", Name: "Product"}, product.Type)
+		assert.Equal("ID!", product.Args[0].Type.String())
+		assert.Equal("SCALAR", product.Args[0].Type.OfType.Kind)
+
+		args := query.Fields[1].Args
+		if assert.Len(args, 3) {
+			assert.Equal("10", *args[0].DefaultValue)
+			assert.Equal("TITLE", *args[1].DefaultValue)
+			assert.Equal("[{available: true}]", *args[2].DefaultValue)
+			assert.Equal("INPUT_OBJECT", args[2].Type.Named().Kind)
+		}
+
+		assert.Equal("node", query.Fields[2].Name)
+		assert.Equal("INTERFACE", query.Fields[2].Type.Kind)
+	}
+
+	product := s.Type("Product")
+	if assert.NotNil(product) && assert.Len(product.Fields, 5) {
+		assert.Equal([]TypeRef{{Kind: "INTERFACE", Name: "Node"}}, product.Interfaces)
+		assert.Equal([]AppliedDirective{{Name: "key", Args: []Argument{{Name: "fields", Value: `"id"`}}}}, product.Directives)
+
+		assert.True(product.Fields[1].IsDeprecated)
+		assert.Equal(defaultDeprecationReason, *product.Fields[1].DeprecationReason)
+		assert.Equal("Use `slug` instead", *product.Fields[2].DeprecationReason)
+		assert.Empty(product.Fields[2].Directives)
+		assert.False(product.Fields[3].IsDeprecated)
+		assert.Equal("[[Int!]]!", product.Fields[4].Type.String())
+	}
+
+	assert.Equal([]TypeRef{{Kind: "OBJECT", Name: "Product"}}, s.Type("Node").PossibleTypes)
+	assert.Equal([]TypeRef{{Kind: "OBJECT", Name: "Product"}}, s.Type("Result").PossibleTypes)
+
+	if sortKeys := s.Type("SortKeys"); assert.NotNil(sortKeys) && assert.Len(sortKeys.EnumValues, 3) {
+		assert.True(sortKeys.EnumValues[1].IsDeprecated)
+		assert.Equal("VENDOR", sortKeys.EnumValues[2].Name)
+	}
+
+	assert.Equal("SCALAR", s.Type("Boolean").Kind)
+
+	var names []string
+	for _, d := range s.Directives {
+		names = append(names, d.Name)
+	}
+
+	assert.Equal([]string{"key", "include", "skip", "deprecated"}, names)
+	assert.True(s.Directives[0].IsRepeatable)
+}
+
+func TestParseSDL_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"type Query {\n  id: ID!\n  name String\n}", `syntax error at 3:8: expected ":", found "String"`},
+		{`type Query { name: "String" }`, `syntax error at 1:20: expected name, found string "String"`},
+		{"type Query { id: ID }\ntype Query { id: ID }", "syntax error at 2:6: type Query is defined more than once"},
+		{"extend type Query { id: ID }", "syntax error at 1:13: extension of undefined type Query"},
+		{`type Query { name: String @deprecated(reason: "x) }`, "syntax error at 1:47: unterminated string"},
+		{"query { id }", `syntax error at 1:1: expected definition, found "query"`},
+		{"type Query { product: Product }", "undefined type Product"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseSDL(tt.src)
+		if assert.Error(err, tt.src) {
+			assert.Equal("graphql: "+tt.want, err.Error(), tt.src)
+		}
+	}
+}
+
+func TestParseSDL_File(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := path.Join("..", "..", "schema", "2022-01")
+
+	bs, err := ioutil.ReadFile(path.Join(dir, "schema.json"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	want, err := ParseIntrospection(bs)
+	if !assert.NoError(err) {
+		return
+	}
+
+	bs, err = ioutil.ReadFile(path.Join(dir, "schema.graphqls"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	got, err := ParseSDL(string(bs))
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Equal(want.QueryType, got.QueryType)
+	assert.Equal(want.MutationType, got.MutationType)
+
+	// The schemas should agree on every type other than those of the
+	// introspection system, which SDL doesn't describe.
+	for _, w := range want.Types {
+		if strings.HasPrefix(w.Name, "__") {
+			continue
+		}
+
+		g := got.Type(w.Name)
+		if !assert.NotNil(g, w.Name) {
+			continue
+		}
+
+		assert.Equal(w.Kind, g.Kind, w.Name)
+		assert.ElementsMatch(w.PossibleTypes, g.PossibleTypes, w.Name)
+
+		if !assert.Len(g.Fields, len(w.Fields), w.Name) {
+			continue
+		}
+
+		for i, f := range w.Fields {
+			assert.Equal(f.Name, g.Fields[i].Name, w.Name)
+			assert.Equal(f.Type, g.Fields[i].Type, w.Name+"."+f.Name)
+			assert.Equal(f.DeprecationReason, g.Fields[i].DeprecationReason, w.Name+"."+f.Name)
+			assert.Len(g.Fields[i].Args, len(f.Args), w.Name+"."+f.Name)
+		}
+	}
+
+	// Printing the parsed schema and parsing it again should be lossless.
+	var b strings.Builder
+	if assert.NoError(PrintSDL(&b, got)) {
+		again, err := ParseSDL(b.String())
+		if assert.NoError(err) {
+			assert.Equal(got.Type("Product"), again.Type("Product"))
+			assert.Equal(got.Directives, again.Directives)
+		}
+	}
+}
+
+func TestBlockStringValue(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("Hello,\n  World!\n\nYours,\n  GraphQL.", blockStringValue(`
+    Hello,
+      World!
+
+    Yours,
+      GraphQL.
+  `))
+
+	assert.Equal("One line", blockStringValue("One line"))
+	assert.Equal("First\nSecond", blockStringValue("First\n  Second\n"))
+}
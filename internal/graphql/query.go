@@ -0,0 +1,291 @@
+package graphql
+
+// Document describes an executable GraphQL document: a set of operations and
+// the fragments they use.
+type Document struct {
+	// Source is the source of the document.
+	Source     string
+	Operations []*Operation
+	Fragments  []*Fragment
+}
+
+// Operation describes a query, mutation or subscription.
+type Operation struct {
+	// Type is "query", "mutation" or "subscription".
+	Type string
+	// Name is the name of the operation, which is empty if it's anonymous.
+	Name                string
+	VariableDefinitions []*VariableDefinition
+	Directives          []AppliedDirective
+	SelectionSet        []Selection
+	Pos                 Position
+	// Source is the source of the operation's definition.
+	Source string
+}
+
+// VariableDefinition describes a variable declared by an operation.
+type VariableDefinition struct {
+	Name string
+	// Type is the type of the variable. The kinds of the named types it
+	// refers to aren't set.
+	Type TypeRef
+	// DefaultValue is the default value as a GraphQL literal, or nil if
+	// there's none.
+	DefaultValue *string
+	Pos          Position
+}
+
+// Fragment describes a named fragment.
+type Fragment struct {
+	Name          string
+	TypeCondition string
+	Directives    []AppliedDirective
+	SelectionSet  []Selection
+	Pos           Position
+	// Source is the source of the fragment's definition.
+	Source string
+}
+
+// Selection is one of *FieldSelection, *FragmentSpread or *InlineFragment.
+type Selection interface {
+	// Position returns the position of the selection.
+	Position() Position
+}
+
+// FieldSelection describes the selection of a field.
+type FieldSelection struct {
+	// Alias is the alias of the field, which is empty if it has none.
+	Alias        string
+	Name         string
+	Arguments    []Argument
+	Directives   []AppliedDirective
+	SelectionSet []Selection
+	Pos          Position
+}
+
+// ResponseName returns the name of the field in the response: its alias, or
+// its name if it has none.
+func (f *FieldSelection) ResponseName() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+
+	return f.Name
+}
+
+// Position implements Selection.
+func (f *FieldSelection) Position() Position { return f.Pos }
+
+// FragmentSpread describes the spread of a named fragment.
+type FragmentSpread struct {
+	Name       string
+	Directives []AppliedDirective
+	Pos        Position
+}
+
+// Position implements Selection.
+func (s *FragmentSpread) Position() Position { return s.Pos }
+
+// InlineFragment describes an inline fragment.
+type InlineFragment struct {
+	// TypeCondition is the type the fragment applies to, which is empty if
+	// it applies to the enclosing type.
+	TypeCondition string
+	Directives    []AppliedDirective
+	SelectionSet  []Selection
+	Pos           Position
+}
+
+// Position implements Selection.
+func (f *InlineFragment) Position() Position { return f.Pos }
+
+// Operation returns the operation named name, or nil if there's none. If name
+// is empty, the document's anonymous operation is returned, or its only
+// operation if it has a single one.
+func (d *Document) Operation(name string) *Operation {
+	if name == "" && len(d.Operations) == 1 {
+		return d.Operations[0]
+	}
+
+	for _, op := range d.Operations {
+		if op.Name == name {
+			return op
+		}
+	}
+
+	return nil
+}
+
+// Fragment returns the fragment named name, or nil if there's none.
+func (d *Document) Fragment(name string) *Fragment {
+	for _, f := range d.Fragments {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// ParseQuery parses an executable document, such as a query.
+func ParseQuery(src string) (doc *Document, err error) {
+	defer recoverSyntaxError(&err)
+
+	p, err := newParser(src)
+	if err != nil {
+		return nil, err
+	}
+
+	doc = &Document{Source: src}
+	qp := &queryParser{parser: p, src: src}
+
+	if p.peek().kind == tokenEOF {
+		p.errorf(p.peek(), "expected definition, found %s", p.peek())
+	}
+
+	for p.peek().kind != tokenEOF {
+		switch t := p.peek(); {
+		case t.kind == tokenPunct && t.value == "{":
+			doc.Operations = append(doc.Operations, qp.operation())
+		case t.kind == tokenName && (t.value == "query" || t.value == "mutation" || t.value == "subscription"):
+			doc.Operations = append(doc.Operations, qp.operation())
+		case t.kind == tokenName && t.value == "fragment":
+			doc.Fragments = append(doc.Fragments, qp.fragment())
+		default:
+			p.errorf(t, "expected definition, found %s", t)
+		}
+	}
+
+	return doc, nil
+}
+
+// queryParser parses the definitions of an executable document.
+type queryParser struct {
+	*parser
+	src string
+}
+
+// source returns the source from the token start to the last token consumed.
+func (p *queryParser) source(start token) string {
+	return p.src[start.start:p.toks[p.i-1].end]
+}
+
+// operation consumes an operation definition.
+func (p *queryParser) operation() *Operation {
+	start := p.peek()
+	op := &Operation{Type: "query", Pos: start.pos}
+
+	// The query keyword may be omitted, as in the query shorthand "{ shop }".
+	if start.kind == tokenName {
+		op.Type = p.next().value
+
+		if p.peek().kind == tokenName {
+			op.Name = p.name()
+		}
+
+		if p.skip("(") {
+			for !p.skip(")") {
+				op.VariableDefinitions = append(op.VariableDefinitions, p.variableDefinition())
+			}
+		}
+
+		op.Directives = p.directives(false)
+	}
+
+	op.SelectionSet = p.selectionSet()
+	op.Source = p.source(start)
+
+	return op
+}
+
+// variableDefinition consumes a variable definition.
+func (p *queryParser) variableDefinition() *VariableDefinition {
+	pos := p.expect("$").pos
+	v := &VariableDefinition{Name: p.name(), Pos: pos}
+
+	p.expect(":")
+	v.Type = p.typeRef()
+
+	if p.skip("=") {
+		value := p.value(true)
+		v.DefaultValue = &value
+	}
+
+	p.directives(true)
+	return v
+}
+
+// fragment consumes a fragment definition.
+func (p *queryParser) fragment() *Fragment {
+	start := p.expect("fragment")
+
+	nameTok := p.peek()
+	f := &Fragment{Name: p.name(), Pos: start.pos}
+	if f.Name == "on" {
+		p.errorf(nameTok, `unexpected "on"`)
+	}
+
+	p.expect("on")
+	f.TypeCondition = p.name()
+	f.Directives = p.directives(false)
+	f.SelectionSet = p.selectionSet()
+	f.Source = p.source(start)
+
+	return f
+}
+
+// selectionSet consumes a selection set, which must have at least one
+// selection.
+func (p *queryParser) selectionSet() []Selection {
+	var sels []Selection
+
+	p.expect("{")
+	for {
+		sels = append(sels, p.selection())
+		if p.skip("}") {
+			return sels
+		}
+	}
+}
+
+// selection consumes a field, fragment spread or inline fragment.
+func (p *queryParser) selection() Selection {
+	pos := p.peek().pos
+
+	if p.skip("...") {
+		if p.peek().kind == tokenName && p.peek().value != "on" {
+			return &FragmentSpread{Name: p.name(), Directives: p.directives(false), Pos: pos}
+		}
+
+		f := &InlineFragment{Pos: pos}
+		if p.skip("on") {
+			f.TypeCondition = p.name()
+		}
+
+		f.Directives = p.directives(false)
+		f.SelectionSet = p.selectionSet()
+
+		return f
+	}
+
+	f := &FieldSelection{Name: p.name(), Pos: pos}
+	if p.skip(":") {
+		f.Alias, f.Name = f.Name, p.name()
+	}
+
+	if p.skip("(") {
+		for !p.skip(")") {
+			name := p.name()
+			p.expect(":")
+			f.Arguments = append(f.Arguments, Argument{Name: name, Value: p.value(false)})
+		}
+	}
+
+	f.Directives = p.directives(false)
+
+	if p.is("{") {
+		f.SelectionSet = p.selectionSet()
+	}
+
+	return f
+}
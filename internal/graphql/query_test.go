@@ -0,0 +1,97 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testQuery = `# Fetches a product.
+query GetProduct($id: ID!, $first: Int = 10) @cached {
+  product(id: $id) {
+    id
+    title: name
+    ...Variants @include(if: true)
+    ... on Node { id }
+  }
+}
+
+fragment Variants on Product {
+  variants(first: $first) { edges { node { id } } }
+}
+
+{ shop { name } }
+`
+
+func TestParseQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	doc, err := ParseQuery(testQuery)
+	if !assert.NoError(err) || !assert.Len(doc.Operations, 2) || !assert.Len(doc.Fragments, 1) {
+		return
+	}
+
+	op := doc.Operation("GetProduct")
+	if assert.NotNil(op) {
+		assert.Equal("query", op.Type)
+		assert.Equal(Position{Line: 2, Column: 1}, op.Pos)
+		assert.Equal([]AppliedDirective{{Name: "cached"}}, op.Directives)
+		assert.Equal("query GetProduct", op.Source[:16])
+		assert.Equal("}", op.Source[len(op.Source)-1:])
+
+		if assert.Len(op.VariableDefinitions, 2) {
+			assert.Equal("ID!", op.VariableDefinitions[0].Type.String())
+			assert.Nil(op.VariableDefinitions[0].DefaultValue)
+			assert.Equal("10", *op.VariableDefinitions[1].DefaultValue)
+		}
+
+		product := op.SelectionSet[0].(*FieldSelection)
+		assert.Equal([]Argument{{Name: "id", Value: "$id"}}, product.Arguments)
+
+		if assert.Len(product.SelectionSet, 4) {
+			title := product.SelectionSet[1].(*FieldSelection)
+			assert.Equal("title", title.ResponseName())
+			assert.Equal("name", title.Name)
+
+			spread := product.SelectionSet[2].(*FragmentSpread)
+			assert.Equal("Variants", spread.Name)
+			assert.Equal(Position{Line: 6, Column: 5}, spread.Position())
+
+			inline := product.SelectionSet[3].(*InlineFragment)
+			assert.Equal("Node", inline.TypeCondition)
+		}
+	}
+
+	if shop := doc.Operation(""); assert.NotNil(shop) {
+		assert.Equal("{ shop { name } }", shop.Source)
+	}
+
+	f := doc.Fragment("Variants")
+	if assert.NotNil(f) {
+		assert.Equal("Product", f.TypeCondition)
+		assert.Equal("fragment Variants on Product {\n  variants(first: $first) { edges { node { id } } }\n}", f.Source)
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"", "syntax error at 1:1: expected definition, found end of document"},
+		{"query { }", `syntax error at 1:9: expected name, found "}"`},
+		{"query Q($id ID) { id }", `syntax error at 1:13: expected ":", found "ID"`},
+		{"fragment on on Product { id }", `syntax error at 1:10: unexpected "on"`},
+		{"type Query { id: ID }", `syntax error at 1:1: expected definition, found "type"`},
+		{"{ product(id: 1 { id } }", `syntax error at 1:17: expected name, found "{"`},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseQuery(tt.src)
+		if assert.Error(err, tt.src) {
+			assert.Equal("graphql: "+tt.want, err.Error(), tt.src)
+		}
+	}
+}
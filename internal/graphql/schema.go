@@ -0,0 +1,169 @@
+// Package graphql models GraphQL schemas as described by introspection, for
+// use by the code generator.
+package graphql
+
+import "strings"
+
+// Schema describes a GraphQL schema, in the shape of the __schema field of an
+// introspection result.
+type Schema struct {
+	QueryType        *TypeName   `json:"queryType"`
+	MutationType     *TypeName   `json:"mutationType"`
+	SubscriptionType *TypeName   `json:"subscriptionType"`
+	Types            []Type      `json:"types"`
+	Directives       []Directive `json:"directives"`
+}
+
+// TypeName names one of the root operation types of a schema.
+type TypeName struct {
+	Name string `json:"name"`
+}
+
+// Type describes a named GraphQL type. Which of its fields are set depends on
+// its Kind.
+type Type struct {
+	Kind          string       `json:"kind"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+	Fields        []Field      `json:"fields"`
+	InputFields   []InputValue `json:"inputFields"`
+	Interfaces    []TypeRef    `json:"interfaces"`
+	EnumValues    []EnumValue  `json:"enumValues"`
+	PossibleTypes []TypeRef    `json:"possibleTypes"`
+	// Directives are the directives applied to the type. Introspection
+	// doesn't expose them, so they're only set for schemas parsed from SDL.
+	Directives []AppliedDirective `json:"-"`
+}
+
+// Field describes a field of an object or interface type.
+type Field struct {
+	Name              string       `json:"name"`
+	Description       string       `json:"description"`
+	Args              []InputValue `json:"args"`
+	Type              TypeRef      `json:"type"`
+	IsDeprecated      bool         `json:"isDeprecated"`
+	DeprecationReason *string      `json:"deprecationReason"`
+	// Directives are the directives applied to the field other than
+	// @deprecated, which is reflected by IsDeprecated. They're only set for
+	// schemas parsed from SDL.
+	Directives []AppliedDirective `json:"-"`
+}
+
+// InputValue describes an argument of a field or directive, or a field of an
+// input object type.
+type InputValue struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Type        TypeRef `json:"type"`
+	// DefaultValue is the default value as a GraphQL literal, such as "false",
+	// or nil if there's none.
+	DefaultValue *string `json:"defaultValue"`
+	// Directives are the directives applied to the value. They're only set
+	// for schemas parsed from SDL.
+	Directives []AppliedDirective `json:"-"`
+}
+
+// EnumValue describes a value of an enum type.
+type EnumValue struct {
+	Name              string  `json:"name"`
+	Description       string  `json:"description"`
+	IsDeprecated      bool    `json:"isDeprecated"`
+	DeprecationReason *string `json:"deprecationReason"`
+	// Directives are the directives applied to the value other than
+	// @deprecated. They're only set for schemas parsed from SDL.
+	Directives []AppliedDirective `json:"-"`
+}
+
+// Directive describes a directive the schema supports.
+type Directive struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Locations   []string     `json:"locations"`
+	Args        []InputValue `json:"args"`
+	// IsRepeatable is whether the directive may be applied more than once at
+	// a location. IntrospectionQuery doesn't select it, as not all servers
+	// support it, so it's only set for schemas parsed from SDL.
+	IsRepeatable bool `json:"isRepeatable"`
+}
+
+// AppliedDirective describes a use of a directive in SDL, such as
+// @accessRestricted(reason: "Requires a private token").
+type AppliedDirective struct {
+	Name string
+	Args []Argument
+}
+
+// Argument describes an argument passed to a directive.
+type Argument struct {
+	Name string
+	// Value is the value as a GraphQL literal, such as "true" or "\"x\"".
+	Value string
+}
+
+// TypeRef describes a reference to a GraphQL type, wrapped in any number of
+// NON_NULL and LIST modifiers.
+type TypeRef struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	OfType *TypeRef `json:"ofType"`
+}
+
+// String returns t in GraphQL notation, such as "[String!]!".
+func (t TypeRef) String() string {
+	switch {
+	case t.Kind == "NON_NULL" && t.OfType != nil:
+		return t.OfType.String() + "!"
+	case t.Kind == "LIST" && t.OfType != nil:
+		return "[" + t.OfType.String() + "]"
+	default:
+		return t.Name
+	}
+}
+
+// Named returns the named type t refers to, stripped of its modifiers.
+func (t TypeRef) Named() TypeRef {
+	for t.OfType != nil {
+		t = *t.OfType
+	}
+
+	return t
+}
+
+// Type returns the type named name, or nil if there's none. The types of the
+// introspection system, such as __Type, are included even if the schema
+// doesn't define them.
+func (s *Schema) Type(name string) *Type {
+	for i := range s.Types {
+		if s.Types[i].Name == name {
+			return &s.Types[i]
+		}
+	}
+
+	return introspection()[name]
+}
+
+// FieldDef returns the definition of the field of t named name, or nil if
+// there's none. Unlike the FieldDef function, it includes the __schema and
+// __type fields of the query root type.
+func (s *Schema) FieldDef(t *Type, name string) *Field {
+	if s.QueryType != nil && t.Name == s.QueryType.Name {
+		for i := range metaFields {
+			if metaFields[i].Name == name {
+				return &metaFields[i]
+			}
+		}
+	}
+
+	return FieldDef(t, name)
+}
+
+// isBuiltin reports whether the type named name is one of the scalars built
+// into GraphQL, or part of the introspection system.
+func isBuiltin(name string) bool {
+	switch name {
+	case "String", "Int", "Float", "Boolean", "ID":
+		return true
+	}
+
+	return strings.HasPrefix(name, "__")
+}
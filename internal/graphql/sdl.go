@@ -0,0 +1,266 @@
+package graphql
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// defaultDeprecationReason is the reason given for deprecations which don't
+// specify one, which is omitted when printing.
+const defaultDeprecationReason = "No longer supported"
+
+// PrintSDL writes s to w in the GraphQL schema definition language. Built-in
+// scalars and directives are omitted, and types are written in alphabetical
+// order.
+func PrintSDL(w io.Writer, s *Schema) error {
+	p := &printer{}
+
+	p.schema(s)
+
+	for _, d := range s.Directives {
+		switch d.Name {
+		case "include", "skip", "deprecated", "specifiedBy":
+			continue
+		}
+
+		p.directive(d)
+	}
+
+	types := make([]Type, 0, len(s.Types))
+	for _, t := range s.Types {
+		if !isBuiltin(t.Name) {
+			types = append(types, t)
+		}
+	}
+
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+
+	for _, t := range types {
+		p.typ(t)
+	}
+
+	// Each definition is followed by a blank line, except the last.
+	_, err := io.WriteString(w, strings.TrimSuffix(p.String(), "\n"))
+	return err
+}
+
+// printer accumulates a schema printed in SDL.
+type printer struct {
+	strings.Builder
+}
+
+// schema prints the schema definition, unless the root operation types have
+// their conventional names, in which case it's implied.
+func (p *printer) schema(s *Schema) {
+	roots := []struct {
+		op  string
+		typ *TypeName
+		std string
+	}{
+		{"query", s.QueryType, "Query"},
+		{"mutation", s.MutationType, "Mutation"},
+		{"subscription", s.SubscriptionType, "Subscription"},
+	}
+
+	conventional := true
+	for _, r := range roots {
+		if r.typ != nil && r.typ.Name != r.std {
+			conventional = false
+		}
+	}
+
+	if conventional {
+		return
+	}
+
+	p.WriteString("schema {\n")
+	for _, r := range roots {
+		if r.typ != nil {
+			fmt.Fprintf(p, "  %s: %s\n", r.op, r.typ.Name)
+		}
+	}
+	p.WriteString("}\n\n")
+}
+
+// directive prints the definition of the directive d.
+func (p *printer) directive(d Directive) {
+	p.description(d.Description, "")
+	repeatable := ""
+	if d.IsRepeatable {
+		repeatable = " repeatable"
+	}
+
+	fmt.Fprintf(p, "directive @%s%s%s on %s\n\n", d.Name, p.args(d.Args, ""), repeatable, strings.Join(d.Locations, " | "))
+}
+
+// typ prints the definition of the type t.
+func (p *printer) typ(t Type) {
+	p.description(t.Description, "")
+
+	switch t.Kind {
+	case "SCALAR":
+		fmt.Fprintf(p, "scalar %s%s\n\n", t.Name, applied(t.Directives))
+	case "OBJECT", "INTERFACE":
+		keyword := "type"
+		if t.Kind == "INTERFACE" {
+			keyword = "interface"
+		}
+
+		fmt.Fprintf(p, "%s %s%s%s {\n", keyword, t.Name, implements(t.Interfaces), applied(t.Directives))
+		for _, f := range t.Fields {
+			p.description(f.Description, "  ")
+			fmt.Fprintf(p, "  %s%s: %s%s%s\n", f.Name, p.args(f.Args, "  "), f.Type, deprecated(f.IsDeprecated, f.DeprecationReason), applied(f.Directives))
+		}
+		p.WriteString("}\n\n")
+	case "UNION":
+		members := make([]string, len(t.PossibleTypes))
+		for i, m := range t.PossibleTypes {
+			members[i] = m.Name
+		}
+
+		fmt.Fprintf(p, "union %s%s = %s\n\n", t.Name, applied(t.Directives), strings.Join(members, " | "))
+	case "ENUM":
+		fmt.Fprintf(p, "enum %s%s {\n", t.Name, applied(t.Directives))
+		for _, v := range t.EnumValues {
+			p.description(v.Description, "  ")
+			fmt.Fprintf(p, "  %s%s%s\n", v.Name, deprecated(v.IsDeprecated, v.DeprecationReason), applied(v.Directives))
+		}
+		p.WriteString("}\n\n")
+	case "INPUT_OBJECT":
+		fmt.Fprintf(p, "input %s%s {\n", t.Name, applied(t.Directives))
+		for _, f := range t.InputFields {
+			p.description(f.Description, "  ")
+			fmt.Fprintf(p, "  %s\n", inputValue(f))
+		}
+		p.WriteString("}\n\n")
+	}
+}
+
+// args returns the parenthesized arguments args, or an empty string if there
+// are none. Arguments with descriptions are printed on separate lines, indented
+// beyond indent.
+func (p *printer) args(args []InputValue, indent string) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	described := false
+	for _, a := range args {
+		if a.Description != "" {
+			described = true
+		}
+	}
+
+	if !described {
+		values := make([]string, len(args))
+		for i, a := range args {
+			values[i] = inputValue(a)
+		}
+
+		return "(" + strings.Join(values, ", ") + ")"
+	}
+
+	var b printer
+	b.WriteString("(\n")
+	for _, a := range args {
+		b.description(a.Description, indent+"  ")
+		b.WriteString(indent + "  " + inputValue(a) + "\n")
+	}
+	b.WriteString(indent + ")")
+
+	return b.String()
+}
+
+// description prints desc as a block string, if it isn't empty.
+func (p *printer) description(desc, indent string) {
+	if desc == "" {
+		return
+	}
+
+	desc = strings.ReplaceAll(desc, `"""`, `\"""`)
+
+	// A single line can't end in a quote, which would run into the closing
+	// quotes.
+	if !strings.Contains(desc, "\n") && len(desc) <= 70 && !strings.HasSuffix(desc, `"`) {
+		fmt.Fprintf(p, "%s\"\"\"%s\"\"\"\n", indent, desc)
+		return
+	}
+
+	fmt.Fprintf(p, "%s\"\"\"\n", indent)
+	for _, line := range strings.Split(desc, "\n") {
+		if line == "" {
+			p.WriteString("\n")
+			continue
+		}
+
+		fmt.Fprintf(p, "%s%s\n", indent, line)
+	}
+	fmt.Fprintf(p, "%s\"\"\"\n", indent)
+}
+
+// inputValue returns v as an argument or input field definition.
+func inputValue(v InputValue) string {
+	s := v.Name + ": " + v.Type.String()
+	if v.DefaultValue != nil {
+		s += " = " + *v.DefaultValue
+	}
+
+	return s + applied(v.Directives)
+}
+
+// applied returns the applied directives ds, each preceded by a space.
+func applied(ds []AppliedDirective) string {
+	var b strings.Builder
+
+	for _, d := range ds {
+		b.WriteString(" @" + d.Name)
+		if len(d.Args) == 0 {
+			continue
+		}
+
+		args := make([]string, len(d.Args))
+		for i, a := range d.Args {
+			args[i] = a.Name + ": " + a.Value
+		}
+
+		b.WriteString("(" + strings.Join(args, ", ") + ")")
+	}
+
+	return b.String()
+}
+
+// implements returns the implements clause for the interfaces ifaces.
+func implements(ifaces []TypeRef) string {
+	if len(ifaces) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(ifaces))
+	for i, iface := range ifaces {
+		names[i] = iface.Name
+	}
+
+	return " implements " + strings.Join(names, " & ")
+}
+
+// deprecated returns the @deprecated directive for a field or enum value, if
+// it's deprecated.
+func deprecated(isDeprecated bool, reason *string) string {
+	if !isDeprecated {
+		return ""
+	}
+
+	if reason == nil || *reason == defaultDeprecationReason {
+		return " @deprecated"
+	}
+
+	return fmt.Sprintf(" @deprecated(reason: %s)", quote(*reason))
+}
+
+// quote returns s as a GraphQL string literal.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + r.Replace(s) + `"`
+}
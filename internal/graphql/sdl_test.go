@@ -0,0 +1,96 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintSDL(t *testing.T) {
+	assert := assert.New(t)
+
+	named := func(kind, name string) TypeRef { return TypeRef{Kind: kind, Name: name} }
+	nonNull := func(t TypeRef) TypeRef { return TypeRef{Kind: "NON_NULL", OfType: &t} }
+	list := func(t TypeRef) TypeRef { return TypeRef{Kind: "LIST", OfType: &t} }
+	reason := "Use `title` instead"
+	defaultValue := "false"
+
+	s := &Schema{
+		QueryType: &TypeName{Name: "QueryRoot"},
+		Types: []Type{
+			{Kind: "SCALAR", Name: "String"},
+			{Kind: "OBJECT", Name: "__Schema"},
+			{
+				Kind: "OBJECT", Name: "QueryRoot",
+				Fields: []Field{{
+					Name: "products",
+					Args: []InputValue{{Name: "reverse", Type: named("SCALAR", "Boolean"), DefaultValue: &defaultValue}},
+					Type: nonNull(list(nonNull(named("OBJECT", "Product")))),
+				}},
+			},
+			{
+				Kind: "OBJECT", Name: "Product", Description: "A product.",
+				Interfaces: []TypeRef{named("INTERFACE", "Node")},
+				Fields: []Field{
+					{Name: "id", Type: nonNull(named("SCALAR", "ID"))},
+					{Name: "name", Type: named("SCALAR", "String"), IsDeprecated: true, DeprecationReason: &reason},
+					{Name: "title", Description: "The product's \"title\"", Type: nonNull(named("SCALAR", "String"))},
+				},
+			},
+			{Kind: "INTERFACE", Name: "Node", Fields: []Field{{Name: "id", Type: nonNull(named("SCALAR", "ID"))}}},
+			{Kind: "UNION", Name: "Media", PossibleTypes: []TypeRef{named("OBJECT", "Image"), named("OBJECT", "Video")}},
+			{Kind: "ENUM", Name: "SortKeys", EnumValues: []EnumValue{{Name: "TITLE"}, {Name: "NAME", IsDeprecated: true}}},
+			{Kind: "INPUT_OBJECT", Name: "Input", InputFields: []InputValue{{Name: "title", Description: "Line one.\nLine two.", Type: named("SCALAR", "String")}}},
+		},
+		Directives: []Directive{
+			{Name: "include", Locations: []string{"FIELD"}},
+			{Name: "cached", Locations: []string{"QUERY", "FIELD"}, Args: []InputValue{{Name: "ttl", Type: named("SCALAR", "Int")}}},
+		},
+	}
+
+	var b strings.Builder
+	if !assert.NoError(PrintSDL(&b, s)) {
+		return
+	}
+
+	assert.Equal(`schema {
+  query: QueryRoot
+}
+
+directive @cached(ttl: Int) on QUERY | FIELD
+
+input Input {
+  """
+  Line one.
+  Line two.
+  """
+  title: String
+}
+
+union Media = Image | Video
+
+interface Node {
+  id: ID!
+}
+
+"""A product."""
+type Product implements Node {
+  id: ID!
+  name: String @deprecated(reason: "Use `+"`title`"+` instead")
+  """
+  The product's "title"
+  """
+  title: String!
+}
+
+type QueryRoot {
+  products(reverse: Boolean = false): [Product!]!
+}
+
+enum SortKeys {
+  TITLE
+  NAME @deprecated
+}
+`, b.String())
+}
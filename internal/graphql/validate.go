@@ -0,0 +1,862 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ValidationError describes a way in which a document is invalid against a
+// schema.
+type ValidationError struct {
+	Pos Position
+	Msg string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("graphql: %s: %s", e.Pos, e.Msg)
+}
+
+// ValidationErrors is a list of the ways in which a document is invalid.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface, returning the messages of each of the
+// errors, separated by newlines.
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Validate validates doc against s, returning ValidationErrors describing any
+// ways in which it's invalid. Uses of deprecated fields and enum values don't
+// make a document invalid, so are instead returned as warnings.
+func Validate(s *Schema, doc *Document) (warnings ValidationErrors, err error) {
+	v := newValidator(s, doc)
+	v.validate()
+
+	if len(v.errs) != 0 {
+		return v.warnings, v.errs
+	}
+
+	return v.warnings, nil
+}
+
+// InferVariables returns definitions of the variables op uses, in order of
+// first use, with the types expected where they're first used. It ignores
+// those op defines itself, so is intended for completing operations which
+// leave their variables undefined; the result should still be validated.
+func InferVariables(s *Schema, doc *Document, op *Operation) []*VariableDefinition {
+	v := newValidator(s, doc)
+	v.validateFragments()
+
+	root := v.rootType(op)
+	if root == nil {
+		return nil
+	}
+
+	var defs []*VariableDefinition
+	seen := map[string]bool{}
+
+	for _, def := range op.VariableDefinitions {
+		seen[def.Name] = true
+	}
+
+	for _, usage := range v.operationUsages(root, op).variables {
+		// Variables used within input objects with unknown fields have no
+		// expected type.
+		if seen[usage.name] || usage.typ.String() == "" {
+			continue
+		}
+
+		seen[usage.name] = true
+		defs = append(defs, &VariableDefinition{Name: usage.name, Type: usage.typ, Pos: usage.pos})
+	}
+
+	return defs
+}
+
+// newValidator returns a validator of doc against s.
+func newValidator(s *Schema, doc *Document) *validator {
+	v := &validator{
+		schema:     s,
+		doc:        doc,
+		types:      map[string]*Type{},
+		directives: map[string]*Directive{},
+	}
+
+	for i := range s.Types {
+		v.types[s.Types[i].Name] = &s.Types[i]
+	}
+
+	// Schemas in SDL don't define the types of the introspection system, though
+	// they're implicitly part of every schema.
+	for name, t := range introspection() {
+		if _, ok := v.types[name]; !ok {
+			v.types[name] = t
+		}
+	}
+
+	for i := range s.Directives {
+		v.directives[s.Directives[i].Name] = &s.Directives[i]
+	}
+
+	return v
+}
+
+// validator validates a document against a schema.
+type validator struct {
+	schema     *Schema
+	doc        *Document
+	types      map[string]*Type
+	directives map[string]*Directive
+	errs       ValidationErrors
+	warnings   ValidationErrors
+
+	// fragmentUsages caches the variable usages and fragment spreads within
+	// each fragment, which are attributed to the operations spreading it.
+	fragmentUsages map[string]*usages
+	// conflicts records the pairs of fields found to conflict, by position,
+	// so that each is reported once.
+	conflicts map[[2]Position]bool
+}
+
+// usages records the variables used, and fragments spread, within a selection
+// set.
+type usages struct {
+	variables []variableUsage
+	spreads   []string
+}
+
+// variableUsage describes a use of a variable where a value of typ is
+// expected.
+type variableUsage struct {
+	name string
+	typ  TypeRef
+	// hasDefault is whether the location of the usage has a default value,
+	// such that a nullable variable may be used where a non-null value is
+	// expected.
+	hasDefault bool
+	pos        Position
+}
+
+func (v *validator) errorf(pos Position, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Pos: pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+// deprecated records a warning at pos that the element described by what is
+// deprecated, for the given reason.
+func (v *validator) deprecated(pos Position, what string, reason *string) {
+	msg := fmt.Sprintf("The %s is deprecated.", what)
+	if reason != nil && *reason != "" {
+		msg += " " + *reason
+	}
+
+	v.warnings = append(v.warnings, &ValidationError{Pos: pos, Msg: msg})
+}
+
+func (v *validator) validate() {
+	names := map[string]bool{}
+	for _, op := range v.doc.Operations {
+		if op.Name == "" && len(v.doc.Operations) > 1 {
+			v.errorf(op.Pos, "This anonymous operation must be the only defined operation.")
+		}
+
+		if op.Name != "" && names[op.Name] {
+			v.errorf(op.Pos, "There can be only one operation named %q.", op.Name)
+		}
+
+		names[op.Name] = true
+	}
+
+	v.validateFragments()
+	v.validateFragmentCycles()
+
+	for _, op := range v.doc.Operations {
+		v.validateOperation(op)
+	}
+
+	v.validateOverlaps()
+}
+
+// validateFragments validates each fragment once, against its type
+// condition, recording the variables it uses so that they can be attributed to
+// each operation spreading it.
+func (v *validator) validateFragments() {
+	v.fragmentUsages = map[string]*usages{}
+
+	fragments := map[string]bool{}
+	for _, f := range v.doc.Fragments {
+		if fragments[f.Name] {
+			v.errorf(f.Pos, "There can be only one fragment named %q.", f.Name)
+			continue
+		}
+
+		fragments[f.Name] = true
+
+		u := &usages{}
+		v.fragmentUsages[f.Name] = u
+
+		t := v.compositeType(f.TypeCondition, f.Pos)
+		if t == nil {
+			continue
+		}
+
+		v.validateDirectives(f.Directives, f.Pos, u)
+		v.validateSelections(t, f.SelectionSet, u)
+	}
+}
+
+// compositeType returns the object, interface or union type named name,
+// recording an error at pos if there's none.
+func (v *validator) compositeType(name string, pos Position) *Type {
+	t, ok := v.types[name]
+	if !ok {
+		v.errorf(pos, "Unknown type %q.", name)
+		return nil
+	}
+
+	switch t.Kind {
+	case "OBJECT", "INTERFACE", "UNION":
+		return t
+	}
+
+	v.errorf(pos, "Fragment cannot condition on non composite type %q.", name)
+	return nil
+}
+
+// validateFragmentCycles records an error for each fragment which spreads
+// itself, directly or indirectly.
+func (v *validator) validateFragmentCycles() {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	state := map[string]int{}
+
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		switch state[name] {
+		case visiting:
+			return true
+		case visited:
+			return false
+		}
+
+		state[name] = visiting
+		defer func() { state[name] = visited }()
+
+		u, ok := v.fragmentUsages[name]
+		if !ok {
+			return false
+		}
+
+		for _, spread := range u.spreads {
+			if visit(spread) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, f := range v.doc.Fragments {
+		if state[f.Name] == 0 && visit(f.Name) {
+			v.errorf(f.Pos, "Cannot spread fragment %q within itself.", f.Name)
+		}
+	}
+}
+
+// validateOperation validates op, including its use of variables.
+func (v *validator) validateOperation(op *Operation) {
+	root := v.rootType(op)
+	if root == nil {
+		v.errorf(op.Pos, "Schema is not configured for %ss.", op.Type)
+		return
+	}
+
+	defs := map[string]*VariableDefinition{}
+	for _, def := range op.VariableDefinitions {
+		if _, ok := defs[def.Name]; ok {
+			v.errorf(def.Pos, "There can be only one variable named \"$%s\".", def.Name)
+			continue
+		}
+
+		defs[def.Name] = def
+
+		t, ok := v.types[def.Type.Named().Name]
+		switch {
+		case !ok:
+			v.errorf(def.Pos, "Unknown type %q.", def.Type.Named().Name)
+			continue
+		case t.Kind != "SCALAR" && t.Kind != "ENUM" && t.Kind != "INPUT_OBJECT":
+			v.errorf(def.Pos, "Variable \"$%s\" cannot be non-input type %q.", def.Name, def.Type)
+			continue
+		}
+
+		if def.DefaultValue != nil {
+			v.validateValue(def.Type, *def.DefaultValue, def.Pos, fmt.Sprintf("variable \"$%s\"", def.Name))
+		}
+	}
+
+	u := v.operationUsages(root, op)
+
+	opName := op.Name
+	if opName == "" {
+		opName = "anonymous"
+	}
+
+	used := map[string]bool{}
+	for _, usage := range u.variables {
+		used[usage.name] = true
+
+		def, ok := defs[usage.name]
+		if !ok {
+			v.errorf(usage.pos, "Variable \"$%s\" is not defined by operation %q.", usage.name, opName)
+			continue
+		}
+
+		if !variableAllowed(def, usage) {
+			v.errorf(usage.pos, "Variable \"$%s\" of type %q used in position expecting type %q.", usage.name, def.Type, usage.typ)
+		}
+	}
+
+	for _, def := range op.VariableDefinitions {
+		if !used[def.Name] {
+			v.errorf(def.Pos, "Variable \"$%s\" is never used in operation %q.", def.Name, opName)
+		}
+	}
+}
+
+// rootType returns the root type of operations of the type of op, or nil if
+// the schema doesn't support them.
+func (v *validator) rootType(op *Operation) *Type {
+	var root *TypeName
+	switch op.Type {
+	case "query":
+		root = v.schema.QueryType
+	case "mutation":
+		root = v.schema.MutationType
+	case "subscription":
+		root = v.schema.SubscriptionType
+	}
+
+	if root == nil {
+		return nil
+	}
+
+	return v.types[root.Name]
+}
+
+// operationUsages validates the selections of op, from its root type root,
+// returning the variables they use, including those used by the fragments
+// they spread, directly or indirectly.
+func (v *validator) operationUsages(root *Type, op *Operation) *usages {
+	u := &usages{}
+	v.validateDirectives(op.Directives, op.Pos, u)
+	v.validateSelections(root, op.SelectionSet, u)
+
+	seen := map[string]bool{}
+	for i := 0; i < len(u.spreads); i++ {
+		name := u.spreads[i]
+		if seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		if fu, ok := v.fragmentUsages[name]; ok {
+			u.variables = append(u.variables, fu.variables...)
+			u.spreads = append(u.spreads, fu.spreads...)
+		}
+	}
+
+	return u
+}
+
+// variableAllowed reports whether the variable defined by def may be used as
+// described by usage.
+func variableAllowed(def *VariableDefinition, usage variableUsage) bool {
+	if usage.typ.Kind == "NON_NULL" && def.Type.Kind != "NON_NULL" {
+		hasDefault := def.DefaultValue != nil && *def.DefaultValue != "null"
+		if !hasDefault && !usage.hasDefault {
+			return false
+		}
+
+		return isSubtype(def.Type, *usage.typ.OfType)
+	}
+
+	return isSubtype(def.Type, usage.typ)
+}
+
+// isSubtype reports whether a value of type a may be used where one of type
+// b is expected.
+func isSubtype(a, b TypeRef) bool {
+	switch {
+	case b.Kind == "NON_NULL":
+		return a.Kind == "NON_NULL" && isSubtype(*a.OfType, *b.OfType)
+	case a.Kind == "NON_NULL":
+		return isSubtype(*a.OfType, b)
+	case b.Kind == "LIST":
+		return a.Kind == "LIST" && isSubtype(*a.OfType, *b.OfType)
+	case a.Kind == "LIST":
+		return false
+	}
+
+	return a.Name == b.Name
+}
+
+// validateSelections validates the selections sels of the composite type t,
+// recording the variables and fragments they use in u.
+func (v *validator) validateSelections(t *Type, sels []Selection, u *usages) {
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *FieldSelection:
+			v.validateField(t, sel, u)
+		case *FragmentSpread:
+			v.validateDirectives(sel.Directives, sel.Pos, u)
+			u.spreads = append(u.spreads, sel.Name)
+
+			f := v.doc.Fragment(sel.Name)
+			if f == nil {
+				v.errorf(sel.Pos, "Unknown fragment %q.", sel.Name)
+				continue
+			}
+
+			if cond, ok := v.types[f.TypeCondition]; ok && !v.overlap(t, cond) {
+				v.errorf(sel.Pos, "Fragment %q cannot be spread here as objects of type %q can never be of type %q.", sel.Name, t.Name, cond.Name)
+			}
+		case *InlineFragment:
+			v.validateDirectives(sel.Directives, sel.Pos, u)
+
+			cond := t
+			if sel.TypeCondition != "" {
+				if cond = v.compositeType(sel.TypeCondition, sel.Pos); cond == nil {
+					continue
+				}
+
+				if !v.overlap(t, cond) {
+					v.errorf(sel.Pos, "Fragment cannot be spread here as objects of type %q can never be of type %q.", t.Name, cond.Name)
+				}
+			}
+
+			v.validateSelections(cond, sel.SelectionSet, u)
+		}
+	}
+}
+
+// validateField validates the selection f of a field of the composite type t.
+func (v *validator) validateField(t *Type, f *FieldSelection, u *usages) {
+	v.validateDirectives(f.Directives, f.Pos, u)
+
+	if f.Name == "__typename" {
+		if f.SelectionSet != nil {
+			v.errorf(f.Pos, "Field %q must not have a selection since type \"String!\" has no subfields.", f.Name)
+		}
+
+		return
+	}
+
+	def := v.schema.FieldDef(t, f.Name)
+	if def == nil {
+		v.errorf(f.Pos, "Cannot query field %q on type %q.", f.Name, t.Name)
+		return
+	}
+
+	if def.IsDeprecated {
+		v.deprecated(f.Pos, fmt.Sprintf("field \"%s.%s\"", t.Name, f.Name), def.DeprecationReason)
+	}
+
+	v.validateArguments(def.Args, f.Arguments, f.Pos, fmt.Sprintf("field \"%s.%s\"", t.Name, f.Name), u)
+
+	ft, ok := v.types[def.Type.Named().Name]
+	if !ok {
+		return
+	}
+
+	switch ft.Kind {
+	case "OBJECT", "INTERFACE", "UNION":
+		if f.SelectionSet == nil {
+			v.errorf(f.Pos, "Field %q of type %q must have a selection of subfields.", f.Name, def.Type)
+			return
+		}
+
+		v.validateSelections(ft, f.SelectionSet, u)
+	default:
+		if f.SelectionSet != nil {
+			v.errorf(f.Pos, "Field %q must not have a selection since type %q has no subfields.", f.Name, def.Type)
+		}
+	}
+}
+
+// FieldDef returns the definition of the field named name of the object or
+// interface type t, or nil if there's none.
+func FieldDef(t *Type, name string) *Field {
+	for i := range t.Fields {
+		if t.Fields[i].Name == name {
+			return &t.Fields[i]
+		}
+	}
+
+	return nil
+}
+
+// validateDirectives validates the directives ds applied at pos.
+func (v *validator) validateDirectives(ds []AppliedDirective, pos Position, u *usages) {
+	for _, d := range ds {
+		def, ok := v.directives[d.Name]
+		if !ok {
+			v.errorf(pos, "Unknown directive \"@%s\".", d.Name)
+			continue
+		}
+
+		v.validateArguments(def.Args, d.Args, pos, fmt.Sprintf("directive \"@%s\"", d.Name), u)
+	}
+}
+
+// validateArguments validates the arguments args given for those defined by
+// defs, of the field or directive described by of.
+func (v *validator) validateArguments(defs []InputValue, args []Argument, pos Position, of string, u *usages) {
+	given := map[string]bool{}
+
+	for _, arg := range args {
+		if given[arg.Name] {
+			v.errorf(pos, "There can be only one argument named %q.", arg.Name)
+			continue
+		}
+
+		given[arg.Name] = true
+
+		def := inputValueDef(defs, arg.Name)
+		if def == nil {
+			v.errorf(pos, "Unknown argument %q on %s.", arg.Name, of)
+			continue
+		}
+
+		v.validateValue(def.Type, arg.Value, pos, fmt.Sprintf("argument %q of %s", arg.Name, of))
+		v.collectVariables(def.Type, arg.Value, def.DefaultValue != nil, pos, u)
+	}
+
+	for _, def := range defs {
+		if def.Type.Kind == "NON_NULL" && def.DefaultValue == nil && !given[def.Name] {
+			v.errorf(pos, "Argument %q of type %q is required on %s, but it was not provided.", def.Name, def.Type, of)
+		}
+	}
+}
+
+// inputValueDef returns the definition of the argument or input field named
+// name among defs, or nil if there's none.
+func inputValueDef(defs []InputValue, name string) *InputValue {
+	for i := range defs {
+		if defs[i].Name == name {
+			return &defs[i]
+		}
+	}
+
+	return nil
+}
+
+// collectVariables records the variables used by the literal value, where a
+// value of typ is expected, in u. hasDefault is whether the argument the value
+// is given for has a default, in which case a nullable variable may be used
+// directly as the value even if typ is non-null.
+func (v *validator) collectVariables(typ TypeRef, literal string, hasDefault bool, pos Position, u *usages) {
+	value, err := parseValue(literal)
+	if err != nil {
+		return
+	}
+
+	var collect func(typ TypeRef, value *valueNode, top bool)
+	collect = func(typ TypeRef, value *valueNode, top bool) {
+		switch value.kind {
+		case valueVariable:
+			u.variables = append(u.variables, variableUsage{name: value.raw, typ: typ, hasDefault: top && hasDefault, pos: pos})
+		case valueList:
+			of := typ
+			if of.Kind == "NON_NULL" {
+				of = *of.OfType
+			}
+
+			if of.Kind == "LIST" {
+				of = *of.OfType
+			}
+
+			for _, item := range value.list {
+				collect(of, item, false)
+			}
+		case valueObject:
+			t := v.types[typ.Named().Name]
+			for _, field := range value.fields {
+				var ft TypeRef
+				if t != nil {
+					if def := inputValueDef(t.InputFields, field.name); def != nil {
+						ft = def.Type
+					}
+				}
+
+				collect(ft, field.value, false)
+			}
+		}
+	}
+
+	collect(typ, value, true)
+}
+
+// validateValue validates the literal value, where a value of typ is expected,
+// recording an error at pos describing it as that of the element described by
+// of.
+func (v *validator) validateValue(typ TypeRef, literal string, pos Position, of string) {
+	value, err := parseValue(literal)
+	if err != nil {
+		v.errorf(pos, "Invalid value %s for %s: %s.", literal, of, err)
+		return
+	}
+
+	if msg := v.checkValue(typ, value, pos); msg != "" {
+		v.errorf(pos, "Invalid value %s for %s: %s.", literal, of, msg)
+	}
+}
+
+// checkValue checks value against typ, returning a description of the
+// problem if it's invalid, or an empty string if it's valid. Variables are
+// checked separately, so are always accepted. Deprecated enum values are
+// recorded as warnings at pos.
+func (v *validator) checkValue(typ TypeRef, value *valueNode, pos Position) string {
+	if value.kind == valueVariable {
+		return ""
+	}
+
+	if typ.Kind == "NON_NULL" {
+		if value.kind == valueNull {
+			return fmt.Sprintf("expected non-null %s", typ)
+		}
+
+		return v.checkValue(*typ.OfType, value, pos)
+	}
+
+	if value.kind == valueNull {
+		return ""
+	}
+
+	if typ.Kind == "LIST" {
+		if value.kind != valueList {
+			// A single value is coerced to a list of one.
+			return v.checkValue(*typ.OfType, value, pos)
+		}
+
+		for _, item := range value.list {
+			if msg := v.checkValue(*typ.OfType, item, pos); msg != "" {
+				return msg
+			}
+		}
+
+		return ""
+	}
+
+	t, ok := v.types[typ.Name]
+	if !ok {
+		return ""
+	}
+
+	switch t.Kind {
+	case "ENUM":
+		if value.kind != valueEnum {
+			return fmt.Sprintf("expected enum %s", t.Name)
+		}
+
+		for _, ev := range t.EnumValues {
+			if ev.Name == value.raw {
+				if ev.IsDeprecated {
+					v.deprecated(pos, fmt.Sprintf("enum value \"%s.%s\"", t.Name, ev.Name), ev.DeprecationReason)
+				}
+
+				return ""
+			}
+		}
+
+		return fmt.Sprintf("%s isn't a value of enum %s", value.raw, t.Name)
+	case "INPUT_OBJECT":
+		if value.kind != valueObject {
+			return fmt.Sprintf("expected input object %s", t.Name)
+		}
+
+		given := map[string]bool{}
+		for _, field := range value.fields {
+			given[field.name] = true
+
+			def := inputValueDef(t.InputFields, field.name)
+			if def == nil {
+				return fmt.Sprintf("%s isn't a field of input object %s", field.name, t.Name)
+			}
+
+			if msg := v.checkValue(def.Type, field.value, pos); msg != "" {
+				return msg
+			}
+		}
+
+		for _, def := range t.InputFields {
+			if def.Type.Kind == "NON_NULL" && def.DefaultValue == nil && !given[def.Name] {
+				return fmt.Sprintf("field %s of input object %s is required", def.Name, t.Name)
+			}
+		}
+
+		return ""
+	}
+
+	switch t.Name {
+	case "Int":
+		if value.kind != valueInt {
+			return "expected Int"
+		}
+
+		// Int is a signed 32-bit integer.
+		if _, err := strconv.ParseInt(value.raw, 10, 32); err != nil {
+			return fmt.Sprintf("%s is out of range for Int", value.raw)
+		}
+	case "Float":
+		if value.kind != valueInt && value.kind != valueFloat {
+			return "expected Float"
+		}
+	case "String":
+		if value.kind != valueString {
+			return "expected String"
+		}
+	case "ID":
+		if value.kind != valueString && value.kind != valueInt {
+			return "expected ID"
+		}
+	case "Boolean":
+		if value.kind != valueBoolean {
+			return "expected Boolean"
+		}
+	}
+
+	// Custom scalars accept any literal.
+	return ""
+}
+
+// overlap reports whether an object may be of both of the composite types a
+// and b.
+func (v *validator) overlap(a, b *Type) bool {
+	bs := map[string]bool{}
+	for _, name := range possibleTypes(b) {
+		bs[name] = true
+	}
+
+	for _, name := range possibleTypes(a) {
+		if bs[name] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// possibleTypes returns the names of the object types an object of the
+// composite type t may be.
+func possibleTypes(t *Type) []string {
+	if t.Kind == "OBJECT" {
+		return []string{t.Name}
+	}
+
+	names := make([]string, len(t.PossibleTypes))
+	for i, pt := range t.PossibleTypes {
+		names[i] = pt.Name
+	}
+
+	return names
+}
+
+// valueKind is the kind of a value literal.
+type valueKind int
+
+const (
+	valueVariable valueKind = iota
+	valueInt
+	valueFloat
+	valueString
+	valueBoolean
+	valueNull
+	valueEnum
+	valueList
+	valueObject
+)
+
+// valueNode is a parsed value literal.
+type valueNode struct {
+	kind valueKind
+	// raw is the name of a variable, the source of a number, the value of a
+	// string, or the name of an enum value, boolean or null.
+	raw    string
+	list   []*valueNode
+	fields []objectField
+}
+
+// objectField is a field of an input object literal.
+type objectField struct {
+	name  string
+	value *valueNode
+}
+
+// parseValue parses a value literal, as recorded by the parser.
+func parseValue(literal string) (value *valueNode, err error) {
+	defer recoverSyntaxError(&err)
+
+	p, err := newParser(literal)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.valueNode(), nil
+}
+
+// valueNode consumes a value.
+func (p *parser) valueNode() *valueNode {
+	t := p.next()
+
+	switch t.kind {
+	case tokenInt:
+		return &valueNode{kind: valueInt, raw: t.value}
+	case tokenFloat:
+		return &valueNode{kind: valueFloat, raw: t.value}
+	case tokenString, tokenBlockString:
+		return &valueNode{kind: valueString, raw: t.value}
+	case tokenName:
+		switch t.value {
+		case "true", "false":
+			return &valueNode{kind: valueBoolean, raw: t.value}
+		case "null":
+			return &valueNode{kind: valueNull, raw: t.value}
+		}
+
+		return &valueNode{kind: valueEnum, raw: t.value}
+	}
+
+	switch t.value {
+	case "$":
+		return &valueNode{kind: valueVariable, raw: p.name()}
+	case "[":
+		v := &valueNode{kind: valueList}
+		for !p.skip("]") {
+			v.list = append(v.list, p.valueNode())
+		}
+
+		return v
+	case "{":
+		v := &valueNode{kind: valueObject}
+		for !p.skip("}") {
+			name := p.name()
+			p.expect(":")
+			v.fields = append(v.fields, objectField{name: name, value: p.valueNode()})
+		}
+
+		return v
+	}
+
+	p.errorf(t, "unexpected %s", t)
+	return nil
+}
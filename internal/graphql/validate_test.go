@@ -0,0 +1,267 @@
+package graphql
+
+import (
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := ParseSDL(testSDL)
+	if !assert.NoError(err) {
+		return
+	}
+
+	doc, err := ParseQuery(`
+query GetProduct($id: ID!, $sortKey: SortKeys) {
+  product(id: $id) { ...ProductFields }
+  product(id: $id) { slug }
+  products(sortKey: $sortKey, filters: {available: true}) { __typename id }
+  node(id: "1") { ... on Product { slug } }
+}
+
+fragment ProductFields on Product {
+  id
+  slug @skip(if: false)
+}`)
+	if assert.NoError(err) {
+		warnings, err := Validate(s, doc)
+		assert.NoError(err)
+		assert.Empty(warnings)
+	}
+
+	tests := []struct {
+		src  string
+		want []string
+	}{
+		{
+			"{ product(id: 1) { price } }",
+			[]string{`1:20: Cannot query field "price" on type "Product".`},
+		},
+		{
+			"{ product { id } }",
+			[]string{`1:3: Argument "id" of type "ID!" is required on field "Query.product", but it was not provided.`},
+		},
+		{
+			"{ product(id: 1, handle: \"x\") { id } }",
+			[]string{`1:3: Unknown argument "handle" on field "Query.product".`},
+		},
+		{
+			"{ product(id: 1) }",
+			[]string{`1:3: Field "product" of type "Product" must have a selection of subfields.`},
+		},
+		{
+			"{ product(id: 1) { id { value } } }",
+			[]string{`1:20: Field "id" must not have a selection since type "ID!" has no subfields.`},
+		},
+		{
+			"{ products(sortKey: BEST_SELLING) { id } }",
+			[]string{`1:3: Invalid value BEST_SELLING for argument "sortKey" of field "Query.products": BEST_SELLING isn't a value of enum SortKeys.`},
+		},
+		{
+			"{ products(first: 2147483648) { id } }",
+			[]string{`1:3: Invalid value 2147483648 for argument "first" of field "Query.products": 2147483648 is out of range for Int.`},
+		},
+		{
+			"{ products(first: -2147483649) { id } }",
+			[]string{`1:3: Invalid value -2147483649 for argument "first" of field "Query.products": -2147483649 is out of range for Int.`},
+		},
+		{
+			`{ products(filters: [{available: "yes"}]) { id } }`,
+			[]string{`1:3: Invalid value [{available: "yes"}] for argument "filters" of field "Query.products": expected Boolean.`},
+		},
+		{
+			"query Q($id: ID, $unused: Int) { product(id: $id) { id } }",
+			[]string{
+				`1:34: Variable "$id" of type "ID" used in position expecting type "ID!".`,
+				`1:18: Variable "$unused" is never used in operation "Q".`,
+			},
+		},
+		{
+			"query Q { product(id: $id) { id } }",
+			[]string{`1:11: Variable "$id" is not defined by operation "Q".`},
+		},
+		{
+			"query Q($p: Product) { product(id: 1) { id } }",
+			[]string{
+				`1:9: Variable "$p" cannot be non-input type "Product".`,
+				`1:9: Variable "$p" is never used in operation "Q".`,
+			},
+		},
+		{
+			"query Q($first: Int) { products { ...F } } fragment F on Product { id @include(if: $first) }",
+			[]string{`1:68: Variable "$first" of type "Int" used in position expecting type "Boolean!".`},
+		},
+		{
+			"{ product(id: 1) { ...Missing ... on Filter { available } } }",
+			[]string{
+				`1:20: Unknown fragment "Missing".`,
+				`1:31: Fragment cannot condition on non composite type "Filter".`,
+			},
+		},
+		{
+			"{ node(id: 1) { ...F } } fragment F on Query { __typename }",
+			[]string{`1:17: Fragment "F" cannot be spread here as objects of type "Node" can never be of type "Query".`},
+		},
+		{
+			"{ product(id: 1) { ...A } } fragment A on Product { ...B } fragment B on Product { ...A }",
+			[]string{`1:29: Cannot spread fragment "A" within itself.`},
+		},
+		{
+			"query Q { product(id: 1) @cached { id } } query Q { products { id } } { node(id: 1) { id } }",
+			[]string{
+				`1:43: There can be only one operation named "Q".`,
+				`1:71: This anonymous operation must be the only defined operation.`,
+				`1:11: Unknown directive "@cached".`,
+			},
+		},
+		{
+			"{ product(id: 1) { x: slug x: name } }",
+			[]string{`1:20: Fields "x" conflict because "slug" and "name" are different fields. Use different aliases on the fields to fetch both if this was intentional.`},
+		},
+		{
+			"{ p: products(first: 1) { id } ...F } fragment F on Query { p: products(first: 2) { id } }",
+			[]string{`1:3: Fields "p" conflict because they have differing arguments. Use different aliases on the fields to fetch both if this was intentional.`},
+		},
+		{
+			"{ product(id: 1) { id } product(id: 1) { id: matrix } }",
+			[]string{`1:3: Fields "product" conflict because subfields "id" conflict because "id" and "matrix" are different fields. Use different aliases on the fields to fetch both if this was intentional.`},
+		},
+		{
+			"mutation { product(id: 1) { id } }",
+			[]string{`1:1: Schema is not configured for mutations.`},
+		},
+	}
+
+	for _, tt := range tests {
+		doc, err := ParseQuery(tt.src)
+		if !assert.NoError(err, tt.src) {
+			continue
+		}
+
+		_, err = Validate(s, doc)
+
+		var got []string
+		if errs, ok := err.(ValidationErrors); assert.True(ok, tt.src) {
+			for _, e := range errs {
+				got = append(got, e.Pos.String()+": "+e.Msg)
+			}
+		}
+
+		assert.Equal(tt.want, got, tt.src)
+	}
+}
+
+func TestValidate_Deprecations(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := ParseSDL(testSDL)
+	if !assert.NoError(err) {
+		return
+	}
+
+	doc, err := ParseQuery(`{
+  products(sortKey: PRICE) { name handle }
+}`)
+	if !assert.NoError(err) {
+		return
+	}
+
+	warnings, err := Validate(s, doc)
+	assert.NoError(err)
+
+	var got []string
+	for _, w := range warnings {
+		got = append(got, w.Error())
+	}
+
+	assert.Equal([]string{
+		`graphql: 2:3: The enum value "SortKeys.PRICE" is deprecated. Sort client-side`,
+		`graphql: 2:30: The field "Product.name" is deprecated. No longer supported`,
+		"graphql: 2:35: The field \"Product.handle\" is deprecated. Use `slug` instead",
+	}, got)
+}
+
+func TestInferVariables(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := ParseSDL(testSDL)
+	if !assert.NoError(err) {
+		return
+	}
+
+	doc, err := ParseQuery(`query ($id: ID) {
+  product(id: $id) { id }
+  products(first: $first, filters: [{available: $available}]) { ...F }
+}
+
+fragment F on Product { slug @include(if: $slug) }`)
+	if !assert.NoError(err) {
+		return
+	}
+
+	var got []string
+	for _, def := range InferVariables(s, doc, doc.Operations[0]) {
+		got = append(got, def.Name+": "+def.Type.String())
+	}
+
+	assert.Equal([]string{"first: Int", "available: Boolean", "slug: Boolean!"}, got)
+}
+
+func TestValidate_File(t *testing.T) {
+	assert := assert.New(t)
+
+	bs, err := ioutil.ReadFile(path.Join("..", "..", "schema", "2022-01", "schema.json"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	s, err := ParseIntrospection(bs)
+	if !assert.NoError(err) {
+		return
+	}
+
+	files, err := filepath.Glob(path.Join("..", "..", "test", "*.graphql"))
+	if !assert.NoError(err) || !assert.NotEmpty(files) {
+		return
+	}
+
+	for _, file := range files {
+		bs, err := ioutil.ReadFile(file)
+		if !assert.NoError(err) {
+			continue
+		}
+
+		doc, err := ParseQuery(string(bs))
+		if assert.NoError(err, file) {
+			_, err := Validate(s, doc)
+			assert.NoError(err, file)
+		}
+	}
+}
+
+func TestValidate_Introspection(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := ParseSDL(testSDL)
+	if !assert.NoError(err) {
+		return
+	}
+
+	doc, err := ParseQuery(IntrospectionQuery)
+	if assert.NoError(err) {
+		_, err := Validate(s, doc)
+		assert.NoError(err)
+	}
+
+	doc, err = ParseQuery(`{ __type(name: "Product") { name fields { name type { kind } } } product(id: "1") { __schema { description } } }`)
+	if assert.NoError(err) {
+		_, err := Validate(s, doc)
+		assert.EqualError(err, `graphql: 1:85: Cannot query field "__schema" on type "Product".`)
+	}
+}
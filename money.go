@@ -0,0 +1,73 @@
+package storefront
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCurrencyMismatch indicates an operation on two amounts of money in
+// different currencies.
+var ErrCurrencyMismatch = errors.New("storefront: currency mismatch")
+
+// NewMoney returns an amount of money in the given currency.
+func NewMoney(amount Decimal, currencyCode CurrencyCode) MoneyV2 {
+	return MoneyV2{Amount: amount, CurrencyCode: currencyCode}
+}
+
+// Add returns m + o. It returns an error wrapping ErrCurrencyMismatch if the
+// amounts are in different currencies.
+func (m MoneyV2) Add(o MoneyV2) (MoneyV2, error) {
+	if err := m.checkCurrency(o); err != nil {
+		return MoneyV2{}, err
+	}
+
+	return NewMoney(m.Amount.Add(o.Amount), m.CurrencyCode), nil
+}
+
+// Sub returns m - o. It returns an error wrapping ErrCurrencyMismatch if the
+// amounts are in different currencies.
+func (m MoneyV2) Sub(o MoneyV2) (MoneyV2, error) {
+	if err := m.checkCurrency(o); err != nil {
+		return MoneyV2{}, err
+	}
+
+	return NewMoney(m.Amount.Sub(o.Amount), m.CurrencyCode), nil
+}
+
+// Mul returns m multiplied by a factor, such as a quantity or a percentage
+// expressed as a fraction. The result isn't rounded.
+func (m MoneyV2) Mul(factor Decimal) MoneyV2 {
+	return NewMoney(m.Amount.Mul(factor), m.CurrencyCode)
+}
+
+// Cmp compares m and o, returning -1 if m < o, 0 if m == o and +1 if m > o.
+// It returns an error wrapping ErrCurrencyMismatch if the amounts are in
+// different currencies.
+func (m MoneyV2) Cmp(o MoneyV2) (int, error) {
+	if err := m.checkCurrency(o); err != nil {
+		return 0, err
+	}
+
+	return m.Amount.Cmp(o.Amount), nil
+}
+
+// Round returns m rounded to the minor unit of its currency, such as cents for
+// USD or whole yen for JPY.
+func (m MoneyV2) Round() MoneyV2 {
+	return NewMoney(m.Amount.Round(m.CurrencyCode.MinorUnits()), m.CurrencyCode)
+}
+
+// String returns m rounded to the minor unit of its currency, followed by the
+// currency code, such as "12.50 USD".
+func (m MoneyV2) String() string {
+	return fmt.Sprintf("%s %s", m.Round().Amount, m.CurrencyCode)
+}
+
+// checkCurrency returns an error if m and o are in different currencies.
+func (m MoneyV2) checkCurrency(o MoneyV2) error {
+	if m.CurrencyCode != o.CurrencyCode {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.CurrencyCode, o.CurrencyCode)
+	}
+
+	return nil
+}
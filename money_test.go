@@ -0,0 +1,48 @@
+package storefront
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoneyV2(t *testing.T) {
+	assert := assert.New(t)
+
+	a := NewMoney(MustParseDecimal("19.99"), CurrencyCodeUsd)
+	b := NewMoney(MustParseDecimal("5.01"), CurrencyCodeUsd)
+
+	sum, err := a.Add(b)
+	if assert.NoError(err) {
+		assert.Equal("25.00 USD", sum.String())
+	}
+
+	diff, err := a.Sub(b)
+	if assert.NoError(err) {
+		assert.Equal("14.98 USD", diff.String())
+	}
+
+	cmp, err := a.Cmp(b)
+	if assert.NoError(err) {
+		assert.Equal(1, cmp)
+	}
+
+	// A 15% discount, rounded to the cent.
+	assert.Equal("3.00 USD", a.Mul(MustParseDecimal("0.15")).String())
+	assert.Equal("2.9985", a.Mul(MustParseDecimal("0.15")).Amount.String())
+
+	_, err = a.Add(NewMoney(MustParseDecimal("1"), CurrencyCodeCad))
+	assert.ErrorIs(err, ErrCurrencyMismatch)
+
+	_, err = a.Cmp(NewMoney(MustParseDecimal("1"), CurrencyCodeCad))
+	assert.ErrorIs(err, ErrCurrencyMismatch)
+}
+
+func TestMoneyV2_Round(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("1235 JPY", NewMoney(MustParseDecimal("1234.5"), CurrencyCodeJpy).String())
+	assert.Equal("1.235 KWD", NewMoney(MustParseDecimal("1.2345"), CurrencyCodeKwd).String())
+	assert.Equal("1.23 EUR", NewMoney(MustParseDecimal("1.2345"), CurrencyCodeEur).String())
+	assert.Equal("10.00", NewMoney(MustParseDecimal("10"), CurrencyCodeUsd).Round().Amount.String())
+}
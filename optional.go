@@ -0,0 +1,132 @@
+package storefront
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// optionalState is the state of an Optional: unset, null or set to a value.
+type optionalState uint8
+
+const (
+	optionalUnset optionalState = iota
+	optionalNull
+	optionalValue
+)
+
+// Optional is the type of the nullable fields of input types, which may be
+// left unset, in which case they're omitted, set to null, or set to a value,
+// which may be a zero value. Its zero value is unset; Some and Null set it.
+type Optional[T any] struct {
+	value T
+	state optionalState
+}
+
+// Some returns an Optional set to v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, state: optionalValue}
+}
+
+// Null returns an Optional set to null.
+func Null[T any]() Optional[T] {
+	return Optional[T]{state: optionalNull}
+}
+
+// IsSet reports whether o is set, either to null or a value.
+func (o Optional[T]) IsSet() bool {
+	return o.state != optionalUnset
+}
+
+// IsNull reports whether o is set to null.
+func (o Optional[T]) IsNull() bool {
+	return o.state == optionalNull
+}
+
+// Get returns the value o is set to, and whether it's set to one.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.state == optionalValue
+}
+
+// MarshalJSON implements json.Marshaler, encoding o as null unless it's set
+// to a value. Input types omit their fields which are unset.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != optionalValue {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, setting o to null or the value
+// data holds. Fields absent from an object are left unset.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Null[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*o = Some(v)
+	return nil
+}
+
+// optional is implemented by each Optional, so that unset fields can be
+// found regardless of their type arguments.
+type optional interface {
+	IsSet() bool
+}
+
+// marshalInput encodes v, a struct of an input type, as a JSON object of its
+// fields, omitting those of Optional types which are unset.
+func marshalInput(v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	t := rv.Type()
+
+	var b bytes.Buffer
+	b.WriteString("{")
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		fv := rv.Field(i).Interface()
+		if o, ok := fv.(optional); ok && !o.IsSet() {
+			continue
+		}
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(fv)
+		if err != nil {
+			return nil, err
+		}
+
+		if b.Len() > 1 {
+			b.WriteString(",")
+		}
+
+		b.Write(key)
+		b.WriteString(":")
+		b.Write(value)
+	}
+
+	b.WriteString("}")
+	return b.Bytes(), nil
+}
@@ -0,0 +1,52 @@
+package storefront
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptional(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("States", func(t *testing.T) {
+		var unset Optional[int]
+		assert.False(unset.IsSet())
+		assert.False(unset.IsNull())
+
+		null := Null[int]()
+		assert.True(null.IsSet())
+		assert.True(null.IsNull())
+
+		_, ok := null.Get()
+		assert.False(ok)
+
+		zero := Some(0)
+		assert.True(zero.IsSet())
+		assert.False(zero.IsNull())
+
+		if v, ok := zero.Get(); assert.True(ok) {
+			assert.Equal(0, v)
+		}
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		bs, err := json.Marshal(Some([]string{}))
+		assert.NoError(err)
+		assert.Equal(`[]`, string(bs))
+
+		bs, err = json.Marshal(Null[[]string]())
+		assert.NoError(err)
+		assert.Equal(`null`, string(bs))
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		var input CartLineUpdateInput
+
+		assert.NoError(json.Unmarshal([]byte(`{"id": "1", "quantity": 0, "sellingPlanId": null}`), &input))
+		assert.Equal(Some(0), input.Quantity)
+		assert.Equal(Null[string](), input.SellingPlanId)
+		assert.False(input.MerchandiseId.IsSet())
+	})
+}
@@ -0,0 +1,123 @@
+package storefront
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option describes a function which configures a Client constructed by New.
+type Option func(*Client) error
+
+// WithHTTPClient sets the *http.Client used to make requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("storefront: nil HTTP client")
+		}
+
+		c.HTTPClient = httpClient
+		return nil
+	}
+}
+
+// WithAPIVersion sets the Storefront API version the client targets, in place
+// of APIVersion. The version must be of the form YYYY-MM, naming a quarterly
+// release, or "unstable". Note that the generated types correspond to
+// SchemaVersion, regardless of the version a client targets.
+func WithAPIVersion(version string) Option {
+	return func(c *Client) error {
+		if err := validateAPIVersion(version); err != nil {
+			return err
+		}
+
+		c.apiVersion = version
+		return nil
+	}
+}
+
+// WithEndpoint sets the base URL requests are sent to, in place of the
+// https://<domain> URL derived from the domain passed to New. The URL may use
+// either the http or https scheme, such as that of an httptest.Server or a
+// proxy. If it has no path, the usual /api/<version>/graphql.json path is
+// appended; otherwise, the URL is used as the endpoint as-is.
+func WithEndpoint(rawURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return fmt.Errorf("storefront: invalid endpoint: %w", err)
+		}
+
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("storefront: invalid endpoint %q: scheme must be http or https", rawURL)
+		}
+
+		if u.Host == "" {
+			return fmt.Errorf("storefront: invalid endpoint %q: missing host", rawURL)
+		}
+
+		c.baseURL = u
+		return nil
+	}
+}
+
+// WithPrivateToken sets a private (or delegate) access token, which is sent in
+// the Shopify-Storefront-Private-Token header. Private tokens are intended for
+// server-side use and must never be exposed to buyers. When using one, the
+// public access token passed to New may be empty, in which case it isn't
+// sent at all.
+func WithPrivateToken(token string) Option {
+	return func(c *Client) error {
+		c.privateToken = token
+		return nil
+	}
+}
+
+// WithTimeout sets the client's Timeout. A zero duration applies no timeout
+// beyond that of the HTTP client.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		c.Timeout = d
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the client's RetryPolicy.
+func WithRetryPolicy(p *RetryPolicy) Option {
+	return func(c *Client) error {
+		c.RetryPolicy = p
+		return nil
+	}
+}
+
+// WithStrictEnums has the client reject responses holding values of enums
+// which aren't known to this version of the package, such as those added in a
+// newer API version, with an error wrapping ErrInvalidEnum, rather than
+// decoding them as the enum's Unknown sentinel. Values are checked against the
+// embedded schema, so the client returns ErrNoSchema if there's none.
+func WithStrictEnums() Option {
+	return func(c *Client) error {
+		c.strictEnums = true
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithHeader adds a header to be sent with each request. It may be specified
+// more than once, including for the same key. Headers required by the API,
+// such as the access token, can't be overridden.
+func WithHeader(key, value string) Option {
+	return func(c *Client) error {
+		c.header.Add(key, value)
+		return nil
+	}
+}
@@ -0,0 +1,121 @@
+package storefront
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Defaults", func(t *testing.T) {
+		c, err := New("DOMAIN", "API_KEY")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(fmt.Sprintf("https://DOMAIN/api/%s/graphql.json", APIVersion), c.endpoint)
+		assert.Equal("API_KEY", c.accessToken)
+		assert.NotNil(c.HTTPClient)
+		assert.Equal(DefaultTimeout, c.Timeout)
+		assert.Nil(c.RetryPolicy)
+		assert.Equal(SchemaVersion, c.APIVersion())
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		httpClient := &http.Client{}
+		p := DefaultRetryPolicy()
+
+		c, err := New("DOMAIN", "API_KEY",
+			WithHTTPClient(httpClient),
+			WithAPIVersion("2022-04"),
+			WithTimeout(time.Second),
+			WithRetryPolicy(p),
+			WithUserAgent("storefront-test/1.0"),
+			WithHeader("X-Test", "a"),
+			WithHeader("X-Test", "b"),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal("https://DOMAIN/api/2022-04/graphql.json", c.endpoint)
+		assert.Equal("2022-04", c.APIVersion())
+		assert.Equal(httpClient, c.HTTPClient)
+		assert.Equal(time.Second, c.Timeout)
+		assert.Equal(p, c.RetryPolicy)
+		assert.Equal("storefront-test/1.0", c.userAgent)
+		assert.Equal([]string{"a", "b"}, c.header.Values("X-Test"))
+	})
+
+	t.Run("InvalidAPIVersion", func(t *testing.T) {
+		for _, version := range []string{"", "2022-02", "22-01", "latest"} {
+			_, err := New("DOMAIN", "API_KEY", WithAPIVersion(version))
+			assert.ErrorIs(err, ErrInvalidAPIVersion, version)
+		}
+
+		_, err := New("DOMAIN", "API_KEY", WithAPIVersion("unstable"))
+		assert.NoError(err)
+	})
+
+	t.Run("Endpoint", func(t *testing.T) {
+		tests := []struct {
+			url  string
+			want string
+		}{
+			{"http://127.0.0.1:8080", "http://127.0.0.1:8080/api/2022-04/graphql.json"},
+			{"https://proxy.internal/", "https://proxy.internal/api/2022-04/graphql.json"},
+			{"https://proxy.internal/shop/graphql", "https://proxy.internal/shop/graphql"},
+		}
+
+		for _, tt := range tests {
+			c, err := New("DOMAIN", "API_KEY", WithEndpoint(tt.url), WithAPIVersion("2022-04"))
+			if assert.NoError(err, tt.url) {
+				assert.Equal(tt.want, c.Endpoint())
+			}
+		}
+
+		for _, url := range []string{"ftp://proxy.internal", "proxy.internal", "http://", "://"} {
+			_, err := New("DOMAIN", "API_KEY", WithEndpoint(url))
+			assert.Error(err, url)
+		}
+	})
+
+	t.Run("NilHTTPClient", func(t *testing.T) {
+		_, err := New("DOMAIN", "API_KEY", WithHTTPClient(nil))
+		assert.Error(err)
+	})
+}
+
+func TestClient_Do_Headers(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("storefront-test/1.0", r.Header.Get("User-Agent"))
+		assert.Equal([]string{"a", "b"}, r.Header.Values("X-Test"))
+		assert.Equal("API_KEY", r.Header.Get("X-Shopify-Storefront-Access-Token"))
+		assert.Equal("application/json", r.Header.Get("Content-Type"))
+
+		fmt.Fprint(w, `{"data":{"shop":{"name":"Shop name"}}}`)
+	}))
+	defer srv.Close()
+
+	c, err := New("DOMAIN", "API_KEY",
+		WithUserAgent("storefront-test/1.0"),
+		WithHeader("X-Test", "a"),
+		WithHeader("X-Test", "b"),
+		WithHeader("Content-Type", "text/plain"),
+		WithEndpoint(srv.URL),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var set Set
+	assert.NoError(c.Query("{ shop { name } }", &set))
+}
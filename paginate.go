@@ -0,0 +1,225 @@
+package storefront
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultPageSize is the number of nodes requested per page by a Paginator,
+// unless the variables passed to Paginate specify first (or last, when
+// paginating backward).
+const DefaultPageSize = 50
+
+// ErrInvalidPath indicates that the path passed to Paginate doesn't lead to a
+// connection in the response.
+var ErrInvalidPath = errors.New("storefront: path doesn't lead to a connection")
+
+// ErrMissingCursor indicates that a page of a connection with more pages had
+// no cursor from which to fetch them, as neither the cursor of its edges nor
+// that of its pageInfo was selected.
+var ErrMissingCursor = errors.New("storefront: cursor must be selected to paginate")
+
+// Paginator iterates over the nodes of a connection, fetching its pages as
+// required. It's typically used as:
+//
+//	p := storefront.Paginate[storefront.Product](ctx, sf, query, nil, "products")
+//	for p.Next() {
+//		log.Print(p.Node().Title)
+//	}
+//
+//	if err := p.Err(); err != nil {
+//		// Handle
+//	}
+//
+// A Paginator isn't safe for concurrent use.
+type Paginator[T any] struct {
+	ctx      context.Context
+	client   *Client
+	query    string
+	vars     map[string]interface{}
+	path     []string
+	backward bool
+
+	edges   []Edge[T]
+	i       int
+	cursor  string
+	hasMore bool
+	err     error
+}
+
+// Paginate returns a Paginator over the nodes of the connection at path in
+// the response to query, a dot-separated list of fields below data, such as
+// "collection.products". Pages are fetched forward from the start of the
+// connection, or from vars["after"] if set.
+//
+// query must declare $first and $after variables and pass them to the
+// connection, which must select pageInfo { hasNextPage } and the cursor of
+// each edge. Paginate sets first to DefaultPageSize unless vars specifies it,
+// and after to the cursor of the last edge fetched. vars isn't modified.
+func Paginate[T any](ctx context.Context, c *Client, query string, vars map[string]interface{}, path string) *Paginator[T] {
+	return newPaginator[T](ctx, c, query, vars, path, false)
+}
+
+// PaginateBackward is as Paginate, but fetches pages backward from the end of
+// the connection, or from vars["before"] if set, yielding nodes in reverse
+// order. query must declare $last and $before variables, and select
+// pageInfo { hasPreviousPage }.
+func PaginateBackward[T any](ctx context.Context, c *Client, query string, vars map[string]interface{}, path string) *Paginator[T] {
+	return newPaginator[T](ctx, c, query, vars, path, true)
+}
+
+func newPaginator[T any](ctx context.Context, c *Client, query string, vars map[string]interface{}, path string, backward bool) *Paginator[T] {
+	p := &Paginator[T]{
+		ctx:      ctx,
+		client:   c,
+		query:    query,
+		vars:     make(map[string]interface{}, len(vars)+2),
+		path:     strings.Split(path, "."),
+		backward: backward,
+		hasMore:  true,
+	}
+
+	for k, v := range vars {
+		p.vars[k] = v
+	}
+
+	size, cursor := "first", "after"
+	if backward {
+		size, cursor = "last", "before"
+	}
+
+	if _, ok := p.vars[size]; !ok {
+		p.vars[size] = DefaultPageSize
+	}
+
+	if v, ok := p.vars[cursor].(string); ok {
+		p.cursor = v
+	}
+
+	return p
+}
+
+// Next advances to the next node, fetching the following page if required.
+// It returns false once there are no more nodes, or if an error occurs, which
+// Err then returns.
+func (p *Paginator[T]) Next() bool {
+	if p.err != nil {
+		return false
+	}
+
+	p.i++
+
+	// Fetch pages until one yields a node, since a page may be empty.
+	for p.i >= len(p.edges) {
+		if !p.hasMore {
+			return false
+		}
+
+		if p.err = p.fetch(); p.err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Node returns the current node. It's only valid after a call to Next has
+// returned true.
+func (p *Paginator[T]) Node() T {
+	return p.edge().Node
+}
+
+// Cursor returns the cursor of the current node, from which pagination may
+// later be resumed. It's only valid after a call to Next has returned true.
+func (p *Paginator[T]) Cursor() string {
+	return p.edge().Cursor
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// edge returns the current edge, accounting for the order of iteration.
+func (p *Paginator[T]) edge() Edge[T] {
+	if p.backward {
+		return p.edges[len(p.edges)-1-p.i]
+	}
+
+	return p.edges[p.i]
+}
+
+// fetch fetches the page following the current one, in the direction of
+// iteration.
+func (p *Paginator[T]) fetch() error {
+	if err := p.ctx.Err(); err != nil {
+		return err
+	}
+
+	if p.cursor != "" {
+		if p.backward {
+			p.vars["before"] = p.cursor
+		} else {
+			p.vars["after"] = p.cursor
+		}
+	}
+
+	var res struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := p.client.QueryWithVariablesContext(p.ctx, p.query, p.vars, &res); err != nil {
+		return err
+	}
+
+	data := res.Data
+	for _, field := range p.path {
+		// A null object along the path, such as a collection which doesn't
+		// exist, has no nodes.
+		if bytes.Equal(data, []byte("null")) {
+			break
+		}
+
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidPath, strings.Join(p.path, "."))
+		}
+
+		var ok bool
+		if data, ok = obj[field]; !ok {
+			return fmt.Errorf("%w: %q", ErrInvalidPath, strings.Join(p.path, "."))
+		}
+	}
+
+	if bytes.Equal(data, []byte("null")) {
+		p.edges, p.i, p.hasMore = nil, 0, false
+		return nil
+	}
+
+	var conn Connection[T]
+	if err := json.Unmarshal(data, &conn); err != nil {
+		return err
+	}
+
+	p.edges, p.i = conn.Edges, 0
+
+	if p.backward {
+		p.hasMore = conn.PageInfo.HasPreviousPage
+		p.cursor = conn.FirstCursor()
+	} else {
+		p.hasMore = conn.HasNext()
+		p.cursor = conn.LastCursor()
+	}
+
+	// Guard against looping forever on a connection which claims to have more
+	// pages but yields no cursor from which to fetch them.
+	if p.hasMore && p.cursor == "" {
+		return fmt.Errorf("%w: %q", ErrMissingCursor, strings.Join(p.path, "."))
+	}
+
+	return nil
+}
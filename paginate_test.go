@@ -0,0 +1,182 @@
+package storefront
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newPaginateServer returns a server which pages through the products titled
+// by titles, whose cursors are their indices, along with a pointer to the
+// variables of each request it receives.
+func newPaginateServer(titles []string) (*httptest.Server, *[]map[string]interface{}) {
+	var requests []map[string]interface{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		requests = append(requests, req.Variables)
+
+		start, end := 0, len(titles)
+		if v, ok := req.Variables["after"].(string); ok {
+			fmt.Sscan(v, &start)
+			start++
+		}
+
+		if v, ok := req.Variables["before"].(string); ok {
+			fmt.Sscan(v, &end)
+		}
+
+		if v, ok := req.Variables["first"].(float64); ok && start+int(v) < end {
+			end = start + int(v)
+		}
+
+		if v, ok := req.Variables["last"].(float64); ok && end-int(v) > start {
+			start = end - int(v)
+		}
+
+		var edges []string
+		for i := start; i < end; i++ {
+			edges = append(edges, fmt.Sprintf(`{"cursor": "%d", "node": {"title": %q}}`, i, titles[i]))
+		}
+
+		fmt.Fprintf(w, `{"data": {"collection": {"products": {
+			"edges": [%s],
+			"pageInfo": {"hasNextPage": %t, "hasPreviousPage": %t}
+		}}}}`, strings.Join(edges, ","), end < len(titles), start > 0)
+	}))
+
+	return srv, &requests
+}
+
+func TestPaginate(t *testing.T) {
+	assert := assert.New(t)
+
+	titles := []string{"A", "B", "C", "D", "E"}
+
+	t.Run("Forward", func(t *testing.T) {
+		srv, requests := newPaginateServer(titles)
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+
+		vars := map[string]interface{}{"handle": "frontpage", "first": 2}
+		p := Paginate[Product](context.Background(), c, "query", vars, "collection.products")
+
+		var got, cursors []string
+		for p.Next() {
+			got = append(got, p.Node().Title)
+			cursors = append(cursors, p.Cursor())
+		}
+
+		assert.NoError(p.Err())
+		assert.Equal(titles, got)
+		assert.Equal([]string{"0", "1", "2", "3", "4"}, cursors)
+
+		if assert.Len(*requests, 3) {
+			assert.Nil((*requests)[0]["after"])
+			assert.Equal("1", (*requests)[1]["after"])
+			assert.Equal("3", (*requests)[2]["after"])
+			assert.Equal("frontpage", (*requests)[2]["handle"])
+		}
+
+		// The caller's variables are left untouched.
+		assert.NotContains(vars, "after")
+	})
+
+	t.Run("Backward", func(t *testing.T) {
+		srv, requests := newPaginateServer(titles)
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+
+		p := PaginateBackward[Product](context.Background(), c, "query", nil, "collection.products")
+
+		var got []string
+		for p.Next() {
+			got = append(got, p.Node().Title)
+		}
+
+		assert.NoError(p.Err())
+		assert.Equal([]string{"E", "D", "C", "B", "A"}, got)
+
+		if assert.Len(*requests, 1) {
+			assert.EqualValues(DefaultPageSize, (*requests)[0]["last"])
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"data": {"collection": null}}`)
+		}))
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+
+		p := Paginate[Product](context.Background(), c, "query", nil, "collection.products")
+		assert.False(p.Next())
+		assert.NoError(p.Err())
+	})
+
+	t.Run("InvalidPath", func(t *testing.T) {
+		srv, _ := newPaginateServer(titles)
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+
+		p := Paginate[Product](context.Background(), c, "query", nil, "collection.variants")
+		assert.False(p.Next())
+		assert.ErrorIs(p.Err(), ErrInvalidPath)
+	})
+
+	t.Run("MissingCursor", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"data": {"products": {
+				"edges": [{"node": {"title": "A"}}],
+				"pageInfo": {"hasNextPage": true}
+			}}}`)
+		}))
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+
+		p := Paginate[Product](context.Background(), c, "query", nil, "products")
+		assert.False(p.Next())
+		assert.ErrorIs(p.Err(), ErrMissingCursor)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		srv, requests := newPaginateServer(titles)
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+
+		ctx, cancel := context.WithCancel(context.Background())
+		p := Paginate[Product](ctx, c, "query", map[string]interface{}{"first": 2}, "collection.products")
+
+		assert.True(p.Next())
+		assert.True(p.Next())
+		cancel()
+
+		assert.False(p.Next())
+		assert.ErrorIs(p.Err(), context.Canceled)
+		assert.False(p.Next())
+		assert.Len(*requests, 1)
+	})
+}
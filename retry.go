@@ -0,0 +1,233 @@
+package storefront
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy describes how a Client retries requests that fail transiently:
+// transport errors, 429 and 5xx responses and THROTTLED GraphQL errors.
+//
+// Mutations are not idempotent, so they're never retried unless
+// RetryMutations is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request, including
+	// the first. Values less than 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the base delay before the first retry. Each subsequent
+	// retry doubles it, up to MaxBackoff, with random jitter applied.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between attempts, or zero for no maximum.
+	// It doesn't limit delays specified by the API's Retry-After header.
+	MaxBackoff time.Duration
+	// RetryMutations allows requests containing mutations to be retried, which
+	// may result in them being applied more than once.
+	RetryMutations bool
+}
+
+// DefaultRetryPolicy returns a policy making up to three attempts, backing off
+// from half a second up to ten seconds between them.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+	}
+}
+
+// retryable reports whether a request which failed with err may be attempted
+// again.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= 500
+	}
+
+	if errors.Is(err, ErrThrottled) {
+		return true
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// backoff returns the delay to wait before the given retry (starting from 1)
+// of a request which failed with err.
+func (p *RetryPolicy) backoff(retry int, err error) time.Duration {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		if d, ok := parseRetryAfter(httpErr.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	d := p.MinBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		if d > math.MaxInt64/2 {
+			d = math.MaxInt64
+			break
+		}
+
+		d *= 2
+	}
+
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	// Use "equal jitter", so we always wait at least half the computed delay.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.ParseFloat(v, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+
+		return time.Duration(secs * float64(time.Second)), true
+	}
+
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+
+	if d := time.Until(t); d > 0 {
+		return d, true
+	}
+
+	return 0, true
+}
+
+// sleep waits for d to elapse, returning early with the context's error if ctx
+// is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// isMutation reports whether the operation r executes is a mutation. If the
+// operation can't be determined, it errs on the side of caution and reports
+// whether the document contains any mutation at all.
+func isMutation(r *Request) bool {
+	ops := scanOperations(r.Query)
+
+	if r.OperationName != "" {
+		for _, op := range ops {
+			if op.name == r.OperationName {
+				return op.kind == "mutation"
+			}
+		}
+	}
+
+	for _, op := range ops {
+		if op.kind == "mutation" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// operation is the type and name of an operation defined in a document.
+type operation struct {
+	kind string
+	name string
+}
+
+// scanOperations does just enough lexing of a GraphQL document to list the
+// operations defined at its top level, skipping comments, strings and
+// anything nested in brackets.
+func scanOperations(doc string) []operation {
+	var ops []operation
+	var words []string
+
+	depth := 0
+
+	for i := 0; i < len(doc); {
+		ch := doc[i]
+
+		switch {
+		case ch == '#':
+			for i < len(doc) && doc[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(doc[i:], `"""`):
+			end := strings.Index(doc[i+3:], `"""`)
+			if end < 0 {
+				return ops
+			}
+			i += end + 6
+		case ch == '"':
+			i++
+			for i < len(doc) && doc[i] != '"' && doc[i] != '\n' {
+				if doc[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+		case ch == '{' || ch == '(' || ch == '[':
+			if depth == 0 && ch == '{' {
+				op := operation{kind: "query"}
+				if len(words) != 0 {
+					op.kind = words[0]
+				}
+				if len(words) > 1 {
+					op.name = words[1]
+				}
+				if op.kind != "fragment" {
+					ops = append(ops, op)
+				}
+				words = nil
+			}
+			depth++
+			i++
+		case ch == '}' || ch == ')' || ch == ']':
+			depth--
+			i++
+		case ch == '_' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z':
+			start := i
+			for i < len(doc) && (doc[i] == '_' || doc[i] >= 'a' && doc[i] <= 'z' || doc[i] >= 'A' && doc[i] <= 'Z' || doc[i] >= '0' && doc[i] <= '9') {
+				i++
+			}
+			if depth == 0 {
+				words = append(words, doc[start:i])
+			}
+		default:
+			i++
+		}
+	}
+
+	return ops
+}
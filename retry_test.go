@@ -0,0 +1,189 @@
+package storefront
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newRetryServer returns a server which responds with the given failure for
+// the first failures requests, and successfully thereafter, along with a
+// pointer to the count of requests it has received.
+func newRetryServer(failures int32, fail http.HandlerFunc) (*httptest.Server, *int32) {
+	var n int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&n, 1) <= failures {
+			fail(w, r)
+			return
+		}
+
+		fmt.Fprint(w, `{"data":{"shop":{"name":"Shop name"}}}`)
+	}))
+
+	return srv, &n
+}
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+}
+
+func TestClient_Do_Retry(t *testing.T) {
+	assert := assert.New(t)
+
+	unavailable := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	t.Run("ServerError", func(t *testing.T) {
+		srv, n := newRetryServer(2, unavailable)
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+		c.RetryPolicy = testRetryPolicy()
+
+		var set Set
+		assert.NoError(c.Query("{ shop { name } }", &set))
+		assert.Equal("Shop name", set.Data.Shop.Name)
+		assert.EqualValues(3, atomic.LoadInt32(n))
+	})
+
+	t.Run("MaxAttempts", func(t *testing.T) {
+		srv, n := newRetryServer(5, unavailable)
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+		c.RetryPolicy = testRetryPolicy()
+
+		var set Set
+		assert.Error(c.Query("{ shop { name } }", &set))
+		assert.EqualValues(3, atomic.LoadInt32(n))
+	})
+
+	t.Run("Throttled", func(t *testing.T) {
+		srv, n := newRetryServer(1, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"errors":[{"message":"Throttled","extensions":{"code":"THROTTLED"}}]}`)
+		})
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+		c.RetryPolicy = testRetryPolicy()
+
+		var set Set
+		assert.NoError(c.Query("{ shop { name } }", &set))
+		assert.EqualValues(2, atomic.LoadInt32(n))
+	})
+
+	t.Run("NotRetryable", func(t *testing.T) {
+		srv, n := newRetryServer(1, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+		c.RetryPolicy = testRetryPolicy()
+
+		var set Set
+		assert.ErrorIs(c.Query("{ shop { name } }", &set), ErrUnauthorized)
+		assert.EqualValues(1, atomic.LoadInt32(n))
+	})
+
+	t.Run("Mutation", func(t *testing.T) {
+		srv, n := newRetryServer(2, unavailable)
+		defer srv.Close()
+
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+		c.RetryPolicy = testRetryPolicy()
+
+		var out map[string]interface{}
+		assert.Error(c.Query("mutation { cartCreate { cart { id } } }", &out))
+		assert.EqualValues(1, atomic.LoadInt32(n))
+
+		c.RetryPolicy.RetryMutations = true
+
+		assert.NoError(c.Query("mutation { cartCreate { cart { id } } }", &out))
+		assert.EqualValues(3, atomic.LoadInt32(n))
+	})
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	for i, max := range []time.Duration{100, 200, 300, 300} {
+		retry := i + 1
+
+		d := p.backoff(retry, nil)
+		assert.GreaterOrEqual(d, max*time.Millisecond/2, "retry %d", retry)
+		assert.LessOrEqual(d, max*time.Millisecond, "retry %d", retry)
+	}
+
+	err := &HTTPError{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"2"}},
+	}
+
+	assert.Equal(2*time.Second, p.backoff(1, err))
+
+	// Without a MaxBackoff, the delay keeps doubling, without overflowing.
+	p = &RetryPolicy{MinBackoff: 100 * time.Millisecond}
+
+	for i, max := range []time.Duration{100, 200, 400, 800} {
+		retry := i + 1
+
+		d := p.backoff(retry, nil)
+		assert.GreaterOrEqual(d, max*time.Millisecond/2, "retry %d", retry)
+		assert.LessOrEqual(d, max*time.Millisecond, "retry %d", retry)
+	}
+
+	assert.Greater(p.backoff(100, nil), time.Duration(0))
+}
+
+func TestIsMutation(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		req  Request
+		want bool
+	}{
+		{Request{Query: "{ shop { name } }"}, false},
+		{Request{Query: "query Shop { shop { name } }"}, false},
+		{Request{Query: "mutation { cartCreate { cart { id } } }"}, true},
+		{Request{Query: `
+			# mutation { nope }
+			query Q($q: String = "mutation {") { shop { name } }
+		`}, false},
+		{Request{Query: `
+			fragment F on Cart { id }
+			mutation Create($input: CartInput) { cartCreate(input: $input) { cart { ...F } } }
+		`}, true},
+		{Request{
+			Query:         "query A { shop { name } } mutation B { cartCreate { cart { id } } }",
+			OperationName: "A",
+		}, false},
+		{Request{
+			Query:         "query A { shop { name } } mutation B { cartCreate { cart { id } } }",
+			OperationName: "B",
+		}, true},
+		{Request{Query: "query A { shop { name } } mutation B { cartCreate { cart { id } } }"}, true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(tt.want, isMutation(&tt.req), tt.req.Query)
+	}
+}
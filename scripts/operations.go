@@ -0,0 +1,584 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/boatilus/storefront-go/internal/graphql"
+	"github.com/dave/jennifer/jen"
+	"github.com/iancoleman/strcase"
+)
+
+// storefrontPath is the import path of the storefront package, which the
+// generated operations use.
+const storefrontPath = "github.com/boatilus/storefront-go"
+
+// sourceFile describes one of the files of operations, by the line at which
+// its source begins within the concatenation of all of them.
+type sourceFile struct {
+	name string
+	line int
+}
+
+// operationGen generates code for the operations of a document.
+type operationGen struct {
+	schema *graphql.Schema
+	doc    *graphql.Document
+	out    *jen.File
+}
+
+// selectedField describes a field selected from a composite type, merging all
+// the selections of it with the same response name.
+type selectedField struct {
+	responseName string
+	def          graphql.Field
+	// parent is the name of the type the field is first selected from.
+	parent string
+	// arguments are the arguments passed to the field, which every selection
+	// merged into it must also pass.
+	arguments []graphql.Argument
+	// conditional is whether the field may be absent from the response, as
+	// it's only selected by fragments which may not apply or by selections
+	// with @skip or @include.
+	conditional bool
+	selections  []graphql.Selection
+}
+
+// genOperations generates code for each of the operations in the .graphql
+// files in dir, writing it to outFile as part of package pkg. For each
+// operation, a function executing it is generated along with the types of its
+// variables and of its response, which holds exactly the fields it selects.
+func genOperations(schema *graphql.Schema, dir, outFile, pkg string) error {
+	paths, err := operationFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	doc, anonymous, err := loadOperations(schema, paths)
+	if err != nil {
+		return err
+	}
+
+	// Generating into the storefront package itself, its identifiers needn't
+	// be qualified.
+	out := jen.NewFile(pkg)
+	if pkg == "storefront" {
+		out = jen.NewFilePathName(storefrontPath, pkg)
+	}
+
+	out.ImportName(storefrontPath, "storefront")
+
+	g := &operationGen{schema: schema, doc: doc, out: out}
+
+	for _, op := range doc.Operations {
+		if err := g.genOperation(op, anonymous[op]); err != nil {
+			return err
+		}
+	}
+
+	return out.Save(outFile)
+}
+
+// operationFiles returns the paths of the .graphql files in dir.
+func operationFiles(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.graphql"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no .graphql files in %s", dir)
+	}
+
+	return paths, nil
+}
+
+// loadOperations parses the operations in the files at paths and validates
+// them against schema, logging warnings of any uses of deprecated fields and
+// enum values. Errors are reported by file, line and column.
+//
+// Fragments may be shared between files. An anonymous operation is named
+// after its file, as collections_query.graphql becomes CollectionsQuery; the
+// returned set records which operations were.
+func loadOperations(schema *graphql.Schema, paths []string) (*graphql.Document, map[*graphql.Operation]bool, error) {
+	// The files are parsed as a single document so that fragments may be
+	// shared between them, with positions mapped back to the files.
+	var src strings.Builder
+	var files []sourceFile
+
+	for _, p := range paths {
+		bs, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		files = append(files, sourceFile{name: p, line: strings.Count(src.String(), "\n") + 1})
+		src.Write(bs)
+		src.WriteString("\n")
+	}
+
+	locate := func(pos graphql.Position) string {
+		f := files[0]
+		for _, file := range files {
+			if file.line <= pos.Line {
+				f = file
+			}
+		}
+
+		return fmt.Sprintf("%s:%d:%d", f.name, pos.Line-f.line+1, pos.Column)
+	}
+
+	doc, err := graphql.ParseQuery(src.String())
+	if err != nil {
+		var se *graphql.SyntaxError
+		if errors.As(err, &se) {
+			return nil, nil, fmt.Errorf("%s: %s", locate(se.Pos), se.Msg)
+		}
+
+		return nil, nil, err
+	}
+
+	anonymous := map[*graphql.Operation]bool{}
+	for _, op := range doc.Operations {
+		if op.Name == "" {
+			name, _, _ := strings.Cut(locate(op.Pos), ":")
+			op.Name = strcase.ToCamel(strings.TrimSuffix(filepath.Base(name), filepath.Ext(name)))
+			anonymous[op] = true
+		}
+	}
+
+	warnings, err := graphql.Validate(schema, doc)
+	for _, w := range warnings {
+		log.Printf("Warning: %s: %s", locate(w.Pos), w.Msg)
+	}
+
+	if err != nil {
+		var msgs []string
+		for _, e := range err.(graphql.ValidationErrors) {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", locate(e.Pos), e.Msg))
+		}
+
+		return nil, nil, errors.New(strings.Join(msgs, "\n"))
+	}
+
+	return doc, anonymous, nil
+}
+
+// packageName returns the name of the package of the Go files in dir, or the
+// name of dir if there are none.
+func packageName(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), abs, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.PackageClauseOnly)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	for name := range pkgs {
+		return name, nil
+	}
+
+	return filepath.Base(abs), nil
+}
+
+// genOperation generates the function executing op, along with the types of
+// its variables and response.
+func (g *operationGen) genOperation(op *graphql.Operation, anonymous bool) error {
+	name := replaceAcronyms(strcase.ToCamel(op.Name))
+	document := strcase.ToLowerCamel(name) + "Document"
+
+	var root *graphql.TypeName
+	switch op.Type {
+	case "query":
+		root = g.schema.QueryType
+	case "mutation":
+		root = g.schema.MutationType
+	case "subscription":
+		root = g.schema.SubscriptionType
+	}
+
+	g.out.Commentf("%s is the document sent to execute the %s %s.", document, name, op.Type)
+	g.out.Const().Id(document).Op("=").Add(rawString(g.document(op))).Line()
+
+	params := []jen.Code{
+		jen.Id("ctx").Qual("context", "Context"),
+		jen.Id("client").Op("*").Add(g.qual("Client")),
+	}
+
+	request := jen.Dict{jen.Id("Query"): jen.Id(document)}
+	if !anonymous {
+		request[jen.Id("OperationName")] = jen.Lit(op.Name)
+	}
+
+	var body []jen.Code
+
+	if len(op.VariableDefinitions) != 0 {
+		g.genVariables(name+"Variables", op)
+
+		params = append(params, jen.Id("vars").Id(name+"Variables"))
+		request[jen.Id("Variables")] = jen.Id("variables")
+
+		// Nullable variables are only sent if they're set, so that the
+		// server applies any defaults.
+		values := jen.Dict{}
+		var optional []jen.Code
+
+		for _, v := range op.VariableDefinitions {
+			field := jen.Id("vars").Dot(variableField(v))
+
+			if v.Type.Kind == "NON_NULL" {
+				values[jen.Lit(v.Name)] = field
+				continue
+			}
+
+			optional = append(optional, jen.If(field.Clone().Op("!=").Nil()).Block(
+				jen.Id("variables").Index(jen.Lit(v.Name)).Op("=").Add(field),
+			))
+		}
+
+		body = append(body, jen.Id("variables").Op(":=").Map(jen.String()).Interface().Values(values))
+		body = append(body, optional...)
+		body = append(body, jen.Line())
+	}
+
+	if err := g.genSelections(name+"Response", g.schema.Type(root.Name), op.SelectionSet, fmt.Sprintf("%s is the data returned by the %s %s.", name+"Response", name, op.Type)); err != nil {
+		return fmt.Errorf("operation %s: %w", op.Name, err)
+	}
+
+	body = append(body,
+		jen.Var().Id("res").Struct(
+			jen.Id("Data").Op("*").Id(name+"Response").Tag(map[string]string{"json": "data"}),
+		),
+		jen.Line(),
+		jen.Err().Op(":=").Id("client").Dot("DoContext").Call(
+			jen.Id("ctx"),
+			jen.Op("&").Add(g.qual("Request")).Values(request),
+			jen.Op("&").Id("res"),
+		),
+		jen.Return(jen.Id("res").Dot("Data"), jen.Err()),
+	)
+
+	g.out.Commentf("%s executes the %s %s.", name, name, op.Type)
+	g.out.Comment("")
+	g.out.Comment("As with Client.Do, any errors in the response are returned as")
+	g.out.Comment("GraphQLErrors, along with any partial data.")
+	g.out.Func().Id(name).Params(params...).Params(jen.Op("*").Id(name+"Response"), jen.Error()).Block(body...).Line()
+
+	return nil
+}
+
+// document returns the document sent to execute op: its source, followed by
+// that of each fragment it uses, directly or indirectly.
+func (g *operationGen) document(op *graphql.Operation) string {
+	used := map[string]bool{}
+
+	var visit func(sels []graphql.Selection)
+	visit = func(sels []graphql.Selection) {
+		for _, sel := range sels {
+			switch sel := sel.(type) {
+			case *graphql.FieldSelection:
+				visit(sel.SelectionSet)
+			case *graphql.InlineFragment:
+				visit(sel.SelectionSet)
+			case *graphql.FragmentSpread:
+				if !used[sel.Name] {
+					used[sel.Name] = true
+					visit(g.doc.Fragment(sel.Name).SelectionSet)
+				}
+			}
+		}
+	}
+
+	visit(op.SelectionSet)
+
+	sources := []string{op.Source}
+	for _, f := range g.doc.Fragments {
+		if used[f.Name] {
+			sources = append(sources, f.Source)
+		}
+	}
+
+	return strings.Join(sources, "\n\n")
+}
+
+// rawString returns the code for a string literal of s, as a raw string
+// literal if it can be, for readability.
+func rawString(s string) jen.Code {
+	if strings.Contains(s, "`") {
+		return jen.Lit(s)
+	}
+
+	return jen.Op("`" + s + "`")
+}
+
+// variableField returns the name of the field of the variables struct holding
+// the variable v.
+func variableField(v *graphql.VariableDefinition) string {
+	return replaceAcronyms(strcase.ToCamel(v.Name))
+}
+
+// genVariables generates the struct typeName holding the values of the
+// variables of op. Nullable variables are pointers, unless already nilable,
+// and aren't sent if nil.
+func (g *operationGen) genVariables(typeName string, op *graphql.Operation) {
+	var props []jen.Code
+
+	for _, v := range op.VariableDefinitions {
+		typ := g.inputTypeCode(v.Type)
+		tag := v.Name
+
+		if v.Type.Kind != "NON_NULL" {
+			if v.Type.Kind != "LIST" && v.Type.Name != "JSON" {
+				typ = jen.Op("*").Add(typ)
+			}
+
+			tag += ",omitempty"
+		}
+
+		props = append(props, jen.Id(variableField(v)).Add(typ).Tag(map[string]string{"json": tag}))
+	}
+
+	g.out.Commentf("%s holds the values of the variables of %s.", typeName, strings.TrimSuffix(typeName, "Variables"))
+	g.out.Type().Id(typeName).Struct(props...).Line()
+}
+
+// genSelections generates the struct typeName holding the fields sels select
+// from the composite type t, along with the structs of any composite fields.
+func (g *operationGen) genSelections(typeName string, t *graphql.Type, sels []graphql.Selection, comment string) error {
+	var fields []*selectedField
+	if err := g.collect(t, sels, false, &fields, map[string]*selectedField{}); err != nil {
+		return err
+	}
+
+	var props []jen.Code
+
+	type child struct {
+		typeName   string
+		t          *graphql.Type
+		selections []graphql.Selection
+		comment    string
+	}
+
+	var children []child
+
+	for _, f := range fields {
+		name := replaceAcronyms(strcase.ToCamel(f.responseName))
+		if f.responseName == "__typename" {
+			name = "Typename"
+		}
+
+		// A conditional field is nullable regardless of its type, as it may
+		// be absent.
+		ref := f.def.Type
+		if f.conditional && ref.Kind == "NON_NULL" {
+			ref = *ref.OfType
+		}
+
+		named := f.def.Type.Named()
+
+		var kind string
+		if nt := g.schema.Type(named.Name); nt != nil {
+			kind = nt.Kind
+		}
+
+		var typ jen.Code
+		switch kind {
+		case "OBJECT", "INTERFACE", "UNION":
+			childName := typeName + name
+			children = append(children, child{
+				typeName:   childName,
+				t:          g.schema.Type(named.Name),
+				selections: f.selections,
+				comment:    fmt.Sprintf("%s is the selection of %s from %s.", childName, f.responseName, typeName),
+			})
+
+			typ = wrapType(ref, jen.Id(childName), false)
+		default:
+			typ = wrapType(ref, g.scalarCode(named.Name), named.Name == "JSON")
+		}
+
+		if f.def.Description != "" {
+			props = append(props, jen.Comment(transformFieldComment(name, f.def.Description)))
+		}
+
+		props = append(props, jen.Id(name).Add(typ).Tag(map[string]string{"json": f.responseName}))
+	}
+
+	g.out.Comment(comment)
+	g.out.Type().Id(typeName).Struct(props...).Line()
+
+	for _, c := range children {
+		if err := g.genSelections(c.typeName, c.t, c.selections, c.comment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collect appends the fields sels select from the composite type t to fields,
+// flattening fragments into them. Fields selected more than once are merged,
+// by way of index, which maps response names to fields. Selections which
+// can't be merged, as they're of different fields or pass different
+// arguments, are an error, even where the validator allows them because they
+// apply to different types: their values couldn't share a struct field.
+func (g *operationGen) collect(t *graphql.Type, sels []graphql.Selection, conditional bool, fields *[]*selectedField, index map[string]*selectedField) error {
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *graphql.FieldSelection:
+			var def graphql.Field
+			if sel.Name == "__typename" {
+				def = graphql.Field{Name: sel.Name, Type: graphql.TypeRef{Kind: "NON_NULL", OfType: &graphql.TypeRef{Kind: "SCALAR", Name: "String"}}}
+			} else {
+				def = *g.schema.FieldDef(t, sel.Name)
+			}
+
+			f, ok := index[sel.ResponseName()]
+			switch {
+			case !ok:
+				f = &selectedField{responseName: sel.ResponseName(), def: def, parent: t.Name, arguments: sel.Arguments, conditional: true}
+
+				index[f.responseName] = f
+				*fields = append(*fields, f)
+			case f.def.Name != def.Name || f.def.Type.String() != def.Type.String():
+				return fmt.Errorf("%q selects both %s.%s and %s.%s, which can't share a field; use different aliases", f.responseName, f.parent, f.def.Name, t.Name, def.Name)
+			case !graphql.SameArguments(f.arguments, sel.Arguments):
+				return fmt.Errorf("%q selects %s with different arguments; use different aliases", f.responseName, def.Name)
+			}
+
+			f.conditional = f.conditional && (conditional || isConditional(sel.Directives))
+			f.selections = append(f.selections, sel.SelectionSet...)
+		case *graphql.FragmentSpread:
+			frag := g.doc.Fragment(sel.Name)
+			cond := g.schema.Type(frag.TypeCondition)
+			if err := g.collect(cond, frag.SelectionSet, conditional || isConditional(sel.Directives) || !covers(cond, t), fields, index); err != nil {
+				return err
+			}
+		case *graphql.InlineFragment:
+			cond := t
+			if sel.TypeCondition != "" {
+				cond = g.schema.Type(sel.TypeCondition)
+			}
+
+			if err := g.collect(cond, sel.SelectionSet, conditional || isConditional(sel.Directives) || !covers(cond, t), fields, index); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isConditional reports whether the directives ds may cause a selection to be
+// skipped.
+func isConditional(ds []graphql.AppliedDirective) bool {
+	for _, d := range ds {
+		if d.Name == "skip" || d.Name == "include" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// covers reports whether every object of the composite type t is also of the
+// composite type cond, such that a fragment on cond always applies to t.
+func covers(cond, t *graphql.Type) bool {
+	members := map[string]bool{}
+	for _, name := range objectTypes(cond) {
+		members[name] = true
+	}
+
+	for _, name := range objectTypes(t) {
+		if !members[name] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// objectTypes returns the names of the object types an object of the
+// composite type t may be.
+func objectTypes(t *graphql.Type) []string {
+	if t.Kind == "OBJECT" {
+		return []string{t.Name}
+	}
+
+	names := make([]string, len(t.PossibleTypes))
+	for i, pt := range t.PossibleTypes {
+		names[i] = pt.Name
+	}
+
+	return names
+}
+
+// wrapType returns the code for a reference to a type, given the code for the
+// named type it refers to: nullable values are pointers, unless nilable, and
+// lists are slices.
+func wrapType(t graphql.TypeRef, named jen.Code, nilable bool) *jen.Statement {
+	nonNull := t.Kind == "NON_NULL"
+	if nonNull {
+		t = *t.OfType
+	}
+
+	if t.Kind == "LIST" {
+		return jen.Index().Add(wrapType(*t.OfType, named, nilable))
+	}
+
+	if nonNull || nilable {
+		return jen.Add(named)
+	}
+
+	return jen.Op("*").Add(named)
+}
+
+// inputTypeCode returns the code for a reference to an input type, ignoring
+// nullability, as for the fields of input objects.
+func (g *operationGen) inputTypeCode(t graphql.TypeRef) *jen.Statement {
+	switch t.Kind {
+	case "NON_NULL":
+		return g.inputTypeCode(*t.OfType)
+	case "LIST":
+		return jen.Index().Add(g.inputTypeCode(*t.OfType))
+	}
+
+	return g.scalarCode(t.Name)
+}
+
+// scalarCode returns the code for the Go type of the scalar, enum or input
+// object type named name.
+func (g *operationGen) scalarCode(name string) *jen.Statement {
+	switch typ := typeMap[name]; {
+	case strings.HasPrefix(name, "__"):
+		// The enums of the introspection system aren't generated.
+		return jen.String()
+	case typ == "time.Time":
+		return jen.Qual("time", "Time")
+	case typ == "Decimal":
+		return g.qual("Decimal")
+	case strings.HasPrefix(typ, "map["):
+		return jen.Map(jen.String()).Interface()
+	case typ != "":
+		return jen.Id(typ)
+	}
+
+	return g.qual(replaceAcronyms(name))
+}
+
+// qual returns the code for the identifier name of the storefront package.
+func (g *operationGen) qual(name string) *jen.Statement {
+	return jen.Qual(storefrontPath, name)
+}
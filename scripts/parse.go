@@ -1,15 +1,21 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"os"
 	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/boatilus/storefront-go"
+	"github.com/boatilus/storefront-go/internal/graphql"
 	"github.com/dave/jennifer/jen"
 	"github.com/iancoleman/strcase"
 	"golang.org/x/exp/slices"
@@ -18,61 +24,8 @@ import (
 // outfile is the filename to write out the generated types.
 const outfile = "types.go"
 
-// body describes the schema's JSON structure.
-type body struct {
-	Schema struct {
-		QueryType struct {
-			Name string `json:"name"`
-		} `json:"queryType"`
-		MutationType struct {
-			Name string `json:"name"`
-		} `json:"mutationType"`
-		SubscriptionType interface{} `json:"subscriptionType"`
-		Types            []gqlType   `json:"types"`
-	} `json:"__schema"`
-}
-
-// enumValue describes a GraphQL enum value.
-type enumValue struct {
-	Name              string      `json:"name"`
-	Description       string      `json:"description"`
-	IsDeprecated      bool        `json:"isDeprecated"`
-	DeprecationReason interface{} `json:"deprecationReason"`
-}
-
-// gqlType describes a GraphQL type.
-type gqlType struct {
-	Kind        string `json:"kind"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Fields      []struct {
-		Name        string        `json:"name"`
-		Description string        `json:"description"`
-		Args        []interface{} `json:"args"`
-		Type        struct {
-			Kind string `json:"kind"`
-			Name string `json:"name"`
-			// We're going to nest a bunch of discrete OfTypes to avoid the
-			// possibility of nil dereferences. In practice, these can go three
-			// levels deep 🙃
-			OfType struct {
-				Kind   string `json:"kind"`
-				Name   string `json:"name"`
-				OfType struct {
-					Kind   string `json:"kind"`
-					Name   string `json:"name"`
-					OfType struct {
-						Kind string `json:"kind"`
-						Name string `json:"name"`
-					}
-				} `json:"ofType,omitempty"`
-			} `json:"ofType"`
-		} `json:"type"`
-		IsDeprecated      bool        `json:"isDeprecated"`
-		DeprecationReason interface{} `json:"deprecationReason"`
-	} `json:"fields"`
-	EnumValues []enumValue `json:"enumValues"`
-}
+// enumsOutfile is the filename to write out the generated large enums.
+const enumsOutfile = "enums.go"
 
 // basicType describes the essential information required to construct a Go type
 // from the JSON type descriptor.
@@ -84,22 +37,45 @@ type basicType struct {
 	GoType       string
 }
 
-// A list of enums to skip during generation, since it gets mildly excessive.
-var enumSkip = []string{
+// kinds maps the names of types to their kinds, so we can tell which fields
+// are of abstract types.
+var kinds = map[string]string{}
+
+// abstractField describes a field of a union or interface type, or a list of
+// them, which must be decoded according to the __typename of its value.
+type abstractField struct {
+	PropertyName string
+	Name         string
+	IsList       bool
+}
+
+// A list of enums to generate into enumsOutfile rather than outfile, since
+// including them gets mildly excessive.
+var largeEnums = []string{
 	"CountryCode", "CurrencyCode", "WeightUnit",
 	"UnitPriceMeasurementMeasuredType", "UnitPriceMeasurementMeasuredUnit",
 }
 
+// currencyMinorUnits maps the ISO 4217 codes of currencies whose minor unit
+// isn't a hundredth to the exponent of their minor unit, which the schema
+// doesn't provide. All other currencies have an exponent of 2.
+var currencyMinorUnits = map[string]int{
+	"BHD": 3, "BIF": 0, "BYR": 0, "CLF": 4, "CLP": 0, "DJF": 0, "GNF": 0,
+	"IQD": 3, "ISK": 0, "JOD": 3, "JPY": 0, "KMF": 0, "KRW": 0, "KWD": 3,
+	"LYD": 3, "OMR": 3, "PYG": 0, "RWF": 0, "TND": 3, "UGX": 0, "UYI": 0,
+	"VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0, "XXX": 0,
+}
+
 // typeMap maps GraphQL/Storefront types to Go's types.
 var typeMap = map[string]string{
 	"Boolean":  "bool",
-	"Decimal":  "float64",
+	"Decimal":  "Decimal",
 	"Float":    "float64",
 	"ID":       "string",
 	"HTML":     "string",
 	"Int":      "int",
 	"JSON":     "map[string]interface{}",
-	"Money":    "string",
+	"Money":    "Decimal",
 	"String":   "string",
 	"DateTime": "time.Time",
 	"URL":      "string",
@@ -122,25 +98,41 @@ var acronymMap = map[string]string{
 }
 
 func main() {
+	version := flag.String("version", "", "API version of the schema; defaults to the name of the schema's directory, or SchemaVersion when introspecting")
+	domain := flag.String("domain", "", "domain of a store to introspect the schema from, writing it to schema/<version>")
+	token := flag.String("token", "", "Storefront API access token to introspect the schema with")
+	operations := flag.String("operations", "", "directory of .graphql operations to generate functions and types for, rather than generating the schema's types")
+	outFile := flag.String("o", "", "file to write the generated operations to; defaults to operations.go in the operations directory")
+	check := flag.String("check", "", "a .graphql file, or directory of them, to validate against the schema, rather than generating types")
+	pkg := flag.String("package", "", "package of the generated operations; defaults to that of the Go files in the output file's directory, or its name")
 	flag.Parse()
 
 	inFiles := flag.Args()
 	var inFile string
 
-	if len(inFiles) == 0 {
+	switch {
+	case *domain != "":
+		if *version == "" {
+			*version = storefront.SchemaVersion
+		}
+
+		inFile = path.Join("schema", *version, "schema.json")
+
+		if err := introspect(*domain, *token, *version, path.Dir(inFile)); err != nil {
+			log.Fatal(err)
+		}
+	case len(inFiles) == 0:
 		inFile = path.Join("schema", "2022-01", "schema.json")
-	} else {
+	default:
 		inFile = inFiles[0]
 	}
 
-	for k, v := range acronymMap {
-		strcase.ConfigureAcronym(k, v)
+	if *version == "" {
+		*version = path.Base(path.Dir(inFile))
 	}
 
-	for _, enum := range enumSkip {
-		// We're going to skip generating these enums, so we want our generated
-		// types for these enums to be string.
-		typeMap[enum] = "string"
+	for k, v := range acronymMap {
+		strcase.ConfigureAcronym(k, v)
 	}
 
 	bs, err := ioutil.ReadFile(inFile)
@@ -148,84 +140,106 @@ func main() {
 		log.Fatal(err)
 	}
 
-	var b body
-	if err := json.Unmarshal(bs, &b); err != nil {
+	// The schema may be given either as an introspection result or in SDL.
+	var schema *graphql.Schema
+	switch path.Ext(inFile) {
+	case ".graphqls", ".graphql":
+		schema, err = graphql.ParseSDL(string(bs))
+	default:
+		schema, err = graphql.ParseIntrospection(bs)
+	}
+
+	if err != nil {
 		log.Fatal(err)
 	}
 
+	for _, t := range schema.Types {
+		kinds[t.Name] = t.Kind
+	}
+
+	if *check != "" {
+		paths := []string{*check}
+		if fi, err := os.Stat(*check); err == nil && fi.IsDir() {
+			if paths, err = operationFiles(*check); err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		doc, _, err := loadOperations(schema, paths)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("%s is valid (%d operations, %d fragments)", *check, len(doc.Operations), len(doc.Fragments))
+		return
+	}
+
+	if *operations != "" {
+		if *outFile == "" {
+			*outFile = path.Join(*operations, "operations.go")
+		}
+
+		if *pkg == "" {
+			name, err := packageName(filepath.Dir(*outFile))
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			*pkg = name
+		}
+
+		if err := genOperations(schema, *operations, *outFile, *pkg); err != nil {
+			log.Fatal(err)
+		}
+
+		return
+	}
+
+	breaks := cycleBreaks(schema.Types)
+
+	// registrations collects the calls registering the decoders of abstract
+	// types, made in the init function generated at the end of the file.
+	var registrations []jen.Code
+
+	// getters records the getters generated for the fields of interfaces, as
+	// types implementing more than one may share them.
+	getters := map[string]bool{}
+
 	out := jen.NewFile("storefront")
+	enumsOut := jen.NewFile("storefront")
+	selectionsOut := jen.NewFile("storefront")
+
+	out.Comment("SchemaVersion is the Storefront API version of the schema from which the")
+	out.Comment("types in this package were generated.")
+	out.Const().Id("SchemaVersion").Op("=").Lit(*version).Line()
 
-	for _, t := range b.Schema.Types {
+	genSchemaSDL(out, path.Join(path.Dir(inFile), "schema.graphqls"))
+
+	for _, t := range schema.Types {
 		// Types that begin with __ are internal, I think. Omit them.
 		if strings.HasPrefix(t.Name, "__") {
 			continue
 		}
 
 		switch t.Kind {
-		case "OBJECT", "INTERFACE":
-			// Generic *Connection and *Edge types are pre-implemented, as their
-			// inclusion during generation would lead to illegal cycles.
-			if strings.HasSuffix(t.Name, "Connection") || strings.HasSuffix(t.Name, "Edge") {
+		case "OBJECT":
+			// Generic *Connection and *Edge types are pre-implemented.
+			if isPreImplemented(t.Name) {
 				continue
 			}
 
 			var fields []basicType
+			var abstractFields []abstractField
 
 			for _, f := range t.Fields {
 				// If we're to leverage the built-in Go type, look it up from the map.
 				// If the typename ends in "Connection" or "Edge", we'll specify the
 				// pre-defined generic type.
 				//
-				// To break cyclic references, we implement special-case handling for
-				// ProductVariant.Product and for Blog.
-				//
 				// Failing that, use the existing type name.
-				typ := f.Type.Name
-				if typ == "" {
-					typ = f.Type.OfType.Name
-				}
-
-				if val, ok := typeMap[typ]; ok {
-					typ = val
-				}
-
-				// If the type is a list, traverse leaves further down and determine
-				// the element type.
-				if f.Type.Kind == "LIST" || f.Type.OfType.Kind == "LIST" {
-					elementTypeName := f.Type.OfType.OfType.Name
-					if elementTypeName == "" {
-						elementTypeName = f.Type.OfType.OfType.OfType.Name // really?
-					}
-
-					if val, ok := typeMap[elementTypeName]; ok {
-						typ = "[]" + val
-					} else {
-						typ = "[]" + elementTypeName
-					}
-				}
-
-				// For simplicity, just treat unions as strings.
-				if f.Type.Kind == "UNION" || f.Type.OfType.Kind == "UNION" {
-					typ = "string"
-				}
-
-				// Special cases:
-				if (t.Name == "ProductVariant" && f.Name == "product") || (t.Name == "Article" && f.Name == "blog") {
-					log.Printf("Note: %s.%s type set to interface{}", t.Name, f.Name)
-					typ = "interface{}"
-				}
-
-				name := strcase.ToCamel(f.Name)
-
-				// Replace any instances in acronymMap with the correctly-cased variants.
-				for k, v := range acronymMap {
-					if strings.Contains(typ, k) {
-						typ = strings.Replace(typ, k, v, -1)
-					}
-
-					if strings.Contains(name, k) {
-						name = strings.Replace(name, k, v, -1)
-					}
+				name, typ := objectField(t, f, breaks)
+				if breaks[t.Name+"."+f.Name] {
+					log.Printf("Note: %s.%s type set to %s to break a cycle", t.Name, f.Name, typ)
 				}
 
 				comment := fmt.Sprintf("%s: %s", name, f.Description)
@@ -240,33 +254,26 @@ func main() {
 					Comment:      comment,
 					GoType:       typ,
 				})
+
+				if k := kinds[f.Type.Named().Name]; k == "UNION" || k == "INTERFACE" {
+					abstractFields = append(abstractFields, abstractField{
+						PropertyName: name,
+						Name:         f.Name,
+						IsList:       strings.HasPrefix(typ, "[]"),
+					})
+				}
 			}
 
 			var props []jen.Code
 
 			for _, f := range fields {
 				name := jen.Id(f.PropertyName)
-				tag := jen.Tag(map[string]string{"json": fmt.Sprintf("%s,omitempty", f.Name)})
-
-				qual := jen.Id(f.GoType)
-				if f.GoType == "time.Time" {
-					qual = jen.Qual("time", "Time")
-				}
-				if strings.HasSuffix(f.GoType, "Connection") {
-					// For *Connection properties, we're going to strip the "Connection"
-					// and specify the generic type.
-					before, _, _ := strings.Cut(f.GoType, "Connection")
-					if before == "String" {
-						before = "string"
-					}
-
-					qual = jen.Id("Connection").Types(jen.Id(before))
-				}
+				tag := jen.Tag(map[string]string{"json": f.Name})
 
 				props = append(
 					props,
 					jen.Comment(transformFieldComment(f.PropertyName, f.Description)),
-					jen.Add(name, qual, tag),
+					jen.Add(name, typeCode(f.GoType), tag),
 				)
 			}
 
@@ -274,29 +281,574 @@ func main() {
 
 			out.Commentf("%s: %s", typeName, t.Description)
 			out.Type().Id(typeName).Struct(props...).Line()
-		case "ENUM":
-			if slices.Contains(enumSkip, t.Name) {
-				continue
+
+			if len(abstractFields) != 0 {
+				genUnmarshalJSON(out, typeName, abstractFields)
+			}
+		case "UNION", "INTERFACE":
+			// Both unions and interfaces are abstract types, whose values are of one
+			// of a set of object types. We generate them as interfaces implemented
+			// by those types, so values can be type-switched on.
+			typeName := replaceAcronyms(t.Name)
+			marker := "is" + typeName
+
+			members := "its member types"
+			if t.Kind == "INTERFACE" {
+				members = "the types implementing it"
+			}
+
+			// The fields of interfaces, which all the types implementing them share,
+			// are read through getters, so they're available without a type switch.
+			methods := []jen.Code{jen.Id(marker).Params()}
+			for _, f := range t.Fields {
+				name, typ := objectField(t, f, breaks)
+				methods = append(methods,
+					jen.Commentf("Get%s returns the %s field shared by %s.", name, f.Name, members),
+					jen.Id("Get"+name).Params().Add(typeCode(typ)),
+				)
 			}
 
-			out.Commentf("%s: %s", t.Name, t.Description)
-			out.Add(jen.Type().Id(t.Name).String())
+			out.Commentf("%s: %s", typeName, t.Description)
+			out.Comment("")
+			out.Commentf("It's implemented by pointers to %s: %s.", members, memberList(t.PossibleTypes))
+			out.Type().Id(typeName).Interface(methods...).Line()
+
+			for _, m := range t.PossibleTypes {
+				out.Func().Params(jen.Op("*").Id(replaceAcronyms(m.Name))).Id(marker).Params().Block().Line()
+
+				if t.Kind == "INTERFACE" {
+					genGetters(out, &t, schema.Type(m.Name), breaks, getters)
+				}
+			}
+
+			genUnmarshalAbstract(out, typeName, t.PossibleTypes)
+
+			registrations = append(registrations, jen.Id("registerAbstract").Call(jen.Id("unmarshal"+typeName)))
+		case "INPUT_OBJECT":
 			var props []jen.Code
+			var optional bool
+
+			for _, f := range t.InputFields {
+				// Non-null input fields are required, so they're always sent. Nullable
+				// fields are optional; we make them Optionals, so that an omitted
+				// field is distinguishable from both null and a zero value.
+				name := replaceAcronyms(strcase.ToCamel(f.Name))
+				typ := typeCode(replaceAcronyms(goType(f.Type)))
+
+				if f.Type.Kind != "NON_NULL" {
+					typ = jen.Id("Optional").Types(typ)
+					optional = true
+				}
 
-			for _, e := range t.EnumValues {
-				name := t.Name + strcase.ToCamel(strings.ToLower(e.Name))
+				props = append(
+					props,
+					jen.Comment(transformFieldComment(name, f.Description)),
+					jen.Add(jen.Id(name), typ, jen.Tag(map[string]string{"json": f.Name})),
+				)
+			}
+
+			typeName := replaceAcronyms(t.Name)
+
+			out.Commentf("%s: %s", typeName, t.Description)
+			out.Type().Id(typeName).Struct(props...).Line()
 
-				name = replaceAcronyms(name)
-				props = append(props, jen.Id(name).Qual("", t.Name).Op("=").Lit(e.Name))
+			if optional {
+				out.Comment("MarshalJSON implements json.Marshaler, omitting fields which are unset.")
+				out.Func().Params(jen.Id("t").Id(typeName)).Id("MarshalJSON").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+					jen.Return(jen.Id("marshalInput").Call(jen.Id("t"))),
+				).Line()
+			}
+		case "ENUM":
+			f := out
+			if slices.Contains(largeEnums, t.Name) {
+				f = enumsOut
 			}
 
-			out.Const().Defs(props...)
+			genEnum(f, t)
+			genEnumMethods(f, t)
+
+			if t.Name == "CurrencyCode" {
+				genMinorUnits(f, t)
+			}
 		}
 	}
 
+	if len(registrations) != 0 {
+		out.Func().Id("init").Params().Block(registrations...)
+	}
+
 	if err := out.Save(outfile); err != nil {
 		log.Fatal(err)
 	}
+
+	if err := enumsOut.Save(enumsOutfile); err != nil {
+		log.Fatal(err)
+	}
+
+	genSelectionTypes(selectionsOut, schema)
+
+	if err := selectionsOut.Save(selectionsOutfile); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// genSchemaSDL generates the schemaSDL variable, embedding the schema in SDL
+// from sdlFile so that Validate can check queries against it. The file must
+// be within the module, so if it's elsewhere or missing, schemaSDL is left
+// empty.
+func genSchemaSDL(out *jen.File, sdlFile string) {
+	out.Comment("schemaSDL is the schema from which the types in this package were")
+	out.Comment("generated, in SDL, against which Validate checks queries.")
+
+	if _, err := os.Stat(sdlFile); err != nil || path.IsAbs(sdlFile) || strings.HasPrefix(path.Clean(sdlFile), "..") {
+		log.Printf("Note: %s can't be embedded, so Validate will return ErrNoSchema", sdlFile)
+		out.Var().Id("schemaSDL").String().Line()
+		return
+	}
+
+	out.Anon("embed")
+	out.Comment("//go:embed " + path.Clean(sdlFile))
+	out.Var().Id("schemaSDL").String().Line()
+}
+
+// enumConst returns the name of the constant for the enum value v of type t.
+func enumConst(t graphql.Type, v graphql.EnumValue) string {
+	return replaceAcronyms(t.Name + strcase.ToCamel(strings.ToLower(v.Name)))
+}
+
+// introspect fetches the schema of the given API version from the store at
+// domain, writing it to dir as both schema.json, the introspection result, and
+// schema.graphqls, in SDL.
+func introspect(domain, token, version, dir string) error {
+	c, err := storefront.New(domain, token, storefront.WithAPIVersion(version), storefront.WithTimeout(time.Minute))
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := c.Query(graphql.IntrospectionQuery, &res); err != nil {
+		return err
+	}
+
+	schema, err := graphql.ParseIntrospection(res.Data)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	var js bytes.Buffer
+	if err := json.Indent(&js, res.Data, "", "  "); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(path.Join(dir, "schema.json"), js.Bytes(), 0644); err != nil {
+		return err
+	}
+
+	var sdl bytes.Buffer
+	if err := graphql.PrintSDL(&sdl, schema); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path.Join(dir, "schema.graphqls"), sdl.Bytes(), 0644)
+}
+
+// unknownConst returns the name of the Unknown sentinel of the enum t.
+func unknownConst(t graphql.Type) string {
+	return replaceAcronyms(t.Name) + "Unknown"
+}
+
+// genEnum generates a string type for the enum t, and constants for each of
+// its values, along with its Unknown sentinel.
+func genEnum(out *jen.File, t graphql.Type) {
+	out.Commentf("%s: %s", t.Name, t.Description)
+	out.Add(jen.Type().Id(t.Name).String())
+
+	unknown := unknownConst(t)
+	props := []jen.Code{
+		jen.Commentf("%s is the zero value of %s. Values unknown to", unknown, t.Name),
+		jen.Comment("this package are decoded as it, unless StrictEnums is set."),
+		jen.Id(unknown).Qual("", t.Name).Op("=").Lit(""),
+	}
+
+	for _, e := range t.EnumValues {
+		name := enumConst(t, e)
+		if name == unknown {
+			log.Fatalf("enum value %s.%s collides with the Unknown sentinel", t.Name, e.Name)
+		}
+
+		props = append(props, jen.Id(name).Qual("", t.Name).Op("=").Lit(e.Name))
+	}
+
+	out.Const().Defs(props...)
+}
+
+// genEnumMethods generates Values, String, IsValid, MarshalJSON and
+// UnmarshalJSON methods for the enum t.
+func genEnumMethods(out *jen.File, t graphql.Type) {
+	var values []jen.Code
+	for _, e := range t.EnumValues {
+		values = append(values, jen.Id(enumConst(t, e)))
+	}
+
+	out.Commentf("Values returns all the values of %s, in schema order.", t.Name)
+	out.Func().Params(jen.Id(t.Name)).Id("Values").Params().Index().Id(t.Name).Block(
+		jen.Return(jen.Index().Id(t.Name).ValuesFunc(func(g *jen.Group) {
+			for _, v := range values {
+				g.Line().Add(v)
+			}
+			g.Line()
+		})),
+	).Line()
+
+	out.Comment("String implements fmt.Stringer.")
+	out.Func().Params(jen.Id("e").Id(t.Name)).Id("String").Params().String().Block(
+		jen.Return(jen.String().Parens(jen.Id("e"))),
+	).Line()
+
+	out.Commentf("IsValid reports whether e is one of the values of %s.", t.Name)
+	out.Func().Params(jen.Id("e").Id(t.Name)).Id("IsValid").Params().Bool().Block(
+		jen.Switch(jen.Id("e")).Block(
+			jen.Case(values...).Block(jen.Return(jen.True())),
+		),
+		jen.Line(),
+		jen.Return(jen.False()),
+	).Line()
+
+	out.Comment("MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as")
+	out.Comment("null; invalid values are rejected when sent as variables.")
+	out.Func().Params(jen.Id("e").Id(t.Name)).Id("MarshalJSON").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.Return(jen.Id("marshalEnum").Call(jen.Id("e"))),
+	).Line()
+
+	out.Comment("UnmarshalJSON implements json.Unmarshaler, decoding unknown values as")
+	out.Commentf("%s, or returning an error if StrictEnums is set.", unknownConst(t))
+	out.Func().Params(jen.Id("e").Op("*").Id(t.Name)).Id("UnmarshalJSON").Params(jen.Id("data").Index().Byte()).Error().Block(
+		jen.Return(jen.Id("unmarshalEnum").Call(jen.Id("data"), jen.Id("e"))),
+	).Line()
+}
+
+// genMinorUnits generates a MinorUnits method for the CurrencyCode enum t,
+// returning the ISO 4217 exponent of each currency's minor unit.
+func genMinorUnits(out *jen.File, t graphql.Type) {
+	// Group the currencies by exponent, in ascending order, leaving those with
+	// the usual exponent of 2 to the default case.
+	byExponent := map[int][]jen.Code{}
+	for _, e := range t.EnumValues {
+		if n, ok := currencyMinorUnits[e.Name]; ok {
+			byExponent[n] = append(byExponent[n], jen.Id(enumConst(t, e)))
+		}
+	}
+
+	var cases []jen.Code
+	for n := 0; n <= 4; n++ {
+		if len(byExponent[n]) != 0 {
+			cases = append(cases, jen.Case(byExponent[n]...).Block(jen.Return(jen.Lit(n))))
+		}
+	}
+
+	cases = append(cases, jen.Default().Block(jen.Return(jen.Lit(2))))
+
+	out.Comment("MinorUnits returns the ISO 4217 exponent of the currency's minor unit: the")
+	out.Comment("number of digits after the decimal point of its amounts, such as 2 for USD")
+	out.Comment("(cents) or 0 for JPY.")
+	out.Func().Params(jen.Id("e").Id(t.Name)).Id("MinorUnits").Params().Int32().Block(
+		jen.Switch(jen.Id("e")).Block(cases...),
+	).Line()
+}
+
+// goType returns the name of the Go type for a reference to a GraphQL type,
+// ignoring nullability.
+func goType(t graphql.TypeRef) string {
+	switch t.Kind {
+	case "NON_NULL":
+		return goType(*t.OfType)
+	case "LIST":
+		return "[]" + goType(*t.OfType)
+	}
+
+	if val, ok := typeMap[t.Name]; ok {
+		return val
+	}
+
+	return t.Name
+}
+
+// objectField returns the name and Go type of the property generated for the
+// field f of the object or interface type t. Fields which would close a cycle
+// of structs containing one another by value, as found by cycleBreaks, are
+// pointers, since such types would be illegal.
+func objectField(t graphql.Type, f graphql.Field, breaks map[string]bool) (string, string) {
+	typ := nullableGoType(f.Type)
+	if breaks[t.Name+"."+f.Name] {
+		typ = "*" + typ
+	}
+
+	name := strcase.ToCamel(f.Name)
+
+	// Replace any instances in acronymMap with the correctly-cased variants.
+	for k, v := range acronymMap {
+		if strings.Contains(typ, k) {
+			typ = strings.Replace(typ, k, v, -1)
+		}
+
+		if strings.Contains(name, k) {
+			name = strings.Replace(name, k, v, -1)
+		}
+	}
+
+	return name, typ
+}
+
+// genGetters generates the getters of the fields of the interface iface on
+// the type m implementing it, which return m's properties. Where m's property is a pointer to break a cycle, but iface's isn't, the
+// getter dereferences it, returning the zero value if it's nil.
+func genGetters(out *jen.File, iface, m *graphql.Type, breaks map[string]bool, getters map[string]bool) {
+	typeName := replaceAcronyms(m.Name)
+
+	for _, f := range iface.Fields {
+		name, typ := objectField(*iface, f, breaks)
+
+		getter := "Get" + name
+		if getters[typeName+"."+getter] {
+			continue
+		}
+
+		getters[typeName+"."+getter] = true
+
+		var mf *graphql.Field
+		for i := range m.Fields {
+			if m.Fields[i].Name == f.Name {
+				mf = &m.Fields[i]
+			}
+
+			if _, mname := objectField(*m, m.Fields[i], breaks); mname == getter {
+				log.Fatalf("getter %s of %s collides with a field", getter, typeName)
+			}
+		}
+
+		if mf == nil {
+			log.Fatalf("%s doesn't have the field %s of %s", m.Name, f.Name, iface.Name)
+		}
+
+		_, mtyp := objectField(*m, *mf, breaks)
+		prop := jen.Id("t").Dot(name)
+
+		var body []jen.Code
+		switch mtyp {
+		case typ:
+			body = []jen.Code{jen.Return(prop)}
+		case "*" + typ:
+			body = []jen.Code{
+				jen.If(prop.Clone().Op("==").Nil()).Block(
+					jen.Var().Id("zero").Add(typeCode(typ)),
+					jen.Return(jen.Id("zero")),
+				),
+				jen.Line(),
+				jen.Return(jen.Op("*").Add(prop.Clone())),
+			}
+		default:
+			log.Fatalf("%s.%s is of type %s, which %s.%s (%s) can't be returned as", iface.Name, f.Name, typ, m.Name, f.Name, mtyp)
+		}
+
+		out.Commentf("%s returns the %s of t, implementing %s.", getter, name, replaceAcronyms(iface.Name))
+		out.Func().Params(jen.Id("t").Op("*").Id(typeName)).Id(getter).Params().Add(typeCode(typ)).Block(body...).Line()
+	}
+}
+
+// nullableGoType returns the name of the Go type for a reference to a GraphQL
+// type, honoring nullability: nullable values are pointers, unless the Go type
+// is already nilable.
+func nullableGoType(t graphql.TypeRef) string {
+	nonNull := t.Kind == "NON_NULL"
+	if nonNull {
+		t = *t.OfType
+	}
+
+	if t.Kind == "LIST" {
+		return "[]" + nullableGoType(*t.OfType)
+	}
+
+	typ := goType(t)
+	if nonNull || t.Kind == "UNION" || t.Kind == "INTERFACE" || strings.HasPrefix(typ, "map[") {
+		return typ
+	}
+
+	return "*" + typ
+}
+
+// isPreImplemented reports whether the object type name is one of the
+// *Connection and *Edge types, which are implemented generically rather than
+// generated, as their inclusion during generation would lead to illegal
+// cycles, or PageInfo, which is implemented alongside them so as to include
+// the fields of later API versions.
+func isPreImplemented(name string) bool {
+	return name == "PageInfo" || strings.HasSuffix(name, "Connection") || strings.HasSuffix(name, "Edge")
+}
+
+// cycleBreaks finds the fields which form cycles of object types containing
+// one another by value, returning a set of them keyed as "Type.field". Making
+// those fields pointers yields legal Go types.
+func cycleBreaks(types []graphql.Type) map[string]bool {
+	// values maps each object type to its fields of object types which would
+	// be generated as values, which are the edges of the graph we search.
+	values := map[string][]graphql.Field{}
+
+	for _, t := range types {
+		if t.Kind != "OBJECT" || isPreImplemented(t.Name) {
+			continue
+		}
+
+		for _, f := range t.Fields {
+			typ := nullableGoType(f.Type)
+			if kinds[typ] == "OBJECT" && !isPreImplemented(typ) {
+				values[t.Name] = append(values[t.Name], f)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	breaks := map[string]bool{}
+
+	// A depth-first search, where any field leading back to a type still being
+	// visited closes a cycle.
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+
+		for _, f := range values[name] {
+			typ := nullableGoType(f.Type)
+
+			switch state[typ] {
+			case visiting:
+				breaks[name+"."+f.Name] = true
+			case unvisited:
+				visit(typ)
+			}
+		}
+
+		state[name] = visited
+	}
+
+	for _, t := range types {
+		if state[t.Name] == unvisited {
+			visit(t.Name)
+		}
+	}
+
+	return breaks
+}
+
+// memberList returns a comma-separated list of the names of the given types.
+func memberList(types []graphql.TypeRef) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = replaceAcronyms(t.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// genUnmarshalAbstract generates a function which decodes a JSON object into
+// the member of the abstract type typeName named by its __typename.
+func genUnmarshalAbstract(out *jen.File, typeName string, members []graphql.TypeRef) {
+	var cases []jen.Code
+
+	for _, m := range members {
+		cases = append(cases, jen.Case(jen.Lit(m.Name)).Block(
+			jen.Id("v").Op(":=").New(jen.Id(replaceAcronyms(m.Name))),
+			jen.Return(jen.Id("v"), jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Id("v"))),
+		))
+	}
+
+	out.Commentf("unmarshal%s decodes a %s according to its __typename. An unknown", typeName, typeName)
+	out.Comment("__typename, as may be introduced by a newer API version, decodes to nil.")
+	out.Func().Id("unmarshal"+typeName).Params(jen.Id("data").Index().Byte()).Params(jen.Id(typeName), jen.Error()).Block(
+		jen.List(jen.Id("typename"), jen.Err()).Op(":=").Id("unmarshalTypename").Call(jen.Id("data")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.Line(),
+		jen.Switch(jen.Id("typename")).Block(cases...),
+		jen.Line(),
+		jen.Return(jen.Nil(), jen.Nil()),
+	).Line()
+}
+
+// genUnmarshalJSON generates an UnmarshalJSON method for the struct typeName,
+// decoding its abstract fields according to their __typename.
+func genUnmarshalJSON(out *jen.File, typeName string, fields []abstractField) {
+	var raws []jen.Code
+	var decodes []jen.Code
+
+	for _, f := range fields {
+		raws = append(raws, jen.Id(f.PropertyName).Qual("encoding/json", "RawMessage").Tag(map[string]string{"json": f.Name}))
+
+		fn := "unmarshalAbstract"
+		if f.IsList {
+			fn = "unmarshalAbstractList"
+		}
+
+		decodes = append(decodes,
+			jen.If(
+				jen.Err().Op(":=").Id(fn).Call(jen.Id("raw").Dot(f.PropertyName), jen.Op("&").Id("t").Dot(f.PropertyName)),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Err())),
+		)
+	}
+
+	body := []jen.Code{
+		jen.Type().Id("alias").Id(typeName),
+		jen.Line(),
+		jen.Var().Id("raw").Struct(append([]jen.Code{jen.Op("*").Id("alias")}, raws...)...),
+		jen.Id("raw").Dot("alias").Op("=").Parens(jen.Op("*").Id("alias")).Parens(jen.Id("t")),
+		jen.Line(),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("data"), jen.Op("&").Id("raw")),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Err())),
+		jen.Line(),
+	}
+	body = append(body, decodes...)
+	body = append(body, jen.Line(), jen.Return(jen.Nil()))
+
+	out.Comment("UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types")
+	out.Comment("according to their __typename.")
+	out.Func().Params(jen.Id("t").Op("*").Id(typeName)).Id("UnmarshalJSON").Params(jen.Id("data").Index().Byte()).Error().Block(body...).Line()
+}
+
+// typeCode returns the code for the Go type named by typ, as returned by
+// goType.
+func typeCode(typ string) jen.Code {
+	switch {
+	case strings.HasPrefix(typ, "*"):
+		return jen.Op("*").Add(typeCode(typ[1:]))
+	case strings.HasPrefix(typ, "[]"):
+		return jen.Index().Add(typeCode(typ[2:]))
+	case typ == "time.Time":
+		return jen.Qual("time", "Time")
+	case strings.HasSuffix(typ, "Connection"):
+		// For *Connection properties, we're going to strip the "Connection"
+		// and specify the generic type.
+		before, _, _ := strings.Cut(typ, "Connection")
+		if before == "String" {
+			before = "string"
+		}
+
+		return jen.Id("Connection").Types(jen.Id(before))
+	}
+
+	return jen.Id(typ)
 }
 
 var fetchReturnsOrFindRgx = regexp.MustCompile(`^(Fetch|Returns|Find\w*)\s(an|a|the)`)
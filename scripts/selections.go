@@ -0,0 +1,268 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/boatilus/storefront-go/internal/graphql"
+	"github.com/dave/jennifer/jen"
+	"github.com/iancoleman/strcase"
+)
+
+// selectionsOutfile is the filename to write out the generated query builder.
+const selectionsOutfile = "selections.go"
+
+// genSelectionTypes generates the query builder: a selection type for each
+// composite type of schema, such as ProductSelection, with a method selecting
+// each of its fields, along with a struct of the arguments of each field
+// which takes them. NewQuery and NewMutation begin operations on the root
+// types.
+func genSelectionTypes(out *jen.File, schema *graphql.Schema) {
+	roots := map[string]string{}
+	if schema.QueryType != nil {
+		roots[schema.QueryType.Name] = "Query"
+	}
+	if schema.MutationType != nil {
+		roots[schema.MutationType.Name] = "Mutation"
+	}
+
+	// names records the identifiers generated so far, so that collisions, as
+	// between an arguments struct and a type of the schema, are caught.
+	names := map[string]bool{}
+	for _, t := range schema.Types {
+		names[replaceAcronyms(t.Name)] = true
+	}
+
+	declare := func(name string) {
+		if names[name] {
+			log.Fatalf("generated identifier %s collides with another", name)
+		}
+
+		names[name] = true
+	}
+
+	for i := range schema.Types {
+		t := &schema.Types[i]
+		if strings.HasPrefix(t.Name, "__") {
+			continue
+		}
+
+		switch t.Kind {
+		case "OBJECT", "INTERFACE", "UNION":
+		default:
+			continue
+		}
+
+		typeName := selectionType(t.Name)
+		declare(typeName)
+
+		out.Commentf("%s selects fields of %s for a query built with NewQuery or", typeName, replaceAcronyms(t.Name))
+		out.Comment("NewMutation. Each of its methods adds to the selection and returns it, so")
+		out.Comment("that calls can be chained.")
+		out.Type().Id(typeName).Struct(jen.Id("sel").Op("*").Id("selection")).Line()
+
+		if kind, ok := roots[t.Name]; ok {
+			genRootSelection(out, typeName, kind)
+		}
+
+		// methods records the methods of the type, which may collide when
+		// fields are cased.
+		methods := map[string]bool{"Typename": true}
+		if _, ok := roots[t.Name]; ok {
+			methods["Build"], methods["Request"] = true, true
+		}
+
+		method := func(name string) {
+			if methods[name] {
+				log.Fatalf("method %s of %s collides with another", name, typeName)
+			}
+
+			methods[name] = true
+		}
+
+		for _, f := range t.Fields {
+			name := replaceAcronyms(strcase.ToCamel(f.Name))
+			method(name)
+
+			var argsType string
+			if len(f.Args) != 0 {
+				argsType = replaceAcronyms(t.Name) + name + "Args"
+				declare(argsType)
+				genArgs(out, argsType, t, f)
+			}
+
+			genFieldMethod(out, schema, typeName, name, argsType, f)
+		}
+
+		out.Comment("Typename selects __typename, the name of the object type of the value.")
+		out.Func().Params(jen.Id("s").Op("*").Id(typeName)).Id("Typename").Params().Op("*").Id(typeName).Block(
+			jen.Id("s").Dot("sel").Dot("field").Call(jen.Lit("__typename")),
+			jen.Return(jen.Id("s")),
+		).Line()
+
+		// Fragments on each possible type of abstract types select the fields
+		// particular to them.
+		for _, m := range t.PossibleTypes {
+			name := "On" + replaceAcronyms(m.Name)
+			method(name)
+
+			memberType := selectionType(m.Name)
+
+			out.Commentf("%s selects, by way of an inline fragment, the fields of the value", name)
+			out.Commentf("selected by fn if it's a %s. __typename is also selected, to tell", replaceAcronyms(m.Name))
+			out.Comment("which type the value is.")
+			out.Func().Params(jen.Id("s").Op("*").Id(typeName)).Id(name).Params(
+				jen.Id("fn").Func().Params(jen.Op("*").Id(memberType)),
+			).Op("*").Id(typeName).Block(
+				jen.Id("sel").Op(":=").Id("s").Dot("sel").Dot("fragment").Call(jen.Lit(m.Name)),
+				jen.If(jen.Id("fn").Op("!=").Nil()).Block(
+					jen.Id("fn").Call(jen.Op("&").Id(memberType).Values(jen.Id("sel"))),
+				),
+				jen.Line(),
+				jen.Return(jen.Id("s")),
+			).Line()
+		}
+	}
+}
+
+// selectionType returns the name of the selection type of the composite type
+// name.
+func selectionType(name string) string {
+	return replaceAcronyms(name) + "Selection"
+}
+
+// genRootSelection generates the constructor of the selection type typeName
+// of a root operation type, such as NewQuery, along with its Build and Request
+// methods.
+func genRootSelection(out *jen.File, typeName, kind string) {
+	op := strings.ToLower(kind)
+
+	out.Commentf("New%s begins building a %s, the fields of which are selected by the", kind, op)
+	out.Commentf("methods of the returned %s.", typeName)
+	out.Func().Id("New" + kind).Params().Op("*").Id(typeName).Block(
+		jen.Return(jen.Op("&").Id(typeName).Values(jen.Id("newQueryBuilder").Call(jen.Lit(op)))),
+	).Line()
+
+	out.Commentf("Build returns the document of the %s, and the values of the variables", op)
+	out.Comment("through which the arguments given to it are passed.")
+	out.Func().Params(jen.Id("s").Op("*").Id(typeName)).Id("Build").Params().Params(jen.String(), jen.Map(jen.String()).Interface()).Block(
+		jen.Return(jen.Id("s").Dot("sel").Dot("build").Call()),
+	).Line()
+
+	out.Commentf("Request returns a Request executing the %s, for use with Client.Do.", op)
+	out.Func().Params(jen.Id("s").Op("*").Id(typeName)).Id("Request").Params().Op("*").Id("Request").Block(
+		jen.Return(jen.Id("s").Dot("sel").Dot("request").Call()),
+	).Line()
+}
+
+// genArgs generates the struct typeName holding the arguments of the field f
+// of t. Optional arguments, which are nullable or have a default value, are
+// pointers, unless already nilable, and aren't passed if nil.
+func genArgs(out *jen.File, typeName string, t *graphql.Type, f graphql.Field) {
+	var props []jen.Code
+
+	for _, a := range f.Args {
+		name := replaceAcronyms(strcase.ToCamel(a.Name))
+		typ := replaceAcronyms(goType(a.Type))
+
+		if isOptional(a) && !strings.HasPrefix(typ, "[]") && !strings.HasPrefix(typ, "map[") {
+			typ = "*" + typ
+		}
+
+		// Arguments are described imperatively, as "Sort the underlying list",
+		// which transformFieldComment would mangle.
+		if a.Description != "" {
+			props = append(props, jen.Commentf("%s: %s", name, a.Description))
+		}
+
+		props = append(props, jen.Id(name).Add(typeCode(typ)))
+	}
+
+	out.Commentf("%s holds the arguments of %s.%s.", typeName, replaceAcronyms(t.Name), f.Name)
+	out.Type().Id(typeName).Struct(props...).Line()
+}
+
+// isOptional reports whether the argument a may be omitted.
+func isOptional(a graphql.InputValue) bool {
+	return a.Type.Kind != "NON_NULL" || a.DefaultValue != nil
+}
+
+// genFieldMethod generates the method name of the selection type typeName
+// which selects the field f. If f takes arguments, the method takes a struct
+// of them, argsType; if f is of a composite type, the method takes a function
+// selecting its fields.
+func genFieldMethod(out *jen.File, schema *graphql.Schema, typeName, name, argsType string, f graphql.Field) {
+	var params []jen.Code
+	var body []jen.Code
+
+	fieldType := schema.Type(f.Type.Named().Name)
+	composite := fieldType != nil && (fieldType.Kind == "OBJECT" || fieldType.Kind == "INTERFACE" || fieldType.Kind == "UNION")
+
+	call := jen.Id("s").Dot("sel").Dot("field").Call(jen.Lit(f.Name))
+	if argsType != "" || composite {
+		body = append(body, jen.Id("f").Op(":=").Add(call))
+	} else {
+		body = append(body, call)
+	}
+
+	if argsType != "" {
+		params = append(params, jen.Id("args").Id(argsType))
+
+		for _, a := range f.Args {
+			field := jen.Id("args").Dot(replaceAcronyms(strcase.ToCamel(a.Name)))
+			typ := goType(a.Type)
+			nilable := strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[")
+
+			value := field.Clone()
+			if isOptional(a) && !nilable {
+				value = jen.Op("*").Add(field.Clone())
+			}
+
+			pass := jen.Id("f").Dot("arg").Call(jen.Lit(a.Name), jen.Lit(a.Type.String()), value)
+
+			if isOptional(a) {
+				body = append(body, jen.If(field.Clone().Op("!=").Nil()).Block(pass))
+			} else {
+				body = append(body, pass)
+			}
+		}
+	}
+
+	if composite {
+		fieldSelection := selectionType(fieldType.Name)
+		params = append(params, jen.Id("fn").Func().Params(jen.Op("*").Id(fieldSelection)))
+
+		body = append(body,
+			jen.Line(),
+			jen.Id("sel").Op(":=").Id("f").Dot("selection").Call(),
+			jen.If(jen.Id("fn").Op("!=").Nil()).Block(
+				jen.Id("fn").Call(jen.Op("&").Id(fieldSelection).Values(jen.Id("sel"))),
+			),
+		)
+	}
+
+	body = append(body, jen.Line(), jen.Return(jen.Id("s")))
+
+	switch {
+	case argsType != "" && composite:
+		out.Commentf("%s selects %s, passing args, and the fields of it selected by fn.", name, f.Name)
+	case argsType != "":
+		out.Commentf("%s selects %s, passing args.", name, f.Name)
+	case composite:
+		out.Commentf("%s selects %s, and the fields of it selected by fn.", name, f.Name)
+	default:
+		out.Commentf("%s selects %s.", name, f.Name)
+	}
+
+	if f.IsDeprecated {
+		reason := "No longer supported"
+		if f.DeprecationReason != nil && *f.DeprecationReason != "" {
+			reason = *f.DeprecationReason
+		}
+
+		out.Comment("")
+		out.Comment("Deprecated: " + reason)
+	}
+
+	out.Func().Params(jen.Id("s").Op("*").Id(typeName)).Id(name).Params(params...).Op("*").Id(typeName).Block(body...).Line()
+}
@@ -0,0 +1,14063 @@
+package storefront
+
+// QueryRootSelection selects fields of QueryRoot for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type QueryRootSelection struct {
+	sel *selection
+}
+
+// NewQuery begins building a query, the fields of which are selected by the
+// methods of the returned QueryRootSelection.
+func NewQuery() *QueryRootSelection {
+	return &QueryRootSelection{newQueryBuilder("query")}
+}
+
+// Build returns the document of the query, and the values of the variables
+// through which the arguments given to it are passed.
+func (s *QueryRootSelection) Build() (string, map[string]interface{}) {
+	return s.sel.build()
+}
+
+// Request returns a Request executing the query, for use with Client.Do.
+func (s *QueryRootSelection) Request() *Request {
+	return s.sel.request()
+}
+
+// QueryRootArticlesArgs holds the arguments of QueryRoot.articles.
+type QueryRootArticlesArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *ArticleSortKeys
+	/*
+	   Query: Supported filter parameters:
+	    - `author`
+	    - `blog_title`
+	    - `created_at`
+	    - `tag`
+	    - `tag_not`
+	    - `updated_at`
+
+	   See the detailed [search syntax](https://shopify.dev/api/usage/search-syntax)
+	   for more information about using filters.
+	*/
+	Query *string
+}
+
+// Articles selects articles, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Articles(args QueryRootArticlesArgs, fn func(*ArticleConnectionSelection)) *QueryRootSelection {
+	f := s.sel.field("articles")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "ArticleSortKeys", *args.SortKey)
+	}
+	if args.Query != nil {
+		f.arg("query", "String", *args.Query)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ArticleConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootBlogArgs holds the arguments of QueryRoot.blog.
+type QueryRootBlogArgs struct {
+	// Id: The ID of the `Blog`.
+	Id *string
+	// Handle: The handle of the `Blog`.
+	Handle *string
+}
+
+// Blog selects blog, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Blog(args QueryRootBlogArgs, fn func(*BlogSelection)) *QueryRootSelection {
+	f := s.sel.field("blog")
+	if args.Id != nil {
+		f.arg("id", "ID", *args.Id)
+	}
+	if args.Handle != nil {
+		f.arg("handle", "String", *args.Handle)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&BlogSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootBlogByHandleArgs holds the arguments of QueryRoot.blogByHandle.
+type QueryRootBlogByHandleArgs struct {
+	// Handle: The handle of the blog.
+	Handle string
+}
+
+// BlogByHandle selects blogByHandle, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `blog` instead
+func (s *QueryRootSelection) BlogByHandle(args QueryRootBlogByHandleArgs, fn func(*BlogSelection)) *QueryRootSelection {
+	f := s.sel.field("blogByHandle")
+	f.arg("handle", "String!", args.Handle)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&BlogSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootBlogsArgs holds the arguments of QueryRoot.blogs.
+type QueryRootBlogsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *BlogSortKeys
+	/*
+	   Query: Supported filter parameters:
+	    - `created_at`
+	    - `handle`
+	    - `title`
+	    - `updated_at`
+
+	   See the detailed [search syntax](https://shopify.dev/api/usage/search-syntax)
+	   for more information about using filters.
+	*/
+	Query *string
+}
+
+// Blogs selects blogs, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Blogs(args QueryRootBlogsArgs, fn func(*BlogConnectionSelection)) *QueryRootSelection {
+	f := s.sel.field("blogs")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "BlogSortKeys", *args.SortKey)
+	}
+	if args.Query != nil {
+		f.arg("query", "String", *args.Query)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&BlogConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootCartArgs holds the arguments of QueryRoot.cart.
+type QueryRootCartArgs struct {
+	// Id: The id of the cart.
+	Id string
+}
+
+// Cart selects cart, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Cart(args QueryRootCartArgs, fn func(*CartSelection)) *QueryRootSelection {
+	f := s.sel.field("cart")
+	f.arg("id", "ID!", args.Id)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootCollectionArgs holds the arguments of QueryRoot.collection.
+type QueryRootCollectionArgs struct {
+	// Id: The ID of the `Collection`.
+	Id *string
+	// Handle: The handle of the `Collection`.
+	Handle *string
+}
+
+// Collection selects collection, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Collection(args QueryRootCollectionArgs, fn func(*CollectionSelection)) *QueryRootSelection {
+	f := s.sel.field("collection")
+	if args.Id != nil {
+		f.arg("id", "ID", *args.Id)
+	}
+	if args.Handle != nil {
+		f.arg("handle", "String", *args.Handle)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CollectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootCollectionByHandleArgs holds the arguments of QueryRoot.collectionByHandle.
+type QueryRootCollectionByHandleArgs struct {
+	// Handle: The handle of the collection.
+	Handle string
+}
+
+// CollectionByHandle selects collectionByHandle, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `collection` instead
+func (s *QueryRootSelection) CollectionByHandle(args QueryRootCollectionByHandleArgs, fn func(*CollectionSelection)) *QueryRootSelection {
+	f := s.sel.field("collectionByHandle")
+	f.arg("handle", "String!", args.Handle)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CollectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootCollectionsArgs holds the arguments of QueryRoot.collections.
+type QueryRootCollectionsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *CollectionSortKeys
+	/*
+	   Query: Supported filter parameters:
+	    - `collection_type`
+	    - `title`
+	    - `updated_at`
+
+	   See the detailed [search syntax](https://shopify.dev/api/usage/search-syntax)
+	   for more information about using filters.
+	*/
+	Query *string
+}
+
+// Collections selects collections, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Collections(args QueryRootCollectionsArgs, fn func(*CollectionConnectionSelection)) *QueryRootSelection {
+	f := s.sel.field("collections")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "CollectionSortKeys", *args.SortKey)
+	}
+	if args.Query != nil {
+		f.arg("query", "String", *args.Query)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CollectionConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootCustomerArgs holds the arguments of QueryRoot.customer.
+type QueryRootCustomerArgs struct {
+	// CustomerAccessToken: The customer access token.
+	CustomerAccessToken string
+}
+
+// Customer selects customer, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Customer(args QueryRootCustomerArgs, fn func(*CustomerSelection)) *QueryRootSelection {
+	f := s.sel.field("customer")
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// Localization selects localization, and the fields of it selected by fn.
+func (s *QueryRootSelection) Localization(fn func(*LocalizationSelection)) *QueryRootSelection {
+	f := s.sel.field("localization")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&LocalizationSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootLocationsArgs holds the arguments of QueryRoot.locations.
+type QueryRootLocationsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *LocationSortKeys
+	// Near: Used to sort results based on proximity to the provided location.
+	Near *GeoCoordinateInput
+}
+
+// Locations selects locations, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Locations(args QueryRootLocationsArgs, fn func(*LocationConnectionSelection)) *QueryRootSelection {
+	f := s.sel.field("locations")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "LocationSortKeys", *args.SortKey)
+	}
+	if args.Near != nil {
+		f.arg("near", "GeoCoordinateInput", *args.Near)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&LocationConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootNodeArgs holds the arguments of QueryRoot.node.
+type QueryRootNodeArgs struct {
+	// Id: The ID of the Node to return.
+	Id string
+}
+
+// Node selects node, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Node(args QueryRootNodeArgs, fn func(*NodeSelection)) *QueryRootSelection {
+	f := s.sel.field("node")
+	f.arg("id", "ID!", args.Id)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&NodeSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootNodesArgs holds the arguments of QueryRoot.nodes.
+type QueryRootNodesArgs struct {
+	// Ids: The IDs of the Nodes to return.
+	Ids []string
+}
+
+// Nodes selects nodes, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Nodes(args QueryRootNodesArgs, fn func(*NodeSelection)) *QueryRootSelection {
+	f := s.sel.field("nodes")
+	f.arg("ids", "[ID!]!", args.Ids)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&NodeSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootPageArgs holds the arguments of QueryRoot.page.
+type QueryRootPageArgs struct {
+	// Id: The ID of the `Page`.
+	Id *string
+	// Handle: The handle of the `Page`.
+	Handle *string
+}
+
+// Page selects page, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Page(args QueryRootPageArgs, fn func(*PageSelection)) *QueryRootSelection {
+	f := s.sel.field("page")
+	if args.Id != nil {
+		f.arg("id", "ID", *args.Id)
+	}
+	if args.Handle != nil {
+		f.arg("handle", "String", *args.Handle)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootPageByHandleArgs holds the arguments of QueryRoot.pageByHandle.
+type QueryRootPageByHandleArgs struct {
+	// Handle: The handle of the page.
+	Handle string
+}
+
+// PageByHandle selects pageByHandle, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `page` instead
+func (s *QueryRootSelection) PageByHandle(args QueryRootPageByHandleArgs, fn func(*PageSelection)) *QueryRootSelection {
+	f := s.sel.field("pageByHandle")
+	f.arg("handle", "String!", args.Handle)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootPagesArgs holds the arguments of QueryRoot.pages.
+type QueryRootPagesArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *PageSortKeys
+	/*
+	   Query: Supported filter parameters:
+	    - `created_at`
+	    - `handle`
+	    - `title`
+	    - `updated_at`
+
+	   See the detailed [search syntax](https://shopify.dev/api/usage/search-syntax)
+	   for more information about using filters.
+	*/
+	Query *string
+}
+
+// Pages selects pages, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Pages(args QueryRootPagesArgs, fn func(*PageConnectionSelection)) *QueryRootSelection {
+	f := s.sel.field("pages")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "PageSortKeys", *args.SortKey)
+	}
+	if args.Query != nil {
+		f.arg("query", "String", *args.Query)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootProductArgs holds the arguments of QueryRoot.product.
+type QueryRootProductArgs struct {
+	// Id: The ID of the `Product`.
+	Id *string
+	// Handle: The handle of the `Product`.
+	Handle *string
+}
+
+// Product selects product, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Product(args QueryRootProductArgs, fn func(*ProductSelection)) *QueryRootSelection {
+	f := s.sel.field("product")
+	if args.Id != nil {
+		f.arg("id", "ID", *args.Id)
+	}
+	if args.Handle != nil {
+		f.arg("handle", "String", *args.Handle)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootProductByHandleArgs holds the arguments of QueryRoot.productByHandle.
+type QueryRootProductByHandleArgs struct {
+	// Handle: The handle of the product.
+	Handle string
+}
+
+// ProductByHandle selects productByHandle, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `product` instead
+func (s *QueryRootSelection) ProductByHandle(args QueryRootProductByHandleArgs, fn func(*ProductSelection)) *QueryRootSelection {
+	f := s.sel.field("productByHandle")
+	f.arg("handle", "String!", args.Handle)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootProductRecommendationsArgs holds the arguments of QueryRoot.productRecommendations.
+type QueryRootProductRecommendationsArgs struct {
+	// ProductId: The id of the product.
+	ProductId string
+}
+
+// ProductRecommendations selects productRecommendations, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) ProductRecommendations(args QueryRootProductRecommendationsArgs, fn func(*ProductSelection)) *QueryRootSelection {
+	f := s.sel.field("productRecommendations")
+	f.arg("productId", "ID!", args.ProductId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootProductTagsArgs holds the arguments of QueryRoot.productTags.
+type QueryRootProductTagsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First int
+}
+
+// ProductTags selects productTags, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) ProductTags(args QueryRootProductTagsArgs, fn func(*StringConnectionSelection)) *QueryRootSelection {
+	f := s.sel.field("productTags")
+	f.arg("first", "Int!", args.First)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&StringConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootProductTypesArgs holds the arguments of QueryRoot.productTypes.
+type QueryRootProductTypesArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First int
+}
+
+// ProductTypes selects productTypes, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) ProductTypes(args QueryRootProductTypesArgs, fn func(*StringConnectionSelection)) *QueryRootSelection {
+	f := s.sel.field("productTypes")
+	f.arg("first", "Int!", args.First)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&StringConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// QueryRootProductsArgs holds the arguments of QueryRoot.products.
+type QueryRootProductsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *ProductSortKeys
+	/*
+	   Query: Supported filter parameters:
+	    - `available_for_sale`
+	    - `created_at`
+	    - `product_type`
+	    - `tag`
+	    - `tag_not`
+	    - `title`
+	    - `updated_at`
+	    - `variants.price`
+	    - `vendor`
+
+	   See the detailed [search syntax](https://shopify.dev/api/usage/search-syntax)
+	   for more information about using filters.
+	*/
+	Query *string
+}
+
+// Products selects products, passing args, and the fields of it selected by fn.
+func (s *QueryRootSelection) Products(args QueryRootProductsArgs, fn func(*ProductConnectionSelection)) *QueryRootSelection {
+	f := s.sel.field("products")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "ProductSortKeys", *args.SortKey)
+	}
+	if args.Query != nil {
+		f.arg("query", "String", *args.Query)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// PublicApiVersions selects publicApiVersions, and the fields of it selected by fn.
+func (s *QueryRootSelection) PublicApiVersions(fn func(*ApiVersionSelection)) *QueryRootSelection {
+	f := s.sel.field("publicApiVersions")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ApiVersionSelection{sel})
+	}
+
+	return s
+}
+
+// Shop selects shop, and the fields of it selected by fn.
+func (s *QueryRootSelection) Shop(fn func(*ShopSelection)) *QueryRootSelection {
+	f := s.sel.field("shop")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ShopSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *QueryRootSelection) Typename() *QueryRootSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ArticleConnectionSelection selects fields of ArticleConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ArticleConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *ArticleConnectionSelection) Edges(fn func(*ArticleEdgeSelection)) *ArticleConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ArticleEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *ArticleConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *ArticleConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ArticleConnectionSelection) Typename() *ArticleConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ArticleEdgeSelection selects fields of ArticleEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ArticleEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *ArticleEdgeSelection) Cursor() *ArticleEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *ArticleEdgeSelection) Node(fn func(*ArticleSelection)) *ArticleEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ArticleSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ArticleEdgeSelection) Typename() *ArticleEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ArticleSelection selects fields of Article for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ArticleSelection struct {
+	sel *selection
+}
+
+// Author selects author, and the fields of it selected by fn.
+//
+// Deprecated: Use `authorV2` instead
+func (s *ArticleSelection) Author(fn func(*ArticleAuthorSelection)) *ArticleSelection {
+	f := s.sel.field("author")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ArticleAuthorSelection{sel})
+	}
+
+	return s
+}
+
+// AuthorV2 selects authorV2, and the fields of it selected by fn.
+func (s *ArticleSelection) AuthorV2(fn func(*ArticleAuthorSelection)) *ArticleSelection {
+	f := s.sel.field("authorV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ArticleAuthorSelection{sel})
+	}
+
+	return s
+}
+
+// Blog selects blog, and the fields of it selected by fn.
+func (s *ArticleSelection) Blog(fn func(*BlogSelection)) *ArticleSelection {
+	f := s.sel.field("blog")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&BlogSelection{sel})
+	}
+
+	return s
+}
+
+// ArticleCommentsArgs holds the arguments of Article.comments.
+type ArticleCommentsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Comments selects comments, passing args, and the fields of it selected by fn.
+func (s *ArticleSelection) Comments(args ArticleCommentsArgs, fn func(*CommentConnectionSelection)) *ArticleSelection {
+	f := s.sel.field("comments")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CommentConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// ArticleContentArgs holds the arguments of Article.content.
+type ArticleContentArgs struct {
+	// TruncateAt: Truncates string after the given length.
+	TruncateAt *int
+}
+
+// Content selects content, passing args.
+func (s *ArticleSelection) Content(args ArticleContentArgs) *ArticleSelection {
+	f := s.sel.field("content")
+	if args.TruncateAt != nil {
+		f.arg("truncateAt", "Int", *args.TruncateAt)
+	}
+
+	return s
+}
+
+// ContentHTML selects contentHtml.
+func (s *ArticleSelection) ContentHTML() *ArticleSelection {
+	s.sel.field("contentHtml")
+
+	return s
+}
+
+// ArticleExcerptArgs holds the arguments of Article.excerpt.
+type ArticleExcerptArgs struct {
+	// TruncateAt: Truncates string after the given length.
+	TruncateAt *int
+}
+
+// Excerpt selects excerpt, passing args.
+func (s *ArticleSelection) Excerpt(args ArticleExcerptArgs) *ArticleSelection {
+	f := s.sel.field("excerpt")
+	if args.TruncateAt != nil {
+		f.arg("truncateAt", "Int", *args.TruncateAt)
+	}
+
+	return s
+}
+
+// ExcerptHTML selects excerptHtml.
+func (s *ArticleSelection) ExcerptHTML() *ArticleSelection {
+	s.sel.field("excerptHtml")
+
+	return s
+}
+
+// Handle selects handle.
+func (s *ArticleSelection) Handle() *ArticleSelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Id selects id.
+func (s *ArticleSelection) Id() *ArticleSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Image selects image, and the fields of it selected by fn.
+func (s *ArticleSelection) Image(fn func(*ImageSelection)) *ArticleSelection {
+	f := s.sel.field("image")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// ArticleMetafieldArgs holds the arguments of Article.metafield.
+type ArticleMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *ArticleSelection) Metafield(args ArticleMetafieldArgs, fn func(*MetafieldSelection)) *ArticleSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// ArticleMetafieldsArgs holds the arguments of Article.metafields.
+type ArticleMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *ArticleSelection) Metafields(args ArticleMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *ArticleSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnlineStoreURL selects onlineStoreUrl.
+func (s *ArticleSelection) OnlineStoreURL() *ArticleSelection {
+	s.sel.field("onlineStoreUrl")
+
+	return s
+}
+
+// PublishedAt selects publishedAt.
+func (s *ArticleSelection) PublishedAt() *ArticleSelection {
+	s.sel.field("publishedAt")
+
+	return s
+}
+
+// SEO selects seo, and the fields of it selected by fn.
+func (s *ArticleSelection) SEO(fn func(*SEOSelection)) *ArticleSelection {
+	f := s.sel.field("seo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SEOSelection{sel})
+	}
+
+	return s
+}
+
+// Tags selects tags.
+func (s *ArticleSelection) Tags() *ArticleSelection {
+	s.sel.field("tags")
+
+	return s
+}
+
+// Title selects title.
+func (s *ArticleSelection) Title() *ArticleSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ArticleSelection) Typename() *ArticleSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// NodeSelection selects fields of Node for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type NodeSelection struct {
+	sel *selection
+}
+
+// Id selects id.
+func (s *NodeSelection) Id() *NodeSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *NodeSelection) Typename() *NodeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnArticle selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Article. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnArticle(fn func(*ArticleSelection)) *NodeSelection {
+	sel := s.sel.fragment("Article")
+	if fn != nil {
+		fn(&ArticleSelection{sel})
+	}
+
+	return s
+}
+
+// OnMetafield selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Metafield. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnMetafield(fn func(*MetafieldSelection)) *NodeSelection {
+	sel := s.sel.fragment("Metafield")
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// OnBlog selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Blog. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnBlog(fn func(*BlogSelection)) *NodeSelection {
+	sel := s.sel.fragment("Blog")
+	if fn != nil {
+		fn(&BlogSelection{sel})
+	}
+
+	return s
+}
+
+// OnCollection selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Collection. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnCollection(fn func(*CollectionSelection)) *NodeSelection {
+	sel := s.sel.fragment("Collection")
+	if fn != nil {
+		fn(&CollectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnProduct selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Product. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnProduct(fn func(*ProductSelection)) *NodeSelection {
+	sel := s.sel.fragment("Product")
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// OnProductOption selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ProductOption. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnProductOption(fn func(*ProductOptionSelection)) *NodeSelection {
+	sel := s.sel.fragment("ProductOption")
+	if fn != nil {
+		fn(&ProductOptionSelection{sel})
+	}
+
+	return s
+}
+
+// OnProductVariant selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ProductVariant. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnProductVariant(fn func(*ProductVariantSelection)) *NodeSelection {
+	sel := s.sel.fragment("ProductVariant")
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// OnLocation selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Location. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnLocation(fn func(*LocationSelection)) *NodeSelection {
+	sel := s.sel.fragment("Location")
+	if fn != nil {
+		fn(&LocationSelection{sel})
+	}
+
+	return s
+}
+
+// OnMailingAddress selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a MailingAddress. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnMailingAddress(fn func(*MailingAddressSelection)) *NodeSelection {
+	sel := s.sel.fragment("MailingAddress")
+	if fn != nil {
+		fn(&MailingAddressSelection{sel})
+	}
+
+	return s
+}
+
+// OnCheckout selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Checkout. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnCheckout(fn func(*CheckoutSelection)) *NodeSelection {
+	sel := s.sel.fragment("Checkout")
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// OnAppliedGiftCard selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a AppliedGiftCard. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnAppliedGiftCard(fn func(*AppliedGiftCardSelection)) *NodeSelection {
+	sel := s.sel.fragment("AppliedGiftCard")
+	if fn != nil {
+		fn(&AppliedGiftCardSelection{sel})
+	}
+
+	return s
+}
+
+// OnCheckoutLineItem selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a CheckoutLineItem. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnCheckoutLineItem(fn func(*CheckoutLineItemSelection)) *NodeSelection {
+	sel := s.sel.fragment("CheckoutLineItem")
+	if fn != nil {
+		fn(&CheckoutLineItemSelection{sel})
+	}
+
+	return s
+}
+
+// OnOrder selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Order. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnOrder(fn func(*OrderSelection)) *NodeSelection {
+	sel := s.sel.fragment("Order")
+	if fn != nil {
+		fn(&OrderSelection{sel})
+	}
+
+	return s
+}
+
+// OnPage selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Page. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnPage(fn func(*PageSelection)) *NodeSelection {
+	sel := s.sel.fragment("Page")
+	if fn != nil {
+		fn(&PageSelection{sel})
+	}
+
+	return s
+}
+
+// OnShopPolicy selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ShopPolicy. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnShopPolicy(fn func(*ShopPolicySelection)) *NodeSelection {
+	sel := s.sel.fragment("ShopPolicy")
+	if fn != nil {
+		fn(&ShopPolicySelection{sel})
+	}
+
+	return s
+}
+
+// OnMediaImage selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a MediaImage. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnMediaImage(fn func(*MediaImageSelection)) *NodeSelection {
+	sel := s.sel.fragment("MediaImage")
+	if fn != nil {
+		fn(&MediaImageSelection{sel})
+	}
+
+	return s
+}
+
+// OnComment selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Comment. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnComment(fn func(*CommentSelection)) *NodeSelection {
+	sel := s.sel.fragment("Comment")
+	if fn != nil {
+		fn(&CommentSelection{sel})
+	}
+
+	return s
+}
+
+// OnCart selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Cart. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnCart(fn func(*CartSelection)) *NodeSelection {
+	sel := s.sel.fragment("Cart")
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// OnCartLine selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a CartLine. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnCartLine(fn func(*CartLineSelection)) *NodeSelection {
+	sel := s.sel.fragment("CartLine")
+	if fn != nil {
+		fn(&CartLineSelection{sel})
+	}
+
+	return s
+}
+
+// OnPayment selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Payment. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnPayment(fn func(*PaymentSelection)) *NodeSelection {
+	sel := s.sel.fragment("Payment")
+	if fn != nil {
+		fn(&PaymentSelection{sel})
+	}
+
+	return s
+}
+
+// OnExternalVideo selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ExternalVideo. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnExternalVideo(fn func(*ExternalVideoSelection)) *NodeSelection {
+	sel := s.sel.fragment("ExternalVideo")
+	if fn != nil {
+		fn(&ExternalVideoSelection{sel})
+	}
+
+	return s
+}
+
+// OnModel3D selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Model3D. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnModel3D(fn func(*Model3DSelection)) *NodeSelection {
+	sel := s.sel.fragment("Model3d")
+	if fn != nil {
+		fn(&Model3DSelection{sel})
+	}
+
+	return s
+}
+
+// OnVideo selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Video. __typename is also selected, to tell
+// which type the value is.
+func (s *NodeSelection) OnVideo(fn func(*VideoSelection)) *NodeSelection {
+	sel := s.sel.fragment("Video")
+	if fn != nil {
+		fn(&VideoSelection{sel})
+	}
+
+	return s
+}
+
+// HasMetafieldsSelection selects fields of HasMetafields for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type HasMetafieldsSelection struct {
+	sel *selection
+}
+
+// HasMetafieldsMetafieldArgs holds the arguments of HasMetafields.metafield.
+type HasMetafieldsMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *HasMetafieldsSelection) Metafield(args HasMetafieldsMetafieldArgs, fn func(*MetafieldSelection)) *HasMetafieldsSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// HasMetafieldsMetafieldsArgs holds the arguments of HasMetafields.metafields.
+type HasMetafieldsMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *HasMetafieldsSelection) Metafields(args HasMetafieldsMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *HasMetafieldsSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *HasMetafieldsSelection) Typename() *HasMetafieldsSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnArticle selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Article. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnArticle(fn func(*ArticleSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("Article")
+	if fn != nil {
+		fn(&ArticleSelection{sel})
+	}
+
+	return s
+}
+
+// OnBlog selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Blog. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnBlog(fn func(*BlogSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("Blog")
+	if fn != nil {
+		fn(&BlogSelection{sel})
+	}
+
+	return s
+}
+
+// OnCollection selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Collection. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnCollection(fn func(*CollectionSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("Collection")
+	if fn != nil {
+		fn(&CollectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnProduct selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Product. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnProduct(fn func(*ProductSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("Product")
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// OnProductVariant selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ProductVariant. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnProductVariant(fn func(*ProductVariantSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("ProductVariant")
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// OnCustomer selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Customer. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnCustomer(fn func(*CustomerSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("Customer")
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// OnOrder selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Order. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnOrder(fn func(*OrderSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("Order")
+	if fn != nil {
+		fn(&OrderSelection{sel})
+	}
+
+	return s
+}
+
+// OnPage selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Page. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnPage(fn func(*PageSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("Page")
+	if fn != nil {
+		fn(&PageSelection{sel})
+	}
+
+	return s
+}
+
+// OnShop selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Shop. __typename is also selected, to tell
+// which type the value is.
+func (s *HasMetafieldsSelection) OnShop(fn func(*ShopSelection)) *HasMetafieldsSelection {
+	sel := s.sel.fragment("Shop")
+	if fn != nil {
+		fn(&ShopSelection{sel})
+	}
+
+	return s
+}
+
+// MetafieldSelection selects fields of Metafield for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MetafieldSelection struct {
+	sel *selection
+}
+
+// CreatedAt selects createdAt.
+func (s *MetafieldSelection) CreatedAt() *MetafieldSelection {
+	s.sel.field("createdAt")
+
+	return s
+}
+
+// Description selects description.
+func (s *MetafieldSelection) Description() *MetafieldSelection {
+	s.sel.field("description")
+
+	return s
+}
+
+// Id selects id.
+func (s *MetafieldSelection) Id() *MetafieldSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Key selects key.
+func (s *MetafieldSelection) Key() *MetafieldSelection {
+	s.sel.field("key")
+
+	return s
+}
+
+// Namespace selects namespace.
+func (s *MetafieldSelection) Namespace() *MetafieldSelection {
+	s.sel.field("namespace")
+
+	return s
+}
+
+// ParentResource selects parentResource, and the fields of it selected by fn.
+func (s *MetafieldSelection) ParentResource(fn func(*MetafieldParentResourceSelection)) *MetafieldSelection {
+	f := s.sel.field("parentResource")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldParentResourceSelection{sel})
+	}
+
+	return s
+}
+
+// Reference selects reference, and the fields of it selected by fn.
+func (s *MetafieldSelection) Reference(fn func(*MetafieldReferenceSelection)) *MetafieldSelection {
+	f := s.sel.field("reference")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldReferenceSelection{sel})
+	}
+
+	return s
+}
+
+// Type selects type.
+func (s *MetafieldSelection) Type() *MetafieldSelection {
+	s.sel.field("type")
+
+	return s
+}
+
+// UpdatedAt selects updatedAt.
+func (s *MetafieldSelection) UpdatedAt() *MetafieldSelection {
+	s.sel.field("updatedAt")
+
+	return s
+}
+
+// Value selects value.
+func (s *MetafieldSelection) Value() *MetafieldSelection {
+	s.sel.field("value")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MetafieldSelection) Typename() *MetafieldSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MetafieldParentResourceSelection selects fields of MetafieldParentResource for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MetafieldParentResourceSelection struct {
+	sel *selection
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MetafieldParentResourceSelection) Typename() *MetafieldParentResourceSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnArticle selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Article. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnArticle(fn func(*ArticleSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("Article")
+	if fn != nil {
+		fn(&ArticleSelection{sel})
+	}
+
+	return s
+}
+
+// OnBlog selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Blog. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnBlog(fn func(*BlogSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("Blog")
+	if fn != nil {
+		fn(&BlogSelection{sel})
+	}
+
+	return s
+}
+
+// OnCollection selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Collection. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnCollection(fn func(*CollectionSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("Collection")
+	if fn != nil {
+		fn(&CollectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnCustomer selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Customer. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnCustomer(fn func(*CustomerSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("Customer")
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// OnOrder selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Order. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnOrder(fn func(*OrderSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("Order")
+	if fn != nil {
+		fn(&OrderSelection{sel})
+	}
+
+	return s
+}
+
+// OnPage selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Page. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnPage(fn func(*PageSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("Page")
+	if fn != nil {
+		fn(&PageSelection{sel})
+	}
+
+	return s
+}
+
+// OnProduct selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Product. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnProduct(fn func(*ProductSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("Product")
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// OnProductVariant selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ProductVariant. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnProductVariant(fn func(*ProductVariantSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("ProductVariant")
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// OnShop selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Shop. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldParentResourceSelection) OnShop(fn func(*ShopSelection)) *MetafieldParentResourceSelection {
+	sel := s.sel.fragment("Shop")
+	if fn != nil {
+		fn(&ShopSelection{sel})
+	}
+
+	return s
+}
+
+// BlogSelection selects fields of Blog for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type BlogSelection struct {
+	sel *selection
+}
+
+// BlogArticleByHandleArgs holds the arguments of Blog.articleByHandle.
+type BlogArticleByHandleArgs struct {
+	// Handle: The handle of the article.
+	Handle string
+}
+
+// ArticleByHandle selects articleByHandle, passing args, and the fields of it selected by fn.
+func (s *BlogSelection) ArticleByHandle(args BlogArticleByHandleArgs, fn func(*ArticleSelection)) *BlogSelection {
+	f := s.sel.field("articleByHandle")
+	f.arg("handle", "String!", args.Handle)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ArticleSelection{sel})
+	}
+
+	return s
+}
+
+// BlogArticlesArgs holds the arguments of Blog.articles.
+type BlogArticlesArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *ArticleSortKeys
+	/*
+	   Query: Supported filter parameters:
+	    - `author`
+	    - `blog_title`
+	    - `created_at`
+	    - `tag`
+	    - `tag_not`
+	    - `updated_at`
+
+	   See the detailed [search syntax](https://shopify.dev/api/usage/search-syntax)
+	   for more information about using filters.
+	*/
+	Query *string
+}
+
+// Articles selects articles, passing args, and the fields of it selected by fn.
+func (s *BlogSelection) Articles(args BlogArticlesArgs, fn func(*ArticleConnectionSelection)) *BlogSelection {
+	f := s.sel.field("articles")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "ArticleSortKeys", *args.SortKey)
+	}
+	if args.Query != nil {
+		f.arg("query", "String", *args.Query)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ArticleConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Authors selects authors, and the fields of it selected by fn.
+func (s *BlogSelection) Authors(fn func(*ArticleAuthorSelection)) *BlogSelection {
+	f := s.sel.field("authors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ArticleAuthorSelection{sel})
+	}
+
+	return s
+}
+
+// Handle selects handle.
+func (s *BlogSelection) Handle() *BlogSelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Id selects id.
+func (s *BlogSelection) Id() *BlogSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// BlogMetafieldArgs holds the arguments of Blog.metafield.
+type BlogMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *BlogSelection) Metafield(args BlogMetafieldArgs, fn func(*MetafieldSelection)) *BlogSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// BlogMetafieldsArgs holds the arguments of Blog.metafields.
+type BlogMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *BlogSelection) Metafields(args BlogMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *BlogSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnlineStoreURL selects onlineStoreUrl.
+func (s *BlogSelection) OnlineStoreURL() *BlogSelection {
+	s.sel.field("onlineStoreUrl")
+
+	return s
+}
+
+// SEO selects seo, and the fields of it selected by fn.
+func (s *BlogSelection) SEO(fn func(*SEOSelection)) *BlogSelection {
+	f := s.sel.field("seo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SEOSelection{sel})
+	}
+
+	return s
+}
+
+// Title selects title.
+func (s *BlogSelection) Title() *BlogSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *BlogSelection) Typename() *BlogSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnlineStorePublishableSelection selects fields of OnlineStorePublishable for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type OnlineStorePublishableSelection struct {
+	sel *selection
+}
+
+// OnlineStoreURL selects onlineStoreUrl.
+func (s *OnlineStorePublishableSelection) OnlineStoreURL() *OnlineStorePublishableSelection {
+	s.sel.field("onlineStoreUrl")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *OnlineStorePublishableSelection) Typename() *OnlineStorePublishableSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnArticle selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Article. __typename is also selected, to tell
+// which type the value is.
+func (s *OnlineStorePublishableSelection) OnArticle(fn func(*ArticleSelection)) *OnlineStorePublishableSelection {
+	sel := s.sel.fragment("Article")
+	if fn != nil {
+		fn(&ArticleSelection{sel})
+	}
+
+	return s
+}
+
+// OnBlog selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Blog. __typename is also selected, to tell
+// which type the value is.
+func (s *OnlineStorePublishableSelection) OnBlog(fn func(*BlogSelection)) *OnlineStorePublishableSelection {
+	sel := s.sel.fragment("Blog")
+	if fn != nil {
+		fn(&BlogSelection{sel})
+	}
+
+	return s
+}
+
+// OnCollection selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Collection. __typename is also selected, to tell
+// which type the value is.
+func (s *OnlineStorePublishableSelection) OnCollection(fn func(*CollectionSelection)) *OnlineStorePublishableSelection {
+	sel := s.sel.fragment("Collection")
+	if fn != nil {
+		fn(&CollectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnProduct selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Product. __typename is also selected, to tell
+// which type the value is.
+func (s *OnlineStorePublishableSelection) OnProduct(fn func(*ProductSelection)) *OnlineStorePublishableSelection {
+	sel := s.sel.fragment("Product")
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// OnPage selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Page. __typename is also selected, to tell
+// which type the value is.
+func (s *OnlineStorePublishableSelection) OnPage(fn func(*PageSelection)) *OnlineStorePublishableSelection {
+	sel := s.sel.fragment("Page")
+	if fn != nil {
+		fn(&PageSelection{sel})
+	}
+
+	return s
+}
+
+// ArticleAuthorSelection selects fields of ArticleAuthor for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ArticleAuthorSelection struct {
+	sel *selection
+}
+
+// Bio selects bio.
+func (s *ArticleAuthorSelection) Bio() *ArticleAuthorSelection {
+	s.sel.field("bio")
+
+	return s
+}
+
+// Email selects email.
+func (s *ArticleAuthorSelection) Email() *ArticleAuthorSelection {
+	s.sel.field("email")
+
+	return s
+}
+
+// FirstName selects firstName.
+func (s *ArticleAuthorSelection) FirstName() *ArticleAuthorSelection {
+	s.sel.field("firstName")
+
+	return s
+}
+
+// LastName selects lastName.
+func (s *ArticleAuthorSelection) LastName() *ArticleAuthorSelection {
+	s.sel.field("lastName")
+
+	return s
+}
+
+// Name selects name.
+func (s *ArticleAuthorSelection) Name() *ArticleAuthorSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ArticleAuthorSelection) Typename() *ArticleAuthorSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MetafieldConnectionSelection selects fields of MetafieldConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MetafieldConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *MetafieldConnectionSelection) Edges(fn func(*MetafieldEdgeSelection)) *MetafieldConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *MetafieldConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *MetafieldConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MetafieldConnectionSelection) Typename() *MetafieldConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MetafieldEdgeSelection selects fields of MetafieldEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MetafieldEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *MetafieldEdgeSelection) Cursor() *MetafieldEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *MetafieldEdgeSelection) Node(fn func(*MetafieldSelection)) *MetafieldEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MetafieldEdgeSelection) Typename() *MetafieldEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// PageInfoSelection selects fields of PageInfo for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type PageInfoSelection struct {
+	sel *selection
+}
+
+// HasNextPage selects hasNextPage.
+func (s *PageInfoSelection) HasNextPage() *PageInfoSelection {
+	s.sel.field("hasNextPage")
+
+	return s
+}
+
+// HasPreviousPage selects hasPreviousPage.
+func (s *PageInfoSelection) HasPreviousPage() *PageInfoSelection {
+	s.sel.field("hasPreviousPage")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *PageInfoSelection) Typename() *PageInfoSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SEOSelection selects fields of SEO for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SEOSelection struct {
+	sel *selection
+}
+
+// Description selects description.
+func (s *SEOSelection) Description() *SEOSelection {
+	s.sel.field("description")
+
+	return s
+}
+
+// Title selects title.
+func (s *SEOSelection) Title() *SEOSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SEOSelection) Typename() *SEOSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CollectionSelection selects fields of Collection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CollectionSelection struct {
+	sel *selection
+}
+
+// CollectionDescriptionArgs holds the arguments of Collection.description.
+type CollectionDescriptionArgs struct {
+	// TruncateAt: Truncates string after the given length.
+	TruncateAt *int
+}
+
+// Description selects description, passing args.
+func (s *CollectionSelection) Description(args CollectionDescriptionArgs) *CollectionSelection {
+	f := s.sel.field("description")
+	if args.TruncateAt != nil {
+		f.arg("truncateAt", "Int", *args.TruncateAt)
+	}
+
+	return s
+}
+
+// DescriptionHTML selects descriptionHtml.
+func (s *CollectionSelection) DescriptionHTML() *CollectionSelection {
+	s.sel.field("descriptionHtml")
+
+	return s
+}
+
+// Handle selects handle.
+func (s *CollectionSelection) Handle() *CollectionSelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Id selects id.
+func (s *CollectionSelection) Id() *CollectionSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Image selects image, and the fields of it selected by fn.
+func (s *CollectionSelection) Image(fn func(*ImageSelection)) *CollectionSelection {
+	f := s.sel.field("image")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// CollectionMetafieldArgs holds the arguments of Collection.metafield.
+type CollectionMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *CollectionSelection) Metafield(args CollectionMetafieldArgs, fn func(*MetafieldSelection)) *CollectionSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// CollectionMetafieldsArgs holds the arguments of Collection.metafields.
+type CollectionMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *CollectionSelection) Metafields(args CollectionMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *CollectionSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnlineStoreURL selects onlineStoreUrl.
+func (s *CollectionSelection) OnlineStoreURL() *CollectionSelection {
+	s.sel.field("onlineStoreUrl")
+
+	return s
+}
+
+// CollectionProductsArgs holds the arguments of Collection.products.
+type CollectionProductsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *ProductCollectionSortKeys
+	// Filters: Returns a subset of products matching all product filters.
+	Filters []ProductFilter
+}
+
+// Products selects products, passing args, and the fields of it selected by fn.
+func (s *CollectionSelection) Products(args CollectionProductsArgs, fn func(*ProductConnectionSelection)) *CollectionSelection {
+	f := s.sel.field("products")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "ProductCollectionSortKeys", *args.SortKey)
+	}
+	if args.Filters != nil {
+		f.arg("filters", "[ProductFilter!]", args.Filters)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Title selects title.
+func (s *CollectionSelection) Title() *CollectionSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// UpdatedAt selects updatedAt.
+func (s *CollectionSelection) UpdatedAt() *CollectionSelection {
+	s.sel.field("updatedAt")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CollectionSelection) Typename() *CollectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ImageSelection selects fields of Image for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ImageSelection struct {
+	sel *selection
+}
+
+// AltText selects altText.
+func (s *ImageSelection) AltText() *ImageSelection {
+	s.sel.field("altText")
+
+	return s
+}
+
+// Height selects height.
+func (s *ImageSelection) Height() *ImageSelection {
+	s.sel.field("height")
+
+	return s
+}
+
+// Id selects id.
+func (s *ImageSelection) Id() *ImageSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// OriginalSrc selects originalSrc.
+//
+// Deprecated: Use `url` instead
+func (s *ImageSelection) OriginalSrc() *ImageSelection {
+	s.sel.field("originalSrc")
+
+	return s
+}
+
+// Src selects src.
+//
+// Deprecated: Use `url` instead
+func (s *ImageSelection) Src() *ImageSelection {
+	s.sel.field("src")
+
+	return s
+}
+
+// ImageTransformedSrcArgs holds the arguments of Image.transformedSrc.
+type ImageTransformedSrcArgs struct {
+	// MaxWidth: Image width in pixels between 1 and 5760.
+	MaxWidth *int
+	// MaxHeight: Image height in pixels between 1 and 5760.
+	MaxHeight *int
+	// Crop: Crops the image according to the specified region.
+	Crop *CropRegion
+	// Scale: Image size multiplier for high-resolution retina displays. Must be between 1 and 3.
+	Scale *int
+	// PreferredContentType: Best effort conversion of image into content type (SVG -> PNG, Anything -> JPG, Anything -> WEBP are supported).
+	PreferredContentType *ImageContentType
+}
+
+// TransformedSrc selects transformedSrc, passing args.
+//
+// Deprecated: Use `url(transform:)` instead
+func (s *ImageSelection) TransformedSrc(args ImageTransformedSrcArgs) *ImageSelection {
+	f := s.sel.field("transformedSrc")
+	if args.MaxWidth != nil {
+		f.arg("maxWidth", "Int", *args.MaxWidth)
+	}
+	if args.MaxHeight != nil {
+		f.arg("maxHeight", "Int", *args.MaxHeight)
+	}
+	if args.Crop != nil {
+		f.arg("crop", "CropRegion", *args.Crop)
+	}
+	if args.Scale != nil {
+		f.arg("scale", "Int", *args.Scale)
+	}
+	if args.PreferredContentType != nil {
+		f.arg("preferredContentType", "ImageContentType", *args.PreferredContentType)
+	}
+
+	return s
+}
+
+// ImageURLArgs holds the arguments of Image.url.
+type ImageURLArgs struct {
+	// Transform: A set of options to transform the original image.
+	Transform *ImageTransformInput
+}
+
+// URL selects url, passing args.
+func (s *ImageSelection) URL(args ImageURLArgs) *ImageSelection {
+	f := s.sel.field("url")
+	if args.Transform != nil {
+		f.arg("transform", "ImageTransformInput", *args.Transform)
+	}
+
+	return s
+}
+
+// Width selects width.
+func (s *ImageSelection) Width() *ImageSelection {
+	s.sel.field("width")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ImageSelection) Typename() *ImageSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ProductConnectionSelection selects fields of ProductConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ProductConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *ProductConnectionSelection) Edges(fn func(*ProductEdgeSelection)) *ProductConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// Filters selects filters, and the fields of it selected by fn.
+func (s *ProductConnectionSelection) Filters(fn func(*FilterSelection)) *ProductConnectionSelection {
+	f := s.sel.field("filters")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&FilterSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *ProductConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *ProductConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ProductConnectionSelection) Typename() *ProductConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ProductEdgeSelection selects fields of ProductEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ProductEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *ProductEdgeSelection) Cursor() *ProductEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *ProductEdgeSelection) Node(fn func(*ProductSelection)) *ProductEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ProductEdgeSelection) Typename() *ProductEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ProductSelection selects fields of Product for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ProductSelection struct {
+	sel *selection
+}
+
+// AvailableForSale selects availableForSale.
+func (s *ProductSelection) AvailableForSale() *ProductSelection {
+	s.sel.field("availableForSale")
+
+	return s
+}
+
+// ProductCollectionsArgs holds the arguments of Product.collections.
+type ProductCollectionsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Collections selects collections, passing args, and the fields of it selected by fn.
+func (s *ProductSelection) Collections(args ProductCollectionsArgs, fn func(*CollectionConnectionSelection)) *ProductSelection {
+	f := s.sel.field("collections")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CollectionConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// CompareAtPriceRange selects compareAtPriceRange, and the fields of it selected by fn.
+func (s *ProductSelection) CompareAtPriceRange(fn func(*ProductPriceRangeSelection)) *ProductSelection {
+	f := s.sel.field("compareAtPriceRange")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductPriceRangeSelection{sel})
+	}
+
+	return s
+}
+
+// CreatedAt selects createdAt.
+func (s *ProductSelection) CreatedAt() *ProductSelection {
+	s.sel.field("createdAt")
+
+	return s
+}
+
+// ProductDescriptionArgs holds the arguments of Product.description.
+type ProductDescriptionArgs struct {
+	// TruncateAt: Truncates string after the given length.
+	TruncateAt *int
+}
+
+// Description selects description, passing args.
+func (s *ProductSelection) Description(args ProductDescriptionArgs) *ProductSelection {
+	f := s.sel.field("description")
+	if args.TruncateAt != nil {
+		f.arg("truncateAt", "Int", *args.TruncateAt)
+	}
+
+	return s
+}
+
+// DescriptionHTML selects descriptionHtml.
+func (s *ProductSelection) DescriptionHTML() *ProductSelection {
+	s.sel.field("descriptionHtml")
+
+	return s
+}
+
+// FeaturedImage selects featuredImage, and the fields of it selected by fn.
+func (s *ProductSelection) FeaturedImage(fn func(*ImageSelection)) *ProductSelection {
+	f := s.sel.field("featuredImage")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// Handle selects handle.
+func (s *ProductSelection) Handle() *ProductSelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Id selects id.
+func (s *ProductSelection) Id() *ProductSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// ProductImagesArgs holds the arguments of Product.images.
+type ProductImagesArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *ProductImageSortKeys
+}
+
+// Images selects images, passing args, and the fields of it selected by fn.
+func (s *ProductSelection) Images(args ProductImagesArgs, fn func(*ImageConnectionSelection)) *ProductSelection {
+	f := s.sel.field("images")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "ProductImageSortKeys", *args.SortKey)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// ProductMediaArgs holds the arguments of Product.media.
+type ProductMediaArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *ProductMediaSortKeys
+}
+
+// Media selects media, passing args, and the fields of it selected by fn.
+func (s *ProductSelection) Media(args ProductMediaArgs, fn func(*MediaConnectionSelection)) *ProductSelection {
+	f := s.sel.field("media")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "ProductMediaSortKeys", *args.SortKey)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MediaConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// ProductMetafieldArgs holds the arguments of Product.metafield.
+type ProductMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *ProductSelection) Metafield(args ProductMetafieldArgs, fn func(*MetafieldSelection)) *ProductSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// ProductMetafieldsArgs holds the arguments of Product.metafields.
+type ProductMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *ProductSelection) Metafields(args ProductMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *ProductSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnlineStoreURL selects onlineStoreUrl.
+func (s *ProductSelection) OnlineStoreURL() *ProductSelection {
+	s.sel.field("onlineStoreUrl")
+
+	return s
+}
+
+// ProductOptionsArgs holds the arguments of Product.options.
+type ProductOptionsArgs struct {
+	// First: Truncate the array result to this size.
+	First *int
+}
+
+// Options selects options, passing args, and the fields of it selected by fn.
+func (s *ProductSelection) Options(args ProductOptionsArgs, fn func(*ProductOptionSelection)) *ProductSelection {
+	f := s.sel.field("options")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductOptionSelection{sel})
+	}
+
+	return s
+}
+
+// PriceRange selects priceRange, and the fields of it selected by fn.
+func (s *ProductSelection) PriceRange(fn func(*ProductPriceRangeSelection)) *ProductSelection {
+	f := s.sel.field("priceRange")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductPriceRangeSelection{sel})
+	}
+
+	return s
+}
+
+// ProductType selects productType.
+func (s *ProductSelection) ProductType() *ProductSelection {
+	s.sel.field("productType")
+
+	return s
+}
+
+// PublishedAt selects publishedAt.
+func (s *ProductSelection) PublishedAt() *ProductSelection {
+	s.sel.field("publishedAt")
+
+	return s
+}
+
+// RequiresSellingPlan selects requiresSellingPlan.
+func (s *ProductSelection) RequiresSellingPlan() *ProductSelection {
+	s.sel.field("requiresSellingPlan")
+
+	return s
+}
+
+// ProductSellingPlanGroupsArgs holds the arguments of Product.sellingPlanGroups.
+type ProductSellingPlanGroupsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// SellingPlanGroups selects sellingPlanGroups, passing args, and the fields of it selected by fn.
+func (s *ProductSelection) SellingPlanGroups(args ProductSellingPlanGroupsArgs, fn func(*SellingPlanGroupConnectionSelection)) *ProductSelection {
+	f := s.sel.field("sellingPlanGroups")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanGroupConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// SEO selects seo, and the fields of it selected by fn.
+func (s *ProductSelection) SEO(fn func(*SEOSelection)) *ProductSelection {
+	f := s.sel.field("seo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SEOSelection{sel})
+	}
+
+	return s
+}
+
+// Tags selects tags.
+func (s *ProductSelection) Tags() *ProductSelection {
+	s.sel.field("tags")
+
+	return s
+}
+
+// Title selects title.
+func (s *ProductSelection) Title() *ProductSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// TotalInventory selects totalInventory.
+func (s *ProductSelection) TotalInventory() *ProductSelection {
+	s.sel.field("totalInventory")
+
+	return s
+}
+
+// UpdatedAt selects updatedAt.
+func (s *ProductSelection) UpdatedAt() *ProductSelection {
+	s.sel.field("updatedAt")
+
+	return s
+}
+
+// ProductVariantBySelectedOptionsArgs holds the arguments of Product.variantBySelectedOptions.
+type ProductVariantBySelectedOptionsArgs struct {
+	// SelectedOptions: The input fields used for a selected option.
+	SelectedOptions []SelectedOptionInput
+}
+
+// VariantBySelectedOptions selects variantBySelectedOptions, passing args, and the fields of it selected by fn.
+func (s *ProductSelection) VariantBySelectedOptions(args ProductVariantBySelectedOptionsArgs, fn func(*ProductVariantSelection)) *ProductSelection {
+	f := s.sel.field("variantBySelectedOptions")
+	f.arg("selectedOptions", "[SelectedOptionInput!]!", args.SelectedOptions)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// ProductVariantsArgs holds the arguments of Product.variants.
+type ProductVariantsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *ProductVariantSortKeys
+}
+
+// Variants selects variants, passing args, and the fields of it selected by fn.
+func (s *ProductSelection) Variants(args ProductVariantsArgs, fn func(*ProductVariantConnectionSelection)) *ProductSelection {
+	f := s.sel.field("variants")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "ProductVariantSortKeys", *args.SortKey)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductVariantConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Vendor selects vendor.
+func (s *ProductSelection) Vendor() *ProductSelection {
+	s.sel.field("vendor")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ProductSelection) Typename() *ProductSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CollectionConnectionSelection selects fields of CollectionConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CollectionConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *CollectionConnectionSelection) Edges(fn func(*CollectionEdgeSelection)) *CollectionConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CollectionEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *CollectionConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *CollectionConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CollectionConnectionSelection) Typename() *CollectionConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CollectionEdgeSelection selects fields of CollectionEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CollectionEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *CollectionEdgeSelection) Cursor() *CollectionEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *CollectionEdgeSelection) Node(fn func(*CollectionSelection)) *CollectionEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CollectionSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CollectionEdgeSelection) Typename() *CollectionEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ProductPriceRangeSelection selects fields of ProductPriceRange for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ProductPriceRangeSelection struct {
+	sel *selection
+}
+
+// MaxVariantPrice selects maxVariantPrice, and the fields of it selected by fn.
+func (s *ProductPriceRangeSelection) MaxVariantPrice(fn func(*MoneyV2Selection)) *ProductPriceRangeSelection {
+	f := s.sel.field("maxVariantPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// MinVariantPrice selects minVariantPrice, and the fields of it selected by fn.
+func (s *ProductPriceRangeSelection) MinVariantPrice(fn func(*MoneyV2Selection)) *ProductPriceRangeSelection {
+	f := s.sel.field("minVariantPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ProductPriceRangeSelection) Typename() *ProductPriceRangeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MoneyV2Selection selects fields of MoneyV2 for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MoneyV2Selection struct {
+	sel *selection
+}
+
+// Amount selects amount.
+func (s *MoneyV2Selection) Amount() *MoneyV2Selection {
+	s.sel.field("amount")
+
+	return s
+}
+
+// CurrencyCode selects currencyCode.
+func (s *MoneyV2Selection) CurrencyCode() *MoneyV2Selection {
+	s.sel.field("currencyCode")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MoneyV2Selection) Typename() *MoneyV2Selection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ImageConnectionSelection selects fields of ImageConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ImageConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *ImageConnectionSelection) Edges(fn func(*ImageEdgeSelection)) *ImageConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *ImageConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *ImageConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ImageConnectionSelection) Typename() *ImageConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ImageEdgeSelection selects fields of ImageEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ImageEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *ImageEdgeSelection) Cursor() *ImageEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *ImageEdgeSelection) Node(fn func(*ImageSelection)) *ImageEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ImageEdgeSelection) Typename() *ImageEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MediaConnectionSelection selects fields of MediaConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MediaConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *MediaConnectionSelection) Edges(fn func(*MediaEdgeSelection)) *MediaConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MediaEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *MediaConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *MediaConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MediaConnectionSelection) Typename() *MediaConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MediaEdgeSelection selects fields of MediaEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MediaEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *MediaEdgeSelection) Cursor() *MediaEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *MediaEdgeSelection) Node(fn func(*MediaSelection)) *MediaEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MediaSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MediaEdgeSelection) Typename() *MediaEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MediaSelection selects fields of Media for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MediaSelection struct {
+	sel *selection
+}
+
+// Alt selects alt.
+func (s *MediaSelection) Alt() *MediaSelection {
+	s.sel.field("alt")
+
+	return s
+}
+
+// MediaContentType selects mediaContentType.
+func (s *MediaSelection) MediaContentType() *MediaSelection {
+	s.sel.field("mediaContentType")
+
+	return s
+}
+
+// PreviewImage selects previewImage, and the fields of it selected by fn.
+func (s *MediaSelection) PreviewImage(fn func(*ImageSelection)) *MediaSelection {
+	f := s.sel.field("previewImage")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MediaSelection) Typename() *MediaSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnMediaImage selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a MediaImage. __typename is also selected, to tell
+// which type the value is.
+func (s *MediaSelection) OnMediaImage(fn func(*MediaImageSelection)) *MediaSelection {
+	sel := s.sel.fragment("MediaImage")
+	if fn != nil {
+		fn(&MediaImageSelection{sel})
+	}
+
+	return s
+}
+
+// OnExternalVideo selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ExternalVideo. __typename is also selected, to tell
+// which type the value is.
+func (s *MediaSelection) OnExternalVideo(fn func(*ExternalVideoSelection)) *MediaSelection {
+	sel := s.sel.fragment("ExternalVideo")
+	if fn != nil {
+		fn(&ExternalVideoSelection{sel})
+	}
+
+	return s
+}
+
+// OnModel3D selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Model3D. __typename is also selected, to tell
+// which type the value is.
+func (s *MediaSelection) OnModel3D(fn func(*Model3DSelection)) *MediaSelection {
+	sel := s.sel.fragment("Model3d")
+	if fn != nil {
+		fn(&Model3DSelection{sel})
+	}
+
+	return s
+}
+
+// OnVideo selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Video. __typename is also selected, to tell
+// which type the value is.
+func (s *MediaSelection) OnVideo(fn func(*VideoSelection)) *MediaSelection {
+	sel := s.sel.fragment("Video")
+	if fn != nil {
+		fn(&VideoSelection{sel})
+	}
+
+	return s
+}
+
+// ProductOptionSelection selects fields of ProductOption for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ProductOptionSelection struct {
+	sel *selection
+}
+
+// Id selects id.
+func (s *ProductOptionSelection) Id() *ProductOptionSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Name selects name.
+func (s *ProductOptionSelection) Name() *ProductOptionSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Values selects values.
+func (s *ProductOptionSelection) Values() *ProductOptionSelection {
+	s.sel.field("values")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ProductOptionSelection) Typename() *ProductOptionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanGroupConnectionSelection selects fields of SellingPlanGroupConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanGroupConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *SellingPlanGroupConnectionSelection) Edges(fn func(*SellingPlanGroupEdgeSelection)) *SellingPlanGroupConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanGroupEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *SellingPlanGroupConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *SellingPlanGroupConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanGroupConnectionSelection) Typename() *SellingPlanGroupConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanGroupEdgeSelection selects fields of SellingPlanGroupEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanGroupEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *SellingPlanGroupEdgeSelection) Cursor() *SellingPlanGroupEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *SellingPlanGroupEdgeSelection) Node(fn func(*SellingPlanGroupSelection)) *SellingPlanGroupEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanGroupSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanGroupEdgeSelection) Typename() *SellingPlanGroupEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanGroupSelection selects fields of SellingPlanGroup for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanGroupSelection struct {
+	sel *selection
+}
+
+// AppName selects appName.
+func (s *SellingPlanGroupSelection) AppName() *SellingPlanGroupSelection {
+	s.sel.field("appName")
+
+	return s
+}
+
+// Name selects name.
+func (s *SellingPlanGroupSelection) Name() *SellingPlanGroupSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Options selects options, and the fields of it selected by fn.
+func (s *SellingPlanGroupSelection) Options(fn func(*SellingPlanGroupOptionSelection)) *SellingPlanGroupSelection {
+	f := s.sel.field("options")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanGroupOptionSelection{sel})
+	}
+
+	return s
+}
+
+// SellingPlanGroupSellingPlansArgs holds the arguments of SellingPlanGroup.sellingPlans.
+type SellingPlanGroupSellingPlansArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// SellingPlans selects sellingPlans, passing args, and the fields of it selected by fn.
+func (s *SellingPlanGroupSelection) SellingPlans(args SellingPlanGroupSellingPlansArgs, fn func(*SellingPlanConnectionSelection)) *SellingPlanGroupSelection {
+	f := s.sel.field("sellingPlans")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanGroupSelection) Typename() *SellingPlanGroupSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanGroupOptionSelection selects fields of SellingPlanGroupOption for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanGroupOptionSelection struct {
+	sel *selection
+}
+
+// Name selects name.
+func (s *SellingPlanGroupOptionSelection) Name() *SellingPlanGroupOptionSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Values selects values.
+func (s *SellingPlanGroupOptionSelection) Values() *SellingPlanGroupOptionSelection {
+	s.sel.field("values")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanGroupOptionSelection) Typename() *SellingPlanGroupOptionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanConnectionSelection selects fields of SellingPlanConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *SellingPlanConnectionSelection) Edges(fn func(*SellingPlanEdgeSelection)) *SellingPlanConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *SellingPlanConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *SellingPlanConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanConnectionSelection) Typename() *SellingPlanConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanEdgeSelection selects fields of SellingPlanEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *SellingPlanEdgeSelection) Cursor() *SellingPlanEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *SellingPlanEdgeSelection) Node(fn func(*SellingPlanSelection)) *SellingPlanEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanEdgeSelection) Typename() *SellingPlanEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanSelection selects fields of SellingPlan for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanSelection struct {
+	sel *selection
+}
+
+// Description selects description.
+func (s *SellingPlanSelection) Description() *SellingPlanSelection {
+	s.sel.field("description")
+
+	return s
+}
+
+// Id selects id.
+func (s *SellingPlanSelection) Id() *SellingPlanSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Name selects name.
+func (s *SellingPlanSelection) Name() *SellingPlanSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Options selects options, and the fields of it selected by fn.
+func (s *SellingPlanSelection) Options(fn func(*SellingPlanOptionSelection)) *SellingPlanSelection {
+	f := s.sel.field("options")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanOptionSelection{sel})
+	}
+
+	return s
+}
+
+// PriceAdjustments selects priceAdjustments, and the fields of it selected by fn.
+func (s *SellingPlanSelection) PriceAdjustments(fn func(*SellingPlanPriceAdjustmentSelection)) *SellingPlanSelection {
+	f := s.sel.field("priceAdjustments")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanPriceAdjustmentSelection{sel})
+	}
+
+	return s
+}
+
+// RecurringDeliveries selects recurringDeliveries.
+func (s *SellingPlanSelection) RecurringDeliveries() *SellingPlanSelection {
+	s.sel.field("recurringDeliveries")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanSelection) Typename() *SellingPlanSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanOptionSelection selects fields of SellingPlanOption for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanOptionSelection struct {
+	sel *selection
+}
+
+// Name selects name.
+func (s *SellingPlanOptionSelection) Name() *SellingPlanOptionSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Value selects value.
+func (s *SellingPlanOptionSelection) Value() *SellingPlanOptionSelection {
+	s.sel.field("value")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanOptionSelection) Typename() *SellingPlanOptionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanPriceAdjustmentSelection selects fields of SellingPlanPriceAdjustment for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanPriceAdjustmentSelection struct {
+	sel *selection
+}
+
+// AdjustmentValue selects adjustmentValue, and the fields of it selected by fn.
+func (s *SellingPlanPriceAdjustmentSelection) AdjustmentValue(fn func(*SellingPlanPriceAdjustmentValueSelection)) *SellingPlanPriceAdjustmentSelection {
+	f := s.sel.field("adjustmentValue")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanPriceAdjustmentValueSelection{sel})
+	}
+
+	return s
+}
+
+// OrderCount selects orderCount.
+func (s *SellingPlanPriceAdjustmentSelection) OrderCount() *SellingPlanPriceAdjustmentSelection {
+	s.sel.field("orderCount")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanPriceAdjustmentSelection) Typename() *SellingPlanPriceAdjustmentSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanPriceAdjustmentValueSelection selects fields of SellingPlanPriceAdjustmentValue for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanPriceAdjustmentValueSelection struct {
+	sel *selection
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanPriceAdjustmentValueSelection) Typename() *SellingPlanPriceAdjustmentValueSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnSellingPlanFixedAmountPriceAdjustment selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a SellingPlanFixedAmountPriceAdjustment. __typename is also selected, to tell
+// which type the value is.
+func (s *SellingPlanPriceAdjustmentValueSelection) OnSellingPlanFixedAmountPriceAdjustment(fn func(*SellingPlanFixedAmountPriceAdjustmentSelection)) *SellingPlanPriceAdjustmentValueSelection {
+	sel := s.sel.fragment("SellingPlanFixedAmountPriceAdjustment")
+	if fn != nil {
+		fn(&SellingPlanFixedAmountPriceAdjustmentSelection{sel})
+	}
+
+	return s
+}
+
+// OnSellingPlanFixedPriceAdjustment selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a SellingPlanFixedPriceAdjustment. __typename is also selected, to tell
+// which type the value is.
+func (s *SellingPlanPriceAdjustmentValueSelection) OnSellingPlanFixedPriceAdjustment(fn func(*SellingPlanFixedPriceAdjustmentSelection)) *SellingPlanPriceAdjustmentValueSelection {
+	sel := s.sel.fragment("SellingPlanFixedPriceAdjustment")
+	if fn != nil {
+		fn(&SellingPlanFixedPriceAdjustmentSelection{sel})
+	}
+
+	return s
+}
+
+// OnSellingPlanPercentagePriceAdjustment selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a SellingPlanPercentagePriceAdjustment. __typename is also selected, to tell
+// which type the value is.
+func (s *SellingPlanPriceAdjustmentValueSelection) OnSellingPlanPercentagePriceAdjustment(fn func(*SellingPlanPercentagePriceAdjustmentSelection)) *SellingPlanPriceAdjustmentValueSelection {
+	sel := s.sel.fragment("SellingPlanPercentagePriceAdjustment")
+	if fn != nil {
+		fn(&SellingPlanPercentagePriceAdjustmentSelection{sel})
+	}
+
+	return s
+}
+
+// SellingPlanFixedAmountPriceAdjustmentSelection selects fields of SellingPlanFixedAmountPriceAdjustment for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanFixedAmountPriceAdjustmentSelection struct {
+	sel *selection
+}
+
+// AdjustmentAmount selects adjustmentAmount, and the fields of it selected by fn.
+func (s *SellingPlanFixedAmountPriceAdjustmentSelection) AdjustmentAmount(fn func(*MoneyV2Selection)) *SellingPlanFixedAmountPriceAdjustmentSelection {
+	f := s.sel.field("adjustmentAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanFixedAmountPriceAdjustmentSelection) Typename() *SellingPlanFixedAmountPriceAdjustmentSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanFixedPriceAdjustmentSelection selects fields of SellingPlanFixedPriceAdjustment for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanFixedPriceAdjustmentSelection struct {
+	sel *selection
+}
+
+// Price selects price, and the fields of it selected by fn.
+func (s *SellingPlanFixedPriceAdjustmentSelection) Price(fn func(*MoneyV2Selection)) *SellingPlanFixedPriceAdjustmentSelection {
+	f := s.sel.field("price")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanFixedPriceAdjustmentSelection) Typename() *SellingPlanFixedPriceAdjustmentSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanPercentagePriceAdjustmentSelection selects fields of SellingPlanPercentagePriceAdjustment for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanPercentagePriceAdjustmentSelection struct {
+	sel *selection
+}
+
+// AdjustmentPercentage selects adjustmentPercentage.
+func (s *SellingPlanPercentagePriceAdjustmentSelection) AdjustmentPercentage() *SellingPlanPercentagePriceAdjustmentSelection {
+	s.sel.field("adjustmentPercentage")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanPercentagePriceAdjustmentSelection) Typename() *SellingPlanPercentagePriceAdjustmentSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ProductVariantSelection selects fields of ProductVariant for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ProductVariantSelection struct {
+	sel *selection
+}
+
+// AvailableForSale selects availableForSale.
+func (s *ProductVariantSelection) AvailableForSale() *ProductVariantSelection {
+	s.sel.field("availableForSale")
+
+	return s
+}
+
+// Barcode selects barcode.
+func (s *ProductVariantSelection) Barcode() *ProductVariantSelection {
+	s.sel.field("barcode")
+
+	return s
+}
+
+// CompareAtPrice selects compareAtPrice.
+//
+// Deprecated: Use `compareAtPriceV2` instead
+func (s *ProductVariantSelection) CompareAtPrice() *ProductVariantSelection {
+	s.sel.field("compareAtPrice")
+
+	return s
+}
+
+// CompareAtPriceV2 selects compareAtPriceV2, and the fields of it selected by fn.
+func (s *ProductVariantSelection) CompareAtPriceV2(fn func(*MoneyV2Selection)) *ProductVariantSelection {
+	f := s.sel.field("compareAtPriceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// CurrentlyNotInStock selects currentlyNotInStock.
+func (s *ProductVariantSelection) CurrentlyNotInStock() *ProductVariantSelection {
+	s.sel.field("currentlyNotInStock")
+
+	return s
+}
+
+// Id selects id.
+func (s *ProductVariantSelection) Id() *ProductVariantSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Image selects image, and the fields of it selected by fn.
+func (s *ProductVariantSelection) Image(fn func(*ImageSelection)) *ProductVariantSelection {
+	f := s.sel.field("image")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// ProductVariantMetafieldArgs holds the arguments of ProductVariant.metafield.
+type ProductVariantMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *ProductVariantSelection) Metafield(args ProductVariantMetafieldArgs, fn func(*MetafieldSelection)) *ProductVariantSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// ProductVariantMetafieldsArgs holds the arguments of ProductVariant.metafields.
+type ProductVariantMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *ProductVariantSelection) Metafields(args ProductVariantMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *ProductVariantSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Price selects price.
+//
+// Deprecated: Use `priceV2` instead
+func (s *ProductVariantSelection) Price() *ProductVariantSelection {
+	s.sel.field("price")
+
+	return s
+}
+
+// PriceV2 selects priceV2, and the fields of it selected by fn.
+func (s *ProductVariantSelection) PriceV2(fn func(*MoneyV2Selection)) *ProductVariantSelection {
+	f := s.sel.field("priceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Product selects product, and the fields of it selected by fn.
+func (s *ProductVariantSelection) Product(fn func(*ProductSelection)) *ProductVariantSelection {
+	f := s.sel.field("product")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// QuantityAvailable selects quantityAvailable.
+func (s *ProductVariantSelection) QuantityAvailable() *ProductVariantSelection {
+	s.sel.field("quantityAvailable")
+
+	return s
+}
+
+// RequiresShipping selects requiresShipping.
+func (s *ProductVariantSelection) RequiresShipping() *ProductVariantSelection {
+	s.sel.field("requiresShipping")
+
+	return s
+}
+
+// SelectedOptions selects selectedOptions, and the fields of it selected by fn.
+func (s *ProductVariantSelection) SelectedOptions(fn func(*SelectedOptionSelection)) *ProductVariantSelection {
+	f := s.sel.field("selectedOptions")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SelectedOptionSelection{sel})
+	}
+
+	return s
+}
+
+// ProductVariantSellingPlanAllocationsArgs holds the arguments of ProductVariant.sellingPlanAllocations.
+type ProductVariantSellingPlanAllocationsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// SellingPlanAllocations selects sellingPlanAllocations, passing args, and the fields of it selected by fn.
+func (s *ProductVariantSelection) SellingPlanAllocations(args ProductVariantSellingPlanAllocationsArgs, fn func(*SellingPlanAllocationConnectionSelection)) *ProductVariantSelection {
+	f := s.sel.field("sellingPlanAllocations")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanAllocationConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// SKU selects sku.
+func (s *ProductVariantSelection) SKU() *ProductVariantSelection {
+	s.sel.field("sku")
+
+	return s
+}
+
+// ProductVariantStoreAvailabilityArgs holds the arguments of ProductVariant.storeAvailability.
+type ProductVariantStoreAvailabilityArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// StoreAvailability selects storeAvailability, passing args, and the fields of it selected by fn.
+func (s *ProductVariantSelection) StoreAvailability(args ProductVariantStoreAvailabilityArgs, fn func(*StoreAvailabilityConnectionSelection)) *ProductVariantSelection {
+	f := s.sel.field("storeAvailability")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&StoreAvailabilityConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Title selects title.
+func (s *ProductVariantSelection) Title() *ProductVariantSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// UnitPrice selects unitPrice, and the fields of it selected by fn.
+func (s *ProductVariantSelection) UnitPrice(fn func(*MoneyV2Selection)) *ProductVariantSelection {
+	f := s.sel.field("unitPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// UnitPriceMeasurement selects unitPriceMeasurement, and the fields of it selected by fn.
+func (s *ProductVariantSelection) UnitPriceMeasurement(fn func(*UnitPriceMeasurementSelection)) *ProductVariantSelection {
+	f := s.sel.field("unitPriceMeasurement")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UnitPriceMeasurementSelection{sel})
+	}
+
+	return s
+}
+
+// Weight selects weight.
+func (s *ProductVariantSelection) Weight() *ProductVariantSelection {
+	s.sel.field("weight")
+
+	return s
+}
+
+// WeightUnit selects weightUnit.
+func (s *ProductVariantSelection) WeightUnit() *ProductVariantSelection {
+	s.sel.field("weightUnit")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ProductVariantSelection) Typename() *ProductVariantSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SelectedOptionSelection selects fields of SelectedOption for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SelectedOptionSelection struct {
+	sel *selection
+}
+
+// Name selects name.
+func (s *SelectedOptionSelection) Name() *SelectedOptionSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Value selects value.
+func (s *SelectedOptionSelection) Value() *SelectedOptionSelection {
+	s.sel.field("value")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SelectedOptionSelection) Typename() *SelectedOptionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanAllocationConnectionSelection selects fields of SellingPlanAllocationConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanAllocationConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *SellingPlanAllocationConnectionSelection) Edges(fn func(*SellingPlanAllocationEdgeSelection)) *SellingPlanAllocationConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanAllocationEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *SellingPlanAllocationConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *SellingPlanAllocationConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanAllocationConnectionSelection) Typename() *SellingPlanAllocationConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanAllocationEdgeSelection selects fields of SellingPlanAllocationEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanAllocationEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *SellingPlanAllocationEdgeSelection) Cursor() *SellingPlanAllocationEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *SellingPlanAllocationEdgeSelection) Node(fn func(*SellingPlanAllocationSelection)) *SellingPlanAllocationEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanAllocationEdgeSelection) Typename() *SellingPlanAllocationEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanAllocationSelection selects fields of SellingPlanAllocation for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanAllocationSelection struct {
+	sel *selection
+}
+
+// PriceAdjustments selects priceAdjustments, and the fields of it selected by fn.
+func (s *SellingPlanAllocationSelection) PriceAdjustments(fn func(*SellingPlanAllocationPriceAdjustmentSelection)) *SellingPlanAllocationSelection {
+	f := s.sel.field("priceAdjustments")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanAllocationPriceAdjustmentSelection{sel})
+	}
+
+	return s
+}
+
+// SellingPlan selects sellingPlan, and the fields of it selected by fn.
+func (s *SellingPlanAllocationSelection) SellingPlan(fn func(*SellingPlanSelection)) *SellingPlanAllocationSelection {
+	f := s.sel.field("sellingPlan")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanAllocationSelection) Typename() *SellingPlanAllocationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// SellingPlanAllocationPriceAdjustmentSelection selects fields of SellingPlanAllocationPriceAdjustment for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type SellingPlanAllocationPriceAdjustmentSelection struct {
+	sel *selection
+}
+
+// CompareAtPrice selects compareAtPrice, and the fields of it selected by fn.
+func (s *SellingPlanAllocationPriceAdjustmentSelection) CompareAtPrice(fn func(*MoneyV2Selection)) *SellingPlanAllocationPriceAdjustmentSelection {
+	f := s.sel.field("compareAtPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// PerDeliveryPrice selects perDeliveryPrice, and the fields of it selected by fn.
+func (s *SellingPlanAllocationPriceAdjustmentSelection) PerDeliveryPrice(fn func(*MoneyV2Selection)) *SellingPlanAllocationPriceAdjustmentSelection {
+	f := s.sel.field("perDeliveryPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Price selects price, and the fields of it selected by fn.
+func (s *SellingPlanAllocationPriceAdjustmentSelection) Price(fn func(*MoneyV2Selection)) *SellingPlanAllocationPriceAdjustmentSelection {
+	f := s.sel.field("price")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// UnitPrice selects unitPrice, and the fields of it selected by fn.
+func (s *SellingPlanAllocationPriceAdjustmentSelection) UnitPrice(fn func(*MoneyV2Selection)) *SellingPlanAllocationPriceAdjustmentSelection {
+	f := s.sel.field("unitPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *SellingPlanAllocationPriceAdjustmentSelection) Typename() *SellingPlanAllocationPriceAdjustmentSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// StoreAvailabilityConnectionSelection selects fields of StoreAvailabilityConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type StoreAvailabilityConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *StoreAvailabilityConnectionSelection) Edges(fn func(*StoreAvailabilityEdgeSelection)) *StoreAvailabilityConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&StoreAvailabilityEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *StoreAvailabilityConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *StoreAvailabilityConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *StoreAvailabilityConnectionSelection) Typename() *StoreAvailabilityConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// StoreAvailabilityEdgeSelection selects fields of StoreAvailabilityEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type StoreAvailabilityEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *StoreAvailabilityEdgeSelection) Cursor() *StoreAvailabilityEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *StoreAvailabilityEdgeSelection) Node(fn func(*StoreAvailabilitySelection)) *StoreAvailabilityEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&StoreAvailabilitySelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *StoreAvailabilityEdgeSelection) Typename() *StoreAvailabilityEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// StoreAvailabilitySelection selects fields of StoreAvailability for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type StoreAvailabilitySelection struct {
+	sel *selection
+}
+
+// Available selects available.
+func (s *StoreAvailabilitySelection) Available() *StoreAvailabilitySelection {
+	s.sel.field("available")
+
+	return s
+}
+
+// Location selects location, and the fields of it selected by fn.
+func (s *StoreAvailabilitySelection) Location(fn func(*LocationSelection)) *StoreAvailabilitySelection {
+	f := s.sel.field("location")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&LocationSelection{sel})
+	}
+
+	return s
+}
+
+// PickUpTime selects pickUpTime.
+func (s *StoreAvailabilitySelection) PickUpTime() *StoreAvailabilitySelection {
+	s.sel.field("pickUpTime")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *StoreAvailabilitySelection) Typename() *StoreAvailabilitySelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// LocationSelection selects fields of Location for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type LocationSelection struct {
+	sel *selection
+}
+
+// Address selects address, and the fields of it selected by fn.
+func (s *LocationSelection) Address(fn func(*LocationAddressSelection)) *LocationSelection {
+	f := s.sel.field("address")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&LocationAddressSelection{sel})
+	}
+
+	return s
+}
+
+// Id selects id.
+func (s *LocationSelection) Id() *LocationSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Name selects name.
+func (s *LocationSelection) Name() *LocationSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *LocationSelection) Typename() *LocationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// LocationAddressSelection selects fields of LocationAddress for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type LocationAddressSelection struct {
+	sel *selection
+}
+
+// Address1 selects address1.
+func (s *LocationAddressSelection) Address1() *LocationAddressSelection {
+	s.sel.field("address1")
+
+	return s
+}
+
+// Address2 selects address2.
+func (s *LocationAddressSelection) Address2() *LocationAddressSelection {
+	s.sel.field("address2")
+
+	return s
+}
+
+// City selects city.
+func (s *LocationAddressSelection) City() *LocationAddressSelection {
+	s.sel.field("city")
+
+	return s
+}
+
+// Country selects country.
+func (s *LocationAddressSelection) Country() *LocationAddressSelection {
+	s.sel.field("country")
+
+	return s
+}
+
+// CountryCode selects countryCode.
+func (s *LocationAddressSelection) CountryCode() *LocationAddressSelection {
+	s.sel.field("countryCode")
+
+	return s
+}
+
+// Formatted selects formatted.
+func (s *LocationAddressSelection) Formatted() *LocationAddressSelection {
+	s.sel.field("formatted")
+
+	return s
+}
+
+// Latitude selects latitude.
+func (s *LocationAddressSelection) Latitude() *LocationAddressSelection {
+	s.sel.field("latitude")
+
+	return s
+}
+
+// Longitude selects longitude.
+func (s *LocationAddressSelection) Longitude() *LocationAddressSelection {
+	s.sel.field("longitude")
+
+	return s
+}
+
+// Phone selects phone.
+func (s *LocationAddressSelection) Phone() *LocationAddressSelection {
+	s.sel.field("phone")
+
+	return s
+}
+
+// Province selects province.
+func (s *LocationAddressSelection) Province() *LocationAddressSelection {
+	s.sel.field("province")
+
+	return s
+}
+
+// ProvinceCode selects provinceCode.
+func (s *LocationAddressSelection) ProvinceCode() *LocationAddressSelection {
+	s.sel.field("provinceCode")
+
+	return s
+}
+
+// ZIP selects zip.
+func (s *LocationAddressSelection) ZIP() *LocationAddressSelection {
+	s.sel.field("zip")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *LocationAddressSelection) Typename() *LocationAddressSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// UnitPriceMeasurementSelection selects fields of UnitPriceMeasurement for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type UnitPriceMeasurementSelection struct {
+	sel *selection
+}
+
+// MeasuredType selects measuredType.
+func (s *UnitPriceMeasurementSelection) MeasuredType() *UnitPriceMeasurementSelection {
+	s.sel.field("measuredType")
+
+	return s
+}
+
+// QuantityUnit selects quantityUnit.
+func (s *UnitPriceMeasurementSelection) QuantityUnit() *UnitPriceMeasurementSelection {
+	s.sel.field("quantityUnit")
+
+	return s
+}
+
+// QuantityValue selects quantityValue.
+func (s *UnitPriceMeasurementSelection) QuantityValue() *UnitPriceMeasurementSelection {
+	s.sel.field("quantityValue")
+
+	return s
+}
+
+// ReferenceUnit selects referenceUnit.
+func (s *UnitPriceMeasurementSelection) ReferenceUnit() *UnitPriceMeasurementSelection {
+	s.sel.field("referenceUnit")
+
+	return s
+}
+
+// ReferenceValue selects referenceValue.
+func (s *UnitPriceMeasurementSelection) ReferenceValue() *UnitPriceMeasurementSelection {
+	s.sel.field("referenceValue")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *UnitPriceMeasurementSelection) Typename() *UnitPriceMeasurementSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ProductVariantConnectionSelection selects fields of ProductVariantConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ProductVariantConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *ProductVariantConnectionSelection) Edges(fn func(*ProductVariantEdgeSelection)) *ProductVariantConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductVariantEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *ProductVariantConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *ProductVariantConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ProductVariantConnectionSelection) Typename() *ProductVariantConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ProductVariantEdgeSelection selects fields of ProductVariantEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ProductVariantEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *ProductVariantEdgeSelection) Cursor() *ProductVariantEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *ProductVariantEdgeSelection) Node(fn func(*ProductVariantSelection)) *ProductVariantEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ProductVariantEdgeSelection) Typename() *ProductVariantEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// FilterSelection selects fields of Filter for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type FilterSelection struct {
+	sel *selection
+}
+
+// Id selects id.
+func (s *FilterSelection) Id() *FilterSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Label selects label.
+func (s *FilterSelection) Label() *FilterSelection {
+	s.sel.field("label")
+
+	return s
+}
+
+// Type selects type.
+func (s *FilterSelection) Type() *FilterSelection {
+	s.sel.field("type")
+
+	return s
+}
+
+// Values selects values, and the fields of it selected by fn.
+func (s *FilterSelection) Values(fn func(*FilterValueSelection)) *FilterSelection {
+	f := s.sel.field("values")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&FilterValueSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *FilterSelection) Typename() *FilterSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// FilterValueSelection selects fields of FilterValue for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type FilterValueSelection struct {
+	sel *selection
+}
+
+// Count selects count.
+func (s *FilterValueSelection) Count() *FilterValueSelection {
+	s.sel.field("count")
+
+	return s
+}
+
+// Id selects id.
+func (s *FilterValueSelection) Id() *FilterValueSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Input selects input.
+func (s *FilterValueSelection) Input() *FilterValueSelection {
+	s.sel.field("input")
+
+	return s
+}
+
+// Label selects label.
+func (s *FilterValueSelection) Label() *FilterValueSelection {
+	s.sel.field("label")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *FilterValueSelection) Typename() *FilterValueSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerSelection selects fields of Customer for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerSelection struct {
+	sel *selection
+}
+
+// AcceptsMarketing selects acceptsMarketing.
+func (s *CustomerSelection) AcceptsMarketing() *CustomerSelection {
+	s.sel.field("acceptsMarketing")
+
+	return s
+}
+
+// CustomerAddressesArgs holds the arguments of Customer.addresses.
+type CustomerAddressesArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Addresses selects addresses, passing args, and the fields of it selected by fn.
+func (s *CustomerSelection) Addresses(args CustomerAddressesArgs, fn func(*MailingAddressConnectionSelection)) *CustomerSelection {
+	f := s.sel.field("addresses")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// CreatedAt selects createdAt.
+func (s *CustomerSelection) CreatedAt() *CustomerSelection {
+	s.sel.field("createdAt")
+
+	return s
+}
+
+// DefaultAddress selects defaultAddress, and the fields of it selected by fn.
+func (s *CustomerSelection) DefaultAddress(fn func(*MailingAddressSelection)) *CustomerSelection {
+	f := s.sel.field("defaultAddress")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressSelection{sel})
+	}
+
+	return s
+}
+
+// DisplayName selects displayName.
+func (s *CustomerSelection) DisplayName() *CustomerSelection {
+	s.sel.field("displayName")
+
+	return s
+}
+
+// Email selects email.
+func (s *CustomerSelection) Email() *CustomerSelection {
+	s.sel.field("email")
+
+	return s
+}
+
+// FirstName selects firstName.
+func (s *CustomerSelection) FirstName() *CustomerSelection {
+	s.sel.field("firstName")
+
+	return s
+}
+
+// Id selects id.
+func (s *CustomerSelection) Id() *CustomerSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// LastIncompleteCheckout selects lastIncompleteCheckout, and the fields of it selected by fn.
+func (s *CustomerSelection) LastIncompleteCheckout(fn func(*CheckoutSelection)) *CustomerSelection {
+	f := s.sel.field("lastIncompleteCheckout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// LastName selects lastName.
+func (s *CustomerSelection) LastName() *CustomerSelection {
+	s.sel.field("lastName")
+
+	return s
+}
+
+// CustomerMetafieldArgs holds the arguments of Customer.metafield.
+type CustomerMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *CustomerSelection) Metafield(args CustomerMetafieldArgs, fn func(*MetafieldSelection)) *CustomerSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerMetafieldsArgs holds the arguments of Customer.metafields.
+type CustomerMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *CustomerSelection) Metafields(args CustomerMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *CustomerSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerOrdersArgs holds the arguments of Customer.orders.
+type CustomerOrdersArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+	// SortKey: Sort the underlying list by the given key.
+	SortKey *OrderSortKeys
+	/*
+	   Query: Supported filter parameters:
+	    - `processed_at`
+
+	   See the detailed [search syntax](https://shopify.dev/api/usage/search-syntax)
+	   for more information about using filters.
+	*/
+	Query *string
+}
+
+// Orders selects orders, passing args, and the fields of it selected by fn.
+func (s *CustomerSelection) Orders(args CustomerOrdersArgs, fn func(*OrderConnectionSelection)) *CustomerSelection {
+	f := s.sel.field("orders")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+	if args.SortKey != nil {
+		f.arg("sortKey", "OrderSortKeys", *args.SortKey)
+	}
+	if args.Query != nil {
+		f.arg("query", "String", *args.Query)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&OrderConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Phone selects phone.
+func (s *CustomerSelection) Phone() *CustomerSelection {
+	s.sel.field("phone")
+
+	return s
+}
+
+// Tags selects tags.
+func (s *CustomerSelection) Tags() *CustomerSelection {
+	s.sel.field("tags")
+
+	return s
+}
+
+// UpdatedAt selects updatedAt.
+func (s *CustomerSelection) UpdatedAt() *CustomerSelection {
+	s.sel.field("updatedAt")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerSelection) Typename() *CustomerSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MailingAddressConnectionSelection selects fields of MailingAddressConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MailingAddressConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *MailingAddressConnectionSelection) Edges(fn func(*MailingAddressEdgeSelection)) *MailingAddressConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *MailingAddressConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *MailingAddressConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MailingAddressConnectionSelection) Typename() *MailingAddressConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MailingAddressEdgeSelection selects fields of MailingAddressEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MailingAddressEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *MailingAddressEdgeSelection) Cursor() *MailingAddressEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *MailingAddressEdgeSelection) Node(fn func(*MailingAddressSelection)) *MailingAddressEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MailingAddressEdgeSelection) Typename() *MailingAddressEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MailingAddressSelection selects fields of MailingAddress for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MailingAddressSelection struct {
+	sel *selection
+}
+
+// Address1 selects address1.
+func (s *MailingAddressSelection) Address1() *MailingAddressSelection {
+	s.sel.field("address1")
+
+	return s
+}
+
+// Address2 selects address2.
+func (s *MailingAddressSelection) Address2() *MailingAddressSelection {
+	s.sel.field("address2")
+
+	return s
+}
+
+// City selects city.
+func (s *MailingAddressSelection) City() *MailingAddressSelection {
+	s.sel.field("city")
+
+	return s
+}
+
+// Company selects company.
+func (s *MailingAddressSelection) Company() *MailingAddressSelection {
+	s.sel.field("company")
+
+	return s
+}
+
+// Country selects country.
+func (s *MailingAddressSelection) Country() *MailingAddressSelection {
+	s.sel.field("country")
+
+	return s
+}
+
+// CountryCode selects countryCode.
+//
+// Deprecated: Use `countryCodeV2` instead
+func (s *MailingAddressSelection) CountryCode() *MailingAddressSelection {
+	s.sel.field("countryCode")
+
+	return s
+}
+
+// CountryCodeV2 selects countryCodeV2.
+func (s *MailingAddressSelection) CountryCodeV2() *MailingAddressSelection {
+	s.sel.field("countryCodeV2")
+
+	return s
+}
+
+// FirstName selects firstName.
+func (s *MailingAddressSelection) FirstName() *MailingAddressSelection {
+	s.sel.field("firstName")
+
+	return s
+}
+
+// MailingAddressFormattedArgs holds the arguments of MailingAddress.formatted.
+type MailingAddressFormattedArgs struct {
+	// WithName: Whether to include the customer's name in the formatted address.
+	WithName *bool
+	// WithCompany: Whether to include the customer's company in the formatted address.
+	WithCompany *bool
+}
+
+// Formatted selects formatted, passing args.
+func (s *MailingAddressSelection) Formatted(args MailingAddressFormattedArgs) *MailingAddressSelection {
+	f := s.sel.field("formatted")
+	if args.WithName != nil {
+		f.arg("withName", "Boolean", *args.WithName)
+	}
+	if args.WithCompany != nil {
+		f.arg("withCompany", "Boolean", *args.WithCompany)
+	}
+
+	return s
+}
+
+// FormattedArea selects formattedArea.
+func (s *MailingAddressSelection) FormattedArea() *MailingAddressSelection {
+	s.sel.field("formattedArea")
+
+	return s
+}
+
+// Id selects id.
+func (s *MailingAddressSelection) Id() *MailingAddressSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// LastName selects lastName.
+func (s *MailingAddressSelection) LastName() *MailingAddressSelection {
+	s.sel.field("lastName")
+
+	return s
+}
+
+// Latitude selects latitude.
+func (s *MailingAddressSelection) Latitude() *MailingAddressSelection {
+	s.sel.field("latitude")
+
+	return s
+}
+
+// Longitude selects longitude.
+func (s *MailingAddressSelection) Longitude() *MailingAddressSelection {
+	s.sel.field("longitude")
+
+	return s
+}
+
+// Name selects name.
+func (s *MailingAddressSelection) Name() *MailingAddressSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Phone selects phone.
+func (s *MailingAddressSelection) Phone() *MailingAddressSelection {
+	s.sel.field("phone")
+
+	return s
+}
+
+// Province selects province.
+func (s *MailingAddressSelection) Province() *MailingAddressSelection {
+	s.sel.field("province")
+
+	return s
+}
+
+// ProvinceCode selects provinceCode.
+func (s *MailingAddressSelection) ProvinceCode() *MailingAddressSelection {
+	s.sel.field("provinceCode")
+
+	return s
+}
+
+// ZIP selects zip.
+func (s *MailingAddressSelection) ZIP() *MailingAddressSelection {
+	s.sel.field("zip")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MailingAddressSelection) Typename() *MailingAddressSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutSelection selects fields of Checkout for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutSelection struct {
+	sel *selection
+}
+
+// AppliedGiftCards selects appliedGiftCards, and the fields of it selected by fn.
+func (s *CheckoutSelection) AppliedGiftCards(fn func(*AppliedGiftCardSelection)) *CheckoutSelection {
+	f := s.sel.field("appliedGiftCards")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&AppliedGiftCardSelection{sel})
+	}
+
+	return s
+}
+
+// AvailableShippingRates selects availableShippingRates, and the fields of it selected by fn.
+func (s *CheckoutSelection) AvailableShippingRates(fn func(*AvailableShippingRatesSelection)) *CheckoutSelection {
+	f := s.sel.field("availableShippingRates")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&AvailableShippingRatesSelection{sel})
+	}
+
+	return s
+}
+
+// BuyerIdentity selects buyerIdentity, and the fields of it selected by fn.
+func (s *CheckoutSelection) BuyerIdentity(fn func(*CheckoutBuyerIdentitySelection)) *CheckoutSelection {
+	f := s.sel.field("buyerIdentity")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutBuyerIdentitySelection{sel})
+	}
+
+	return s
+}
+
+// CompletedAt selects completedAt.
+func (s *CheckoutSelection) CompletedAt() *CheckoutSelection {
+	s.sel.field("completedAt")
+
+	return s
+}
+
+// CreatedAt selects createdAt.
+func (s *CheckoutSelection) CreatedAt() *CheckoutSelection {
+	s.sel.field("createdAt")
+
+	return s
+}
+
+// CurrencyCode selects currencyCode.
+func (s *CheckoutSelection) CurrencyCode() *CheckoutSelection {
+	s.sel.field("currencyCode")
+
+	return s
+}
+
+// CustomAttributes selects customAttributes, and the fields of it selected by fn.
+func (s *CheckoutSelection) CustomAttributes(fn func(*AttributeSelection)) *CheckoutSelection {
+	f := s.sel.field("customAttributes")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&AttributeSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutDiscountApplicationsArgs holds the arguments of Checkout.discountApplications.
+type CheckoutDiscountApplicationsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// DiscountApplications selects discountApplications, passing args, and the fields of it selected by fn.
+func (s *CheckoutSelection) DiscountApplications(args CheckoutDiscountApplicationsArgs, fn func(*DiscountApplicationConnectionSelection)) *CheckoutSelection {
+	f := s.sel.field("discountApplications")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountApplicationConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Email selects email.
+func (s *CheckoutSelection) Email() *CheckoutSelection {
+	s.sel.field("email")
+
+	return s
+}
+
+// Id selects id.
+func (s *CheckoutSelection) Id() *CheckoutSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// CheckoutLineItemsArgs holds the arguments of Checkout.lineItems.
+type CheckoutLineItemsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// LineItems selects lineItems, passing args, and the fields of it selected by fn.
+func (s *CheckoutSelection) LineItems(args CheckoutLineItemsArgs, fn func(*CheckoutLineItemConnectionSelection)) *CheckoutSelection {
+	f := s.sel.field("lineItems")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutLineItemConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// LineItemsSubtotalPrice selects lineItemsSubtotalPrice, and the fields of it selected by fn.
+func (s *CheckoutSelection) LineItemsSubtotalPrice(fn func(*MoneyV2Selection)) *CheckoutSelection {
+	f := s.sel.field("lineItemsSubtotalPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Note selects note.
+func (s *CheckoutSelection) Note() *CheckoutSelection {
+	s.sel.field("note")
+
+	return s
+}
+
+// Order selects order, and the fields of it selected by fn.
+func (s *CheckoutSelection) Order(fn func(*OrderSelection)) *CheckoutSelection {
+	f := s.sel.field("order")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&OrderSelection{sel})
+	}
+
+	return s
+}
+
+// OrderStatusURL selects orderStatusUrl.
+func (s *CheckoutSelection) OrderStatusURL() *CheckoutSelection {
+	s.sel.field("orderStatusUrl")
+
+	return s
+}
+
+// PaymentDue selects paymentDue.
+//
+// Deprecated: Use `paymentDueV2` instead
+func (s *CheckoutSelection) PaymentDue() *CheckoutSelection {
+	s.sel.field("paymentDue")
+
+	return s
+}
+
+// PaymentDueV2 selects paymentDueV2, and the fields of it selected by fn.
+func (s *CheckoutSelection) PaymentDueV2(fn func(*MoneyV2Selection)) *CheckoutSelection {
+	f := s.sel.field("paymentDueV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Ready selects ready.
+func (s *CheckoutSelection) Ready() *CheckoutSelection {
+	s.sel.field("ready")
+
+	return s
+}
+
+// RequiresShipping selects requiresShipping.
+func (s *CheckoutSelection) RequiresShipping() *CheckoutSelection {
+	s.sel.field("requiresShipping")
+
+	return s
+}
+
+// ShippingAddress selects shippingAddress, and the fields of it selected by fn.
+func (s *CheckoutSelection) ShippingAddress(fn func(*MailingAddressSelection)) *CheckoutSelection {
+	f := s.sel.field("shippingAddress")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressSelection{sel})
+	}
+
+	return s
+}
+
+// ShippingDiscountAllocations selects shippingDiscountAllocations, and the fields of it selected by fn.
+func (s *CheckoutSelection) ShippingDiscountAllocations(fn func(*DiscountAllocationSelection)) *CheckoutSelection {
+	f := s.sel.field("shippingDiscountAllocations")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// ShippingLine selects shippingLine, and the fields of it selected by fn.
+func (s *CheckoutSelection) ShippingLine(fn func(*ShippingRateSelection)) *CheckoutSelection {
+	f := s.sel.field("shippingLine")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ShippingRateSelection{sel})
+	}
+
+	return s
+}
+
+// SubtotalPrice selects subtotalPrice.
+//
+// Deprecated: Use `subtotalPriceV2` instead
+func (s *CheckoutSelection) SubtotalPrice() *CheckoutSelection {
+	s.sel.field("subtotalPrice")
+
+	return s
+}
+
+// SubtotalPriceV2 selects subtotalPriceV2, and the fields of it selected by fn.
+func (s *CheckoutSelection) SubtotalPriceV2(fn func(*MoneyV2Selection)) *CheckoutSelection {
+	f := s.sel.field("subtotalPriceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TaxExempt selects taxExempt.
+func (s *CheckoutSelection) TaxExempt() *CheckoutSelection {
+	s.sel.field("taxExempt")
+
+	return s
+}
+
+// TaxesIncluded selects taxesIncluded.
+func (s *CheckoutSelection) TaxesIncluded() *CheckoutSelection {
+	s.sel.field("taxesIncluded")
+
+	return s
+}
+
+// TotalDuties selects totalDuties, and the fields of it selected by fn.
+func (s *CheckoutSelection) TotalDuties(fn func(*MoneyV2Selection)) *CheckoutSelection {
+	f := s.sel.field("totalDuties")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalPrice selects totalPrice.
+//
+// Deprecated: Use `totalPriceV2` instead
+func (s *CheckoutSelection) TotalPrice() *CheckoutSelection {
+	s.sel.field("totalPrice")
+
+	return s
+}
+
+// TotalPriceV2 selects totalPriceV2, and the fields of it selected by fn.
+func (s *CheckoutSelection) TotalPriceV2(fn func(*MoneyV2Selection)) *CheckoutSelection {
+	f := s.sel.field("totalPriceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalTax selects totalTax.
+//
+// Deprecated: Use `totalTaxV2` instead
+func (s *CheckoutSelection) TotalTax() *CheckoutSelection {
+	s.sel.field("totalTax")
+
+	return s
+}
+
+// TotalTaxV2 selects totalTaxV2, and the fields of it selected by fn.
+func (s *CheckoutSelection) TotalTaxV2(fn func(*MoneyV2Selection)) *CheckoutSelection {
+	f := s.sel.field("totalTaxV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// UpdatedAt selects updatedAt.
+func (s *CheckoutSelection) UpdatedAt() *CheckoutSelection {
+	s.sel.field("updatedAt")
+
+	return s
+}
+
+// WebURL selects webUrl.
+func (s *CheckoutSelection) WebURL() *CheckoutSelection {
+	s.sel.field("webUrl")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutSelection) Typename() *CheckoutSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// AppliedGiftCardSelection selects fields of AppliedGiftCard for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type AppliedGiftCardSelection struct {
+	sel *selection
+}
+
+// AmountUsed selects amountUsed.
+//
+// Deprecated: Use `amountUsedV2` instead
+func (s *AppliedGiftCardSelection) AmountUsed() *AppliedGiftCardSelection {
+	s.sel.field("amountUsed")
+
+	return s
+}
+
+// AmountUsedV2 selects amountUsedV2, and the fields of it selected by fn.
+func (s *AppliedGiftCardSelection) AmountUsedV2(fn func(*MoneyV2Selection)) *AppliedGiftCardSelection {
+	f := s.sel.field("amountUsedV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Balance selects balance.
+//
+// Deprecated: Use `balanceV2` instead
+func (s *AppliedGiftCardSelection) Balance() *AppliedGiftCardSelection {
+	s.sel.field("balance")
+
+	return s
+}
+
+// BalanceV2 selects balanceV2, and the fields of it selected by fn.
+func (s *AppliedGiftCardSelection) BalanceV2(fn func(*MoneyV2Selection)) *AppliedGiftCardSelection {
+	f := s.sel.field("balanceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Id selects id.
+func (s *AppliedGiftCardSelection) Id() *AppliedGiftCardSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// LastCharacters selects lastCharacters.
+func (s *AppliedGiftCardSelection) LastCharacters() *AppliedGiftCardSelection {
+	s.sel.field("lastCharacters")
+
+	return s
+}
+
+// PresentmentAmountUsed selects presentmentAmountUsed, and the fields of it selected by fn.
+func (s *AppliedGiftCardSelection) PresentmentAmountUsed(fn func(*MoneyV2Selection)) *AppliedGiftCardSelection {
+	f := s.sel.field("presentmentAmountUsed")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *AppliedGiftCardSelection) Typename() *AppliedGiftCardSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// AvailableShippingRatesSelection selects fields of AvailableShippingRates for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type AvailableShippingRatesSelection struct {
+	sel *selection
+}
+
+// Ready selects ready.
+func (s *AvailableShippingRatesSelection) Ready() *AvailableShippingRatesSelection {
+	s.sel.field("ready")
+
+	return s
+}
+
+// ShippingRates selects shippingRates, and the fields of it selected by fn.
+func (s *AvailableShippingRatesSelection) ShippingRates(fn func(*ShippingRateSelection)) *AvailableShippingRatesSelection {
+	f := s.sel.field("shippingRates")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ShippingRateSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *AvailableShippingRatesSelection) Typename() *AvailableShippingRatesSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ShippingRateSelection selects fields of ShippingRate for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ShippingRateSelection struct {
+	sel *selection
+}
+
+// Handle selects handle.
+func (s *ShippingRateSelection) Handle() *ShippingRateSelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Price selects price.
+//
+// Deprecated: Use `priceV2` instead
+func (s *ShippingRateSelection) Price() *ShippingRateSelection {
+	s.sel.field("price")
+
+	return s
+}
+
+// PriceV2 selects priceV2, and the fields of it selected by fn.
+func (s *ShippingRateSelection) PriceV2(fn func(*MoneyV2Selection)) *ShippingRateSelection {
+	f := s.sel.field("priceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Title selects title.
+func (s *ShippingRateSelection) Title() *ShippingRateSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ShippingRateSelection) Typename() *ShippingRateSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutBuyerIdentitySelection selects fields of CheckoutBuyerIdentity for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutBuyerIdentitySelection struct {
+	sel *selection
+}
+
+// CountryCode selects countryCode.
+func (s *CheckoutBuyerIdentitySelection) CountryCode() *CheckoutBuyerIdentitySelection {
+	s.sel.field("countryCode")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutBuyerIdentitySelection) Typename() *CheckoutBuyerIdentitySelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// AttributeSelection selects fields of Attribute for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type AttributeSelection struct {
+	sel *selection
+}
+
+// Key selects key.
+func (s *AttributeSelection) Key() *AttributeSelection {
+	s.sel.field("key")
+
+	return s
+}
+
+// Value selects value.
+func (s *AttributeSelection) Value() *AttributeSelection {
+	s.sel.field("value")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *AttributeSelection) Typename() *AttributeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// DiscountApplicationConnectionSelection selects fields of DiscountApplicationConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type DiscountApplicationConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *DiscountApplicationConnectionSelection) Edges(fn func(*DiscountApplicationEdgeSelection)) *DiscountApplicationConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountApplicationEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *DiscountApplicationConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *DiscountApplicationConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *DiscountApplicationConnectionSelection) Typename() *DiscountApplicationConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// DiscountApplicationEdgeSelection selects fields of DiscountApplicationEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type DiscountApplicationEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *DiscountApplicationEdgeSelection) Cursor() *DiscountApplicationEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *DiscountApplicationEdgeSelection) Node(fn func(*DiscountApplicationSelection)) *DiscountApplicationEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountApplicationSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *DiscountApplicationEdgeSelection) Typename() *DiscountApplicationEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// DiscountApplicationSelection selects fields of DiscountApplication for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type DiscountApplicationSelection struct {
+	sel *selection
+}
+
+// AllocationMethod selects allocationMethod.
+func (s *DiscountApplicationSelection) AllocationMethod() *DiscountApplicationSelection {
+	s.sel.field("allocationMethod")
+
+	return s
+}
+
+// TargetSelection selects targetSelection.
+func (s *DiscountApplicationSelection) TargetSelection() *DiscountApplicationSelection {
+	s.sel.field("targetSelection")
+
+	return s
+}
+
+// TargetType selects targetType.
+func (s *DiscountApplicationSelection) TargetType() *DiscountApplicationSelection {
+	s.sel.field("targetType")
+
+	return s
+}
+
+// Value selects value, and the fields of it selected by fn.
+func (s *DiscountApplicationSelection) Value(fn func(*PricingValueSelection)) *DiscountApplicationSelection {
+	f := s.sel.field("value")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PricingValueSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *DiscountApplicationSelection) Typename() *DiscountApplicationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnAutomaticDiscountApplication selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a AutomaticDiscountApplication. __typename is also selected, to tell
+// which type the value is.
+func (s *DiscountApplicationSelection) OnAutomaticDiscountApplication(fn func(*AutomaticDiscountApplicationSelection)) *DiscountApplicationSelection {
+	sel := s.sel.fragment("AutomaticDiscountApplication")
+	if fn != nil {
+		fn(&AutomaticDiscountApplicationSelection{sel})
+	}
+
+	return s
+}
+
+// OnDiscountCodeApplication selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a DiscountCodeApplication. __typename is also selected, to tell
+// which type the value is.
+func (s *DiscountApplicationSelection) OnDiscountCodeApplication(fn func(*DiscountCodeApplicationSelection)) *DiscountApplicationSelection {
+	sel := s.sel.fragment("DiscountCodeApplication")
+	if fn != nil {
+		fn(&DiscountCodeApplicationSelection{sel})
+	}
+
+	return s
+}
+
+// OnManualDiscountApplication selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ManualDiscountApplication. __typename is also selected, to tell
+// which type the value is.
+func (s *DiscountApplicationSelection) OnManualDiscountApplication(fn func(*ManualDiscountApplicationSelection)) *DiscountApplicationSelection {
+	sel := s.sel.fragment("ManualDiscountApplication")
+	if fn != nil {
+		fn(&ManualDiscountApplicationSelection{sel})
+	}
+
+	return s
+}
+
+// OnScriptDiscountApplication selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ScriptDiscountApplication. __typename is also selected, to tell
+// which type the value is.
+func (s *DiscountApplicationSelection) OnScriptDiscountApplication(fn func(*ScriptDiscountApplicationSelection)) *DiscountApplicationSelection {
+	sel := s.sel.fragment("ScriptDiscountApplication")
+	if fn != nil {
+		fn(&ScriptDiscountApplicationSelection{sel})
+	}
+
+	return s
+}
+
+// PricingValueSelection selects fields of PricingValue for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type PricingValueSelection struct {
+	sel *selection
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *PricingValueSelection) Typename() *PricingValueSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnMoneyV2 selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a MoneyV2. __typename is also selected, to tell
+// which type the value is.
+func (s *PricingValueSelection) OnMoneyV2(fn func(*MoneyV2Selection)) *PricingValueSelection {
+	sel := s.sel.fragment("MoneyV2")
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// OnPricingPercentageValue selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a PricingPercentageValue. __typename is also selected, to tell
+// which type the value is.
+func (s *PricingValueSelection) OnPricingPercentageValue(fn func(*PricingPercentageValueSelection)) *PricingValueSelection {
+	sel := s.sel.fragment("PricingPercentageValue")
+	if fn != nil {
+		fn(&PricingPercentageValueSelection{sel})
+	}
+
+	return s
+}
+
+// PricingPercentageValueSelection selects fields of PricingPercentageValue for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type PricingPercentageValueSelection struct {
+	sel *selection
+}
+
+// Percentage selects percentage.
+func (s *PricingPercentageValueSelection) Percentage() *PricingPercentageValueSelection {
+	s.sel.field("percentage")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *PricingPercentageValueSelection) Typename() *PricingPercentageValueSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutLineItemConnectionSelection selects fields of CheckoutLineItemConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutLineItemConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *CheckoutLineItemConnectionSelection) Edges(fn func(*CheckoutLineItemEdgeSelection)) *CheckoutLineItemConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutLineItemEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *CheckoutLineItemConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *CheckoutLineItemConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutLineItemConnectionSelection) Typename() *CheckoutLineItemConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutLineItemEdgeSelection selects fields of CheckoutLineItemEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutLineItemEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *CheckoutLineItemEdgeSelection) Cursor() *CheckoutLineItemEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *CheckoutLineItemEdgeSelection) Node(fn func(*CheckoutLineItemSelection)) *CheckoutLineItemEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutLineItemSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutLineItemEdgeSelection) Typename() *CheckoutLineItemEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutLineItemSelection selects fields of CheckoutLineItem for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutLineItemSelection struct {
+	sel *selection
+}
+
+// CustomAttributes selects customAttributes, and the fields of it selected by fn.
+func (s *CheckoutLineItemSelection) CustomAttributes(fn func(*AttributeSelection)) *CheckoutLineItemSelection {
+	f := s.sel.field("customAttributes")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&AttributeSelection{sel})
+	}
+
+	return s
+}
+
+// DiscountAllocations selects discountAllocations, and the fields of it selected by fn.
+func (s *CheckoutLineItemSelection) DiscountAllocations(fn func(*DiscountAllocationSelection)) *CheckoutLineItemSelection {
+	f := s.sel.field("discountAllocations")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// Id selects id.
+func (s *CheckoutLineItemSelection) Id() *CheckoutLineItemSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Quantity selects quantity.
+func (s *CheckoutLineItemSelection) Quantity() *CheckoutLineItemSelection {
+	s.sel.field("quantity")
+
+	return s
+}
+
+// Title selects title.
+func (s *CheckoutLineItemSelection) Title() *CheckoutLineItemSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// UnitPrice selects unitPrice, and the fields of it selected by fn.
+func (s *CheckoutLineItemSelection) UnitPrice(fn func(*MoneyV2Selection)) *CheckoutLineItemSelection {
+	f := s.sel.field("unitPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Variant selects variant, and the fields of it selected by fn.
+func (s *CheckoutLineItemSelection) Variant(fn func(*ProductVariantSelection)) *CheckoutLineItemSelection {
+	f := s.sel.field("variant")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutLineItemSelection) Typename() *CheckoutLineItemSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// DiscountAllocationSelection selects fields of DiscountAllocation for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type DiscountAllocationSelection struct {
+	sel *selection
+}
+
+// AllocatedAmount selects allocatedAmount, and the fields of it selected by fn.
+func (s *DiscountAllocationSelection) AllocatedAmount(fn func(*MoneyV2Selection)) *DiscountAllocationSelection {
+	f := s.sel.field("allocatedAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// DiscountApplication selects discountApplication, and the fields of it selected by fn.
+func (s *DiscountAllocationSelection) DiscountApplication(fn func(*DiscountApplicationSelection)) *DiscountAllocationSelection {
+	f := s.sel.field("discountApplication")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountApplicationSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *DiscountAllocationSelection) Typename() *DiscountAllocationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OrderSelection selects fields of Order for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type OrderSelection struct {
+	sel *selection
+}
+
+// CancelReason selects cancelReason.
+func (s *OrderSelection) CancelReason() *OrderSelection {
+	s.sel.field("cancelReason")
+
+	return s
+}
+
+// CanceledAt selects canceledAt.
+func (s *OrderSelection) CanceledAt() *OrderSelection {
+	s.sel.field("canceledAt")
+
+	return s
+}
+
+// CurrencyCode selects currencyCode.
+func (s *OrderSelection) CurrencyCode() *OrderSelection {
+	s.sel.field("currencyCode")
+
+	return s
+}
+
+// CurrentSubtotalPrice selects currentSubtotalPrice, and the fields of it selected by fn.
+func (s *OrderSelection) CurrentSubtotalPrice(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("currentSubtotalPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// CurrentTotalDuties selects currentTotalDuties, and the fields of it selected by fn.
+func (s *OrderSelection) CurrentTotalDuties(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("currentTotalDuties")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// CurrentTotalPrice selects currentTotalPrice, and the fields of it selected by fn.
+func (s *OrderSelection) CurrentTotalPrice(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("currentTotalPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// CurrentTotalTax selects currentTotalTax, and the fields of it selected by fn.
+func (s *OrderSelection) CurrentTotalTax(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("currentTotalTax")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// CustomerLocale selects customerLocale.
+func (s *OrderSelection) CustomerLocale() *OrderSelection {
+	s.sel.field("customerLocale")
+
+	return s
+}
+
+// CustomerURL selects customerUrl.
+func (s *OrderSelection) CustomerURL() *OrderSelection {
+	s.sel.field("customerUrl")
+
+	return s
+}
+
+// OrderDiscountApplicationsArgs holds the arguments of Order.discountApplications.
+type OrderDiscountApplicationsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// DiscountApplications selects discountApplications, passing args, and the fields of it selected by fn.
+func (s *OrderSelection) DiscountApplications(args OrderDiscountApplicationsArgs, fn func(*DiscountApplicationConnectionSelection)) *OrderSelection {
+	f := s.sel.field("discountApplications")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountApplicationConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Edited selects edited.
+func (s *OrderSelection) Edited() *OrderSelection {
+	s.sel.field("edited")
+
+	return s
+}
+
+// Email selects email.
+func (s *OrderSelection) Email() *OrderSelection {
+	s.sel.field("email")
+
+	return s
+}
+
+// FinancialStatus selects financialStatus.
+func (s *OrderSelection) FinancialStatus() *OrderSelection {
+	s.sel.field("financialStatus")
+
+	return s
+}
+
+// FulfillmentStatus selects fulfillmentStatus.
+func (s *OrderSelection) FulfillmentStatus() *OrderSelection {
+	s.sel.field("fulfillmentStatus")
+
+	return s
+}
+
+// Id selects id.
+func (s *OrderSelection) Id() *OrderSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// OrderLineItemsArgs holds the arguments of Order.lineItems.
+type OrderLineItemsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// LineItems selects lineItems, passing args, and the fields of it selected by fn.
+func (s *OrderSelection) LineItems(args OrderLineItemsArgs, fn func(*OrderLineItemConnectionSelection)) *OrderSelection {
+	f := s.sel.field("lineItems")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&OrderLineItemConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// OrderMetafieldArgs holds the arguments of Order.metafield.
+type OrderMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *OrderSelection) Metafield(args OrderMetafieldArgs, fn func(*MetafieldSelection)) *OrderSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// OrderMetafieldsArgs holds the arguments of Order.metafields.
+type OrderMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *OrderSelection) Metafields(args OrderMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *OrderSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Name selects name.
+func (s *OrderSelection) Name() *OrderSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// OrderNumber selects orderNumber.
+func (s *OrderSelection) OrderNumber() *OrderSelection {
+	s.sel.field("orderNumber")
+
+	return s
+}
+
+// OriginalTotalDuties selects originalTotalDuties, and the fields of it selected by fn.
+func (s *OrderSelection) OriginalTotalDuties(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("originalTotalDuties")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// OriginalTotalPrice selects originalTotalPrice, and the fields of it selected by fn.
+func (s *OrderSelection) OriginalTotalPrice(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("originalTotalPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Phone selects phone.
+func (s *OrderSelection) Phone() *OrderSelection {
+	s.sel.field("phone")
+
+	return s
+}
+
+// ProcessedAt selects processedAt.
+func (s *OrderSelection) ProcessedAt() *OrderSelection {
+	s.sel.field("processedAt")
+
+	return s
+}
+
+// ShippingAddress selects shippingAddress, and the fields of it selected by fn.
+func (s *OrderSelection) ShippingAddress(fn func(*MailingAddressSelection)) *OrderSelection {
+	f := s.sel.field("shippingAddress")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressSelection{sel})
+	}
+
+	return s
+}
+
+// ShippingDiscountAllocations selects shippingDiscountAllocations, and the fields of it selected by fn.
+func (s *OrderSelection) ShippingDiscountAllocations(fn func(*DiscountAllocationSelection)) *OrderSelection {
+	f := s.sel.field("shippingDiscountAllocations")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// StatusURL selects statusUrl.
+func (s *OrderSelection) StatusURL() *OrderSelection {
+	s.sel.field("statusUrl")
+
+	return s
+}
+
+// SubtotalPrice selects subtotalPrice.
+//
+// Deprecated: Use `subtotalPriceV2` instead
+func (s *OrderSelection) SubtotalPrice() *OrderSelection {
+	s.sel.field("subtotalPrice")
+
+	return s
+}
+
+// SubtotalPriceV2 selects subtotalPriceV2, and the fields of it selected by fn.
+func (s *OrderSelection) SubtotalPriceV2(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("subtotalPriceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// OrderSuccessfulFulfillmentsArgs holds the arguments of Order.successfulFulfillments.
+type OrderSuccessfulFulfillmentsArgs struct {
+	// First: Truncate the array result to this size.
+	First *int
+}
+
+// SuccessfulFulfillments selects successfulFulfillments, passing args, and the fields of it selected by fn.
+func (s *OrderSelection) SuccessfulFulfillments(args OrderSuccessfulFulfillmentsArgs, fn func(*FulfillmentSelection)) *OrderSelection {
+	f := s.sel.field("successfulFulfillments")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&FulfillmentSelection{sel})
+	}
+
+	return s
+}
+
+// TotalPrice selects totalPrice.
+//
+// Deprecated: Use `totalPriceV2` instead
+func (s *OrderSelection) TotalPrice() *OrderSelection {
+	s.sel.field("totalPrice")
+
+	return s
+}
+
+// TotalPriceV2 selects totalPriceV2, and the fields of it selected by fn.
+func (s *OrderSelection) TotalPriceV2(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("totalPriceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalRefunded selects totalRefunded.
+//
+// Deprecated: Use `totalRefundedV2` instead
+func (s *OrderSelection) TotalRefunded() *OrderSelection {
+	s.sel.field("totalRefunded")
+
+	return s
+}
+
+// TotalRefundedV2 selects totalRefundedV2, and the fields of it selected by fn.
+func (s *OrderSelection) TotalRefundedV2(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("totalRefundedV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalShippingPrice selects totalShippingPrice.
+//
+// Deprecated: Use `totalShippingPriceV2` instead
+func (s *OrderSelection) TotalShippingPrice() *OrderSelection {
+	s.sel.field("totalShippingPrice")
+
+	return s
+}
+
+// TotalShippingPriceV2 selects totalShippingPriceV2, and the fields of it selected by fn.
+func (s *OrderSelection) TotalShippingPriceV2(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("totalShippingPriceV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalTax selects totalTax.
+//
+// Deprecated: Use `totalTaxV2` instead
+func (s *OrderSelection) TotalTax() *OrderSelection {
+	s.sel.field("totalTax")
+
+	return s
+}
+
+// TotalTaxV2 selects totalTaxV2, and the fields of it selected by fn.
+func (s *OrderSelection) TotalTaxV2(fn func(*MoneyV2Selection)) *OrderSelection {
+	f := s.sel.field("totalTaxV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *OrderSelection) Typename() *OrderSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OrderLineItemConnectionSelection selects fields of OrderLineItemConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type OrderLineItemConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *OrderLineItemConnectionSelection) Edges(fn func(*OrderLineItemEdgeSelection)) *OrderLineItemConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&OrderLineItemEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *OrderLineItemConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *OrderLineItemConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *OrderLineItemConnectionSelection) Typename() *OrderLineItemConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OrderLineItemEdgeSelection selects fields of OrderLineItemEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type OrderLineItemEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *OrderLineItemEdgeSelection) Cursor() *OrderLineItemEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *OrderLineItemEdgeSelection) Node(fn func(*OrderLineItemSelection)) *OrderLineItemEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&OrderLineItemSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *OrderLineItemEdgeSelection) Typename() *OrderLineItemEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OrderLineItemSelection selects fields of OrderLineItem for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type OrderLineItemSelection struct {
+	sel *selection
+}
+
+// CurrentQuantity selects currentQuantity.
+func (s *OrderLineItemSelection) CurrentQuantity() *OrderLineItemSelection {
+	s.sel.field("currentQuantity")
+
+	return s
+}
+
+// CustomAttributes selects customAttributes, and the fields of it selected by fn.
+func (s *OrderLineItemSelection) CustomAttributes(fn func(*AttributeSelection)) *OrderLineItemSelection {
+	f := s.sel.field("customAttributes")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&AttributeSelection{sel})
+	}
+
+	return s
+}
+
+// DiscountAllocations selects discountAllocations, and the fields of it selected by fn.
+func (s *OrderLineItemSelection) DiscountAllocations(fn func(*DiscountAllocationSelection)) *OrderLineItemSelection {
+	f := s.sel.field("discountAllocations")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DiscountAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// DiscountedTotalPrice selects discountedTotalPrice, and the fields of it selected by fn.
+func (s *OrderLineItemSelection) DiscountedTotalPrice(fn func(*MoneyV2Selection)) *OrderLineItemSelection {
+	f := s.sel.field("discountedTotalPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// OriginalTotalPrice selects originalTotalPrice, and the fields of it selected by fn.
+func (s *OrderLineItemSelection) OriginalTotalPrice(fn func(*MoneyV2Selection)) *OrderLineItemSelection {
+	f := s.sel.field("originalTotalPrice")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Quantity selects quantity.
+func (s *OrderLineItemSelection) Quantity() *OrderLineItemSelection {
+	s.sel.field("quantity")
+
+	return s
+}
+
+// Title selects title.
+func (s *OrderLineItemSelection) Title() *OrderLineItemSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Variant selects variant, and the fields of it selected by fn.
+func (s *OrderLineItemSelection) Variant(fn func(*ProductVariantSelection)) *OrderLineItemSelection {
+	f := s.sel.field("variant")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *OrderLineItemSelection) Typename() *OrderLineItemSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// FulfillmentSelection selects fields of Fulfillment for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type FulfillmentSelection struct {
+	sel *selection
+}
+
+// FulfillmentFulfillmentLineItemsArgs holds the arguments of Fulfillment.fulfillmentLineItems.
+type FulfillmentFulfillmentLineItemsArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// FulfillmentLineItems selects fulfillmentLineItems, passing args, and the fields of it selected by fn.
+func (s *FulfillmentSelection) FulfillmentLineItems(args FulfillmentFulfillmentLineItemsArgs, fn func(*FulfillmentLineItemConnectionSelection)) *FulfillmentSelection {
+	f := s.sel.field("fulfillmentLineItems")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&FulfillmentLineItemConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// TrackingCompany selects trackingCompany.
+func (s *FulfillmentSelection) TrackingCompany() *FulfillmentSelection {
+	s.sel.field("trackingCompany")
+
+	return s
+}
+
+// FulfillmentTrackingInfoArgs holds the arguments of Fulfillment.trackingInfo.
+type FulfillmentTrackingInfoArgs struct {
+	// First: Truncate the array result to this size.
+	First *int
+}
+
+// TrackingInfo selects trackingInfo, passing args, and the fields of it selected by fn.
+func (s *FulfillmentSelection) TrackingInfo(args FulfillmentTrackingInfoArgs, fn func(*FulfillmentTrackingInfoSelection)) *FulfillmentSelection {
+	f := s.sel.field("trackingInfo")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&FulfillmentTrackingInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *FulfillmentSelection) Typename() *FulfillmentSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// FulfillmentLineItemConnectionSelection selects fields of FulfillmentLineItemConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type FulfillmentLineItemConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *FulfillmentLineItemConnectionSelection) Edges(fn func(*FulfillmentLineItemEdgeSelection)) *FulfillmentLineItemConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&FulfillmentLineItemEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *FulfillmentLineItemConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *FulfillmentLineItemConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *FulfillmentLineItemConnectionSelection) Typename() *FulfillmentLineItemConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// FulfillmentLineItemEdgeSelection selects fields of FulfillmentLineItemEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type FulfillmentLineItemEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *FulfillmentLineItemEdgeSelection) Cursor() *FulfillmentLineItemEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *FulfillmentLineItemEdgeSelection) Node(fn func(*FulfillmentLineItemSelection)) *FulfillmentLineItemEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&FulfillmentLineItemSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *FulfillmentLineItemEdgeSelection) Typename() *FulfillmentLineItemEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// FulfillmentLineItemSelection selects fields of FulfillmentLineItem for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type FulfillmentLineItemSelection struct {
+	sel *selection
+}
+
+// LineItem selects lineItem, and the fields of it selected by fn.
+func (s *FulfillmentLineItemSelection) LineItem(fn func(*OrderLineItemSelection)) *FulfillmentLineItemSelection {
+	f := s.sel.field("lineItem")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&OrderLineItemSelection{sel})
+	}
+
+	return s
+}
+
+// Quantity selects quantity.
+func (s *FulfillmentLineItemSelection) Quantity() *FulfillmentLineItemSelection {
+	s.sel.field("quantity")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *FulfillmentLineItemSelection) Typename() *FulfillmentLineItemSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// FulfillmentTrackingInfoSelection selects fields of FulfillmentTrackingInfo for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type FulfillmentTrackingInfoSelection struct {
+	sel *selection
+}
+
+// Number selects number.
+func (s *FulfillmentTrackingInfoSelection) Number() *FulfillmentTrackingInfoSelection {
+	s.sel.field("number")
+
+	return s
+}
+
+// URL selects url.
+func (s *FulfillmentTrackingInfoSelection) URL() *FulfillmentTrackingInfoSelection {
+	s.sel.field("url")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *FulfillmentTrackingInfoSelection) Typename() *FulfillmentTrackingInfoSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OrderConnectionSelection selects fields of OrderConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type OrderConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *OrderConnectionSelection) Edges(fn func(*OrderEdgeSelection)) *OrderConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&OrderEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *OrderConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *OrderConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *OrderConnectionSelection) Typename() *OrderConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OrderEdgeSelection selects fields of OrderEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type OrderEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *OrderEdgeSelection) Cursor() *OrderEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *OrderEdgeSelection) Node(fn func(*OrderSelection)) *OrderEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&OrderSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *OrderEdgeSelection) Typename() *OrderEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// PageSelection selects fields of Page for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type PageSelection struct {
+	sel *selection
+}
+
+// Body selects body.
+func (s *PageSelection) Body() *PageSelection {
+	s.sel.field("body")
+
+	return s
+}
+
+// BodySummary selects bodySummary.
+func (s *PageSelection) BodySummary() *PageSelection {
+	s.sel.field("bodySummary")
+
+	return s
+}
+
+// CreatedAt selects createdAt.
+func (s *PageSelection) CreatedAt() *PageSelection {
+	s.sel.field("createdAt")
+
+	return s
+}
+
+// Handle selects handle.
+func (s *PageSelection) Handle() *PageSelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Id selects id.
+func (s *PageSelection) Id() *PageSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// PageMetafieldArgs holds the arguments of Page.metafield.
+type PageMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *PageSelection) Metafield(args PageMetafieldArgs, fn func(*MetafieldSelection)) *PageSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// PageMetafieldsArgs holds the arguments of Page.metafields.
+type PageMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *PageSelection) Metafields(args PageMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *PageSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// OnlineStoreURL selects onlineStoreUrl.
+func (s *PageSelection) OnlineStoreURL() *PageSelection {
+	s.sel.field("onlineStoreUrl")
+
+	return s
+}
+
+// SEO selects seo, and the fields of it selected by fn.
+func (s *PageSelection) SEO(fn func(*SEOSelection)) *PageSelection {
+	f := s.sel.field("seo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SEOSelection{sel})
+	}
+
+	return s
+}
+
+// Title selects title.
+func (s *PageSelection) Title() *PageSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// UpdatedAt selects updatedAt.
+func (s *PageSelection) UpdatedAt() *PageSelection {
+	s.sel.field("updatedAt")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *PageSelection) Typename() *PageSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ShopSelection selects fields of Shop for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ShopSelection struct {
+	sel *selection
+}
+
+// Description selects description.
+func (s *ShopSelection) Description() *ShopSelection {
+	s.sel.field("description")
+
+	return s
+}
+
+// ShopMetafieldArgs holds the arguments of Shop.metafield.
+type ShopMetafieldArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace string
+	// Key: Identifier for the metafield (maximum of 30 characters).
+	Key string
+}
+
+// Metafield selects metafield, passing args, and the fields of it selected by fn.
+func (s *ShopSelection) Metafield(args ShopMetafieldArgs, fn func(*MetafieldSelection)) *ShopSelection {
+	f := s.sel.field("metafield")
+	f.arg("namespace", "String!", args.Namespace)
+	f.arg("key", "String!", args.Key)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldSelection{sel})
+	}
+
+	return s
+}
+
+// ShopMetafieldsArgs holds the arguments of Shop.metafields.
+type ShopMetafieldsArgs struct {
+	// Namespace: Container for a set of metafields (maximum of 20 characters).
+	Namespace *string
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Metafields selects metafields, passing args, and the fields of it selected by fn.
+//
+/*
+Deprecated: The `metafields` field will be removed in the future in favor of using [aliases](https://graphql.org/learn/queries/#aliases) with the `metafield` field.
+*/
+func (s *ShopSelection) Metafields(args ShopMetafieldsArgs, fn func(*MetafieldConnectionSelection)) *ShopSelection {
+	f := s.sel.field("metafields")
+	if args.Namespace != nil {
+		f.arg("namespace", "String", *args.Namespace)
+	}
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MetafieldConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// MoneyFormat selects moneyFormat.
+func (s *ShopSelection) MoneyFormat() *ShopSelection {
+	s.sel.field("moneyFormat")
+
+	return s
+}
+
+// Name selects name.
+func (s *ShopSelection) Name() *ShopSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// PaymentSettings selects paymentSettings, and the fields of it selected by fn.
+func (s *ShopSelection) PaymentSettings(fn func(*PaymentSettingsSelection)) *ShopSelection {
+	f := s.sel.field("paymentSettings")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PaymentSettingsSelection{sel})
+	}
+
+	return s
+}
+
+// PrimaryDomain selects primaryDomain, and the fields of it selected by fn.
+func (s *ShopSelection) PrimaryDomain(fn func(*DomainSelection)) *ShopSelection {
+	f := s.sel.field("primaryDomain")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&DomainSelection{sel})
+	}
+
+	return s
+}
+
+// PrivacyPolicy selects privacyPolicy, and the fields of it selected by fn.
+func (s *ShopSelection) PrivacyPolicy(fn func(*ShopPolicySelection)) *ShopSelection {
+	f := s.sel.field("privacyPolicy")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ShopPolicySelection{sel})
+	}
+
+	return s
+}
+
+// RefundPolicy selects refundPolicy, and the fields of it selected by fn.
+func (s *ShopSelection) RefundPolicy(fn func(*ShopPolicySelection)) *ShopSelection {
+	f := s.sel.field("refundPolicy")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ShopPolicySelection{sel})
+	}
+
+	return s
+}
+
+// ShippingPolicy selects shippingPolicy, and the fields of it selected by fn.
+func (s *ShopSelection) ShippingPolicy(fn func(*ShopPolicySelection)) *ShopSelection {
+	f := s.sel.field("shippingPolicy")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ShopPolicySelection{sel})
+	}
+
+	return s
+}
+
+// ShipsToCountries selects shipsToCountries.
+func (s *ShopSelection) ShipsToCountries() *ShopSelection {
+	s.sel.field("shipsToCountries")
+
+	return s
+}
+
+// SubscriptionPolicy selects subscriptionPolicy, and the fields of it selected by fn.
+func (s *ShopSelection) SubscriptionPolicy(fn func(*ShopPolicyWithDefaultSelection)) *ShopSelection {
+	f := s.sel.field("subscriptionPolicy")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ShopPolicyWithDefaultSelection{sel})
+	}
+
+	return s
+}
+
+// TermsOfService selects termsOfService, and the fields of it selected by fn.
+func (s *ShopSelection) TermsOfService(fn func(*ShopPolicySelection)) *ShopSelection {
+	f := s.sel.field("termsOfService")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ShopPolicySelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ShopSelection) Typename() *ShopSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// PaymentSettingsSelection selects fields of PaymentSettings for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type PaymentSettingsSelection struct {
+	sel *selection
+}
+
+// AcceptedCardBrands selects acceptedCardBrands.
+func (s *PaymentSettingsSelection) AcceptedCardBrands() *PaymentSettingsSelection {
+	s.sel.field("acceptedCardBrands")
+
+	return s
+}
+
+// CardVaultURL selects cardVaultUrl.
+func (s *PaymentSettingsSelection) CardVaultURL() *PaymentSettingsSelection {
+	s.sel.field("cardVaultUrl")
+
+	return s
+}
+
+// CountryCode selects countryCode.
+func (s *PaymentSettingsSelection) CountryCode() *PaymentSettingsSelection {
+	s.sel.field("countryCode")
+
+	return s
+}
+
+// CurrencyCode selects currencyCode.
+func (s *PaymentSettingsSelection) CurrencyCode() *PaymentSettingsSelection {
+	s.sel.field("currencyCode")
+
+	return s
+}
+
+// EnabledPresentmentCurrencies selects enabledPresentmentCurrencies.
+func (s *PaymentSettingsSelection) EnabledPresentmentCurrencies() *PaymentSettingsSelection {
+	s.sel.field("enabledPresentmentCurrencies")
+
+	return s
+}
+
+// ShopifyPaymentsAccountId selects shopifyPaymentsAccountId.
+func (s *PaymentSettingsSelection) ShopifyPaymentsAccountId() *PaymentSettingsSelection {
+	s.sel.field("shopifyPaymentsAccountId")
+
+	return s
+}
+
+// SupportedDigitalWallets selects supportedDigitalWallets.
+func (s *PaymentSettingsSelection) SupportedDigitalWallets() *PaymentSettingsSelection {
+	s.sel.field("supportedDigitalWallets")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *PaymentSettingsSelection) Typename() *PaymentSettingsSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// DomainSelection selects fields of Domain for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type DomainSelection struct {
+	sel *selection
+}
+
+// Host selects host.
+func (s *DomainSelection) Host() *DomainSelection {
+	s.sel.field("host")
+
+	return s
+}
+
+// SSLEnabled selects sslEnabled.
+func (s *DomainSelection) SSLEnabled() *DomainSelection {
+	s.sel.field("sslEnabled")
+
+	return s
+}
+
+// URL selects url.
+func (s *DomainSelection) URL() *DomainSelection {
+	s.sel.field("url")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *DomainSelection) Typename() *DomainSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ShopPolicySelection selects fields of ShopPolicy for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ShopPolicySelection struct {
+	sel *selection
+}
+
+// Body selects body.
+func (s *ShopPolicySelection) Body() *ShopPolicySelection {
+	s.sel.field("body")
+
+	return s
+}
+
+// Handle selects handle.
+func (s *ShopPolicySelection) Handle() *ShopPolicySelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Id selects id.
+func (s *ShopPolicySelection) Id() *ShopPolicySelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Title selects title.
+func (s *ShopPolicySelection) Title() *ShopPolicySelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// URL selects url.
+func (s *ShopPolicySelection) URL() *ShopPolicySelection {
+	s.sel.field("url")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ShopPolicySelection) Typename() *ShopPolicySelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ShopPolicyWithDefaultSelection selects fields of ShopPolicyWithDefault for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ShopPolicyWithDefaultSelection struct {
+	sel *selection
+}
+
+// Body selects body.
+func (s *ShopPolicyWithDefaultSelection) Body() *ShopPolicyWithDefaultSelection {
+	s.sel.field("body")
+
+	return s
+}
+
+// Handle selects handle.
+func (s *ShopPolicyWithDefaultSelection) Handle() *ShopPolicyWithDefaultSelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Id selects id.
+func (s *ShopPolicyWithDefaultSelection) Id() *ShopPolicyWithDefaultSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Title selects title.
+func (s *ShopPolicyWithDefaultSelection) Title() *ShopPolicyWithDefaultSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// URL selects url.
+func (s *ShopPolicyWithDefaultSelection) URL() *ShopPolicyWithDefaultSelection {
+	s.sel.field("url")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ShopPolicyWithDefaultSelection) Typename() *ShopPolicyWithDefaultSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MetafieldReferenceSelection selects fields of MetafieldReference for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MetafieldReferenceSelection struct {
+	sel *selection
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MetafieldReferenceSelection) Typename() *MetafieldReferenceSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnMediaImage selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a MediaImage. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldReferenceSelection) OnMediaImage(fn func(*MediaImageSelection)) *MetafieldReferenceSelection {
+	sel := s.sel.fragment("MediaImage")
+	if fn != nil {
+		fn(&MediaImageSelection{sel})
+	}
+
+	return s
+}
+
+// OnPage selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Page. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldReferenceSelection) OnPage(fn func(*PageSelection)) *MetafieldReferenceSelection {
+	sel := s.sel.fragment("Page")
+	if fn != nil {
+		fn(&PageSelection{sel})
+	}
+
+	return s
+}
+
+// OnProduct selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a Product. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldReferenceSelection) OnProduct(fn func(*ProductSelection)) *MetafieldReferenceSelection {
+	sel := s.sel.fragment("Product")
+	if fn != nil {
+		fn(&ProductSelection{sel})
+	}
+
+	return s
+}
+
+// OnProductVariant selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ProductVariant. __typename is also selected, to tell
+// which type the value is.
+func (s *MetafieldReferenceSelection) OnProductVariant(fn func(*ProductVariantSelection)) *MetafieldReferenceSelection {
+	sel := s.sel.fragment("ProductVariant")
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// MediaImageSelection selects fields of MediaImage for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MediaImageSelection struct {
+	sel *selection
+}
+
+// Alt selects alt.
+func (s *MediaImageSelection) Alt() *MediaImageSelection {
+	s.sel.field("alt")
+
+	return s
+}
+
+// Id selects id.
+func (s *MediaImageSelection) Id() *MediaImageSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Image selects image, and the fields of it selected by fn.
+func (s *MediaImageSelection) Image(fn func(*ImageSelection)) *MediaImageSelection {
+	f := s.sel.field("image")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// MediaContentType selects mediaContentType.
+func (s *MediaImageSelection) MediaContentType() *MediaImageSelection {
+	s.sel.field("mediaContentType")
+
+	return s
+}
+
+// PreviewImage selects previewImage, and the fields of it selected by fn.
+func (s *MediaImageSelection) PreviewImage(fn func(*ImageSelection)) *MediaImageSelection {
+	f := s.sel.field("previewImage")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MediaImageSelection) Typename() *MediaImageSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CommentConnectionSelection selects fields of CommentConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CommentConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *CommentConnectionSelection) Edges(fn func(*CommentEdgeSelection)) *CommentConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CommentEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *CommentConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *CommentConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CommentConnectionSelection) Typename() *CommentConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CommentEdgeSelection selects fields of CommentEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CommentEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *CommentEdgeSelection) Cursor() *CommentEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *CommentEdgeSelection) Node(fn func(*CommentSelection)) *CommentEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CommentSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CommentEdgeSelection) Typename() *CommentEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CommentSelection selects fields of Comment for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CommentSelection struct {
+	sel *selection
+}
+
+// Author selects author, and the fields of it selected by fn.
+func (s *CommentSelection) Author(fn func(*CommentAuthorSelection)) *CommentSelection {
+	f := s.sel.field("author")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CommentAuthorSelection{sel})
+	}
+
+	return s
+}
+
+// CommentContentArgs holds the arguments of Comment.content.
+type CommentContentArgs struct {
+	// TruncateAt: Truncates string after the given length.
+	TruncateAt *int
+}
+
+// Content selects content, passing args.
+func (s *CommentSelection) Content(args CommentContentArgs) *CommentSelection {
+	f := s.sel.field("content")
+	if args.TruncateAt != nil {
+		f.arg("truncateAt", "Int", *args.TruncateAt)
+	}
+
+	return s
+}
+
+// ContentHTML selects contentHtml.
+func (s *CommentSelection) ContentHTML() *CommentSelection {
+	s.sel.field("contentHtml")
+
+	return s
+}
+
+// Id selects id.
+func (s *CommentSelection) Id() *CommentSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CommentSelection) Typename() *CommentSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CommentAuthorSelection selects fields of CommentAuthor for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CommentAuthorSelection struct {
+	sel *selection
+}
+
+// Email selects email.
+func (s *CommentAuthorSelection) Email() *CommentAuthorSelection {
+	s.sel.field("email")
+
+	return s
+}
+
+// Name selects name.
+func (s *CommentAuthorSelection) Name() *CommentAuthorSelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CommentAuthorSelection) Typename() *CommentAuthorSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// BlogConnectionSelection selects fields of BlogConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type BlogConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *BlogConnectionSelection) Edges(fn func(*BlogEdgeSelection)) *BlogConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&BlogEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *BlogConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *BlogConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *BlogConnectionSelection) Typename() *BlogConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// BlogEdgeSelection selects fields of BlogEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type BlogEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *BlogEdgeSelection) Cursor() *BlogEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *BlogEdgeSelection) Node(fn func(*BlogSelection)) *BlogEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&BlogSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *BlogEdgeSelection) Typename() *BlogEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartSelection selects fields of Cart for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartSelection struct {
+	sel *selection
+}
+
+// Attributes selects attributes, and the fields of it selected by fn.
+func (s *CartSelection) Attributes(fn func(*AttributeSelection)) *CartSelection {
+	f := s.sel.field("attributes")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&AttributeSelection{sel})
+	}
+
+	return s
+}
+
+// BuyerIdentity selects buyerIdentity, and the fields of it selected by fn.
+func (s *CartSelection) BuyerIdentity(fn func(*CartBuyerIdentitySelection)) *CartSelection {
+	f := s.sel.field("buyerIdentity")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartBuyerIdentitySelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutURL selects checkoutUrl.
+func (s *CartSelection) CheckoutURL() *CartSelection {
+	s.sel.field("checkoutUrl")
+
+	return s
+}
+
+// CreatedAt selects createdAt.
+func (s *CartSelection) CreatedAt() *CartSelection {
+	s.sel.field("createdAt")
+
+	return s
+}
+
+// DiscountCodes selects discountCodes, and the fields of it selected by fn.
+func (s *CartSelection) DiscountCodes(fn func(*CartDiscountCodeSelection)) *CartSelection {
+	f := s.sel.field("discountCodes")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartDiscountCodeSelection{sel})
+	}
+
+	return s
+}
+
+// EstimatedCost selects estimatedCost, and the fields of it selected by fn.
+func (s *CartSelection) EstimatedCost(fn func(*CartEstimatedCostSelection)) *CartSelection {
+	f := s.sel.field("estimatedCost")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartEstimatedCostSelection{sel})
+	}
+
+	return s
+}
+
+// Id selects id.
+func (s *CartSelection) Id() *CartSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// CartLinesArgs holds the arguments of Cart.lines.
+type CartLinesArgs struct {
+	// First: Returns up to the first `n` elements from the list.
+	First *int
+	// After: Returns the elements that come after the specified cursor.
+	After *string
+	// Last: Returns up to the last `n` elements from the list.
+	Last *int
+	// Before: Returns the elements that come before the specified cursor.
+	Before *string
+	// Reverse: Reverse the order of the underlying list.
+	Reverse *bool
+}
+
+// Lines selects lines, passing args, and the fields of it selected by fn.
+func (s *CartSelection) Lines(args CartLinesArgs, fn func(*CartLineConnectionSelection)) *CartSelection {
+	f := s.sel.field("lines")
+	if args.First != nil {
+		f.arg("first", "Int", *args.First)
+	}
+	if args.After != nil {
+		f.arg("after", "String", *args.After)
+	}
+	if args.Last != nil {
+		f.arg("last", "Int", *args.Last)
+	}
+	if args.Before != nil {
+		f.arg("before", "String", *args.Before)
+	}
+	if args.Reverse != nil {
+		f.arg("reverse", "Boolean", *args.Reverse)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartLineConnectionSelection{sel})
+	}
+
+	return s
+}
+
+// Note selects note.
+func (s *CartSelection) Note() *CartSelection {
+	s.sel.field("note")
+
+	return s
+}
+
+// UpdatedAt selects updatedAt.
+func (s *CartSelection) UpdatedAt() *CartSelection {
+	s.sel.field("updatedAt")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartSelection) Typename() *CartSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartBuyerIdentitySelection selects fields of CartBuyerIdentity for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartBuyerIdentitySelection struct {
+	sel *selection
+}
+
+// CountryCode selects countryCode.
+func (s *CartBuyerIdentitySelection) CountryCode() *CartBuyerIdentitySelection {
+	s.sel.field("countryCode")
+
+	return s
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CartBuyerIdentitySelection) Customer(fn func(*CustomerSelection)) *CartBuyerIdentitySelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// Email selects email.
+func (s *CartBuyerIdentitySelection) Email() *CartBuyerIdentitySelection {
+	s.sel.field("email")
+
+	return s
+}
+
+// Phone selects phone.
+func (s *CartBuyerIdentitySelection) Phone() *CartBuyerIdentitySelection {
+	s.sel.field("phone")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartBuyerIdentitySelection) Typename() *CartBuyerIdentitySelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartDiscountCodeSelection selects fields of CartDiscountCode for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartDiscountCodeSelection struct {
+	sel *selection
+}
+
+// Applicable selects applicable.
+func (s *CartDiscountCodeSelection) Applicable() *CartDiscountCodeSelection {
+	s.sel.field("applicable")
+
+	return s
+}
+
+// Code selects code.
+func (s *CartDiscountCodeSelection) Code() *CartDiscountCodeSelection {
+	s.sel.field("code")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartDiscountCodeSelection) Typename() *CartDiscountCodeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartEstimatedCostSelection selects fields of CartEstimatedCost for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartEstimatedCostSelection struct {
+	sel *selection
+}
+
+// SubtotalAmount selects subtotalAmount, and the fields of it selected by fn.
+func (s *CartEstimatedCostSelection) SubtotalAmount(fn func(*MoneyV2Selection)) *CartEstimatedCostSelection {
+	f := s.sel.field("subtotalAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalAmount selects totalAmount, and the fields of it selected by fn.
+func (s *CartEstimatedCostSelection) TotalAmount(fn func(*MoneyV2Selection)) *CartEstimatedCostSelection {
+	f := s.sel.field("totalAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalDutyAmount selects totalDutyAmount, and the fields of it selected by fn.
+func (s *CartEstimatedCostSelection) TotalDutyAmount(fn func(*MoneyV2Selection)) *CartEstimatedCostSelection {
+	f := s.sel.field("totalDutyAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalTaxAmount selects totalTaxAmount, and the fields of it selected by fn.
+func (s *CartEstimatedCostSelection) TotalTaxAmount(fn func(*MoneyV2Selection)) *CartEstimatedCostSelection {
+	f := s.sel.field("totalTaxAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartEstimatedCostSelection) Typename() *CartEstimatedCostSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartLineConnectionSelection selects fields of CartLineConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartLineConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *CartLineConnectionSelection) Edges(fn func(*CartLineEdgeSelection)) *CartLineConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartLineEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *CartLineConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *CartLineConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartLineConnectionSelection) Typename() *CartLineConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartLineEdgeSelection selects fields of CartLineEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartLineEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *CartLineEdgeSelection) Cursor() *CartLineEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *CartLineEdgeSelection) Node(fn func(*CartLineSelection)) *CartLineEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartLineSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartLineEdgeSelection) Typename() *CartLineEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartLineSelection selects fields of CartLine for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartLineSelection struct {
+	sel *selection
+}
+
+// Attributes selects attributes, and the fields of it selected by fn.
+func (s *CartLineSelection) Attributes(fn func(*AttributeSelection)) *CartLineSelection {
+	f := s.sel.field("attributes")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&AttributeSelection{sel})
+	}
+
+	return s
+}
+
+// DiscountAllocations selects discountAllocations, and the fields of it selected by fn.
+func (s *CartLineSelection) DiscountAllocations(fn func(*CartDiscountAllocationSelection)) *CartLineSelection {
+	f := s.sel.field("discountAllocations")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartDiscountAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// EstimatedCost selects estimatedCost, and the fields of it selected by fn.
+func (s *CartLineSelection) EstimatedCost(fn func(*CartLineEstimatedCostSelection)) *CartLineSelection {
+	f := s.sel.field("estimatedCost")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartLineEstimatedCostSelection{sel})
+	}
+
+	return s
+}
+
+// Id selects id.
+func (s *CartLineSelection) Id() *CartLineSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// Merchandise selects merchandise, and the fields of it selected by fn.
+func (s *CartLineSelection) Merchandise(fn func(*MerchandiseSelection)) *CartLineSelection {
+	f := s.sel.field("merchandise")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MerchandiseSelection{sel})
+	}
+
+	return s
+}
+
+// Quantity selects quantity.
+func (s *CartLineSelection) Quantity() *CartLineSelection {
+	s.sel.field("quantity")
+
+	return s
+}
+
+// SellingPlanAllocation selects sellingPlanAllocation, and the fields of it selected by fn.
+func (s *CartLineSelection) SellingPlanAllocation(fn func(*SellingPlanAllocationSelection)) *CartLineSelection {
+	f := s.sel.field("sellingPlanAllocation")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&SellingPlanAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartLineSelection) Typename() *CartLineSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartDiscountAllocationSelection selects fields of CartDiscountAllocation for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartDiscountAllocationSelection struct {
+	sel *selection
+}
+
+// DiscountedAmount selects discountedAmount, and the fields of it selected by fn.
+func (s *CartDiscountAllocationSelection) DiscountedAmount(fn func(*MoneyV2Selection)) *CartDiscountAllocationSelection {
+	f := s.sel.field("discountedAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartDiscountAllocationSelection) Typename() *CartDiscountAllocationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnCartAutomaticDiscountAllocation selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a CartAutomaticDiscountAllocation. __typename is also selected, to tell
+// which type the value is.
+func (s *CartDiscountAllocationSelection) OnCartAutomaticDiscountAllocation(fn func(*CartAutomaticDiscountAllocationSelection)) *CartDiscountAllocationSelection {
+	sel := s.sel.fragment("CartAutomaticDiscountAllocation")
+	if fn != nil {
+		fn(&CartAutomaticDiscountAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// OnCartCodeDiscountAllocation selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a CartCodeDiscountAllocation. __typename is also selected, to tell
+// which type the value is.
+func (s *CartDiscountAllocationSelection) OnCartCodeDiscountAllocation(fn func(*CartCodeDiscountAllocationSelection)) *CartDiscountAllocationSelection {
+	sel := s.sel.fragment("CartCodeDiscountAllocation")
+	if fn != nil {
+		fn(&CartCodeDiscountAllocationSelection{sel})
+	}
+
+	return s
+}
+
+// CartLineEstimatedCostSelection selects fields of CartLineEstimatedCost for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartLineEstimatedCostSelection struct {
+	sel *selection
+}
+
+// SubtotalAmount selects subtotalAmount, and the fields of it selected by fn.
+func (s *CartLineEstimatedCostSelection) SubtotalAmount(fn func(*MoneyV2Selection)) *CartLineEstimatedCostSelection {
+	f := s.sel.field("subtotalAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// TotalAmount selects totalAmount, and the fields of it selected by fn.
+func (s *CartLineEstimatedCostSelection) TotalAmount(fn func(*MoneyV2Selection)) *CartLineEstimatedCostSelection {
+	f := s.sel.field("totalAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartLineEstimatedCostSelection) Typename() *CartLineEstimatedCostSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MerchandiseSelection selects fields of Merchandise for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MerchandiseSelection struct {
+	sel *selection
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MerchandiseSelection) Typename() *MerchandiseSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnProductVariant selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a ProductVariant. __typename is also selected, to tell
+// which type the value is.
+func (s *MerchandiseSelection) OnProductVariant(fn func(*ProductVariantSelection)) *MerchandiseSelection {
+	sel := s.sel.fragment("ProductVariant")
+	if fn != nil {
+		fn(&ProductVariantSelection{sel})
+	}
+
+	return s
+}
+
+// LocalizationSelection selects fields of Localization for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type LocalizationSelection struct {
+	sel *selection
+}
+
+// AvailableCountries selects availableCountries, and the fields of it selected by fn.
+func (s *LocalizationSelection) AvailableCountries(fn func(*CountrySelection)) *LocalizationSelection {
+	f := s.sel.field("availableCountries")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CountrySelection{sel})
+	}
+
+	return s
+}
+
+// Country selects country, and the fields of it selected by fn.
+func (s *LocalizationSelection) Country(fn func(*CountrySelection)) *LocalizationSelection {
+	f := s.sel.field("country")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CountrySelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *LocalizationSelection) Typename() *LocalizationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CountrySelection selects fields of Country for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CountrySelection struct {
+	sel *selection
+}
+
+// Currency selects currency, and the fields of it selected by fn.
+func (s *CountrySelection) Currency(fn func(*CurrencySelection)) *CountrySelection {
+	f := s.sel.field("currency")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CurrencySelection{sel})
+	}
+
+	return s
+}
+
+// IsoCode selects isoCode.
+func (s *CountrySelection) IsoCode() *CountrySelection {
+	s.sel.field("isoCode")
+
+	return s
+}
+
+// Name selects name.
+func (s *CountrySelection) Name() *CountrySelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// UnitSystem selects unitSystem.
+func (s *CountrySelection) UnitSystem() *CountrySelection {
+	s.sel.field("unitSystem")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CountrySelection) Typename() *CountrySelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CurrencySelection selects fields of Currency for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CurrencySelection struct {
+	sel *selection
+}
+
+// IsoCode selects isoCode.
+func (s *CurrencySelection) IsoCode() *CurrencySelection {
+	s.sel.field("isoCode")
+
+	return s
+}
+
+// Name selects name.
+func (s *CurrencySelection) Name() *CurrencySelection {
+	s.sel.field("name")
+
+	return s
+}
+
+// Symbol selects symbol.
+func (s *CurrencySelection) Symbol() *CurrencySelection {
+	s.sel.field("symbol")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CurrencySelection) Typename() *CurrencySelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// LocationConnectionSelection selects fields of LocationConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type LocationConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *LocationConnectionSelection) Edges(fn func(*LocationEdgeSelection)) *LocationConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&LocationEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *LocationConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *LocationConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *LocationConnectionSelection) Typename() *LocationConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// LocationEdgeSelection selects fields of LocationEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type LocationEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *LocationEdgeSelection) Cursor() *LocationEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *LocationEdgeSelection) Node(fn func(*LocationSelection)) *LocationEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&LocationSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *LocationEdgeSelection) Typename() *LocationEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// PageConnectionSelection selects fields of PageConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type PageConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *PageConnectionSelection) Edges(fn func(*PageEdgeSelection)) *PageConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *PageConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *PageConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *PageConnectionSelection) Typename() *PageConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// PageEdgeSelection selects fields of PageEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type PageEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *PageEdgeSelection) Cursor() *PageEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node, and the fields of it selected by fn.
+func (s *PageEdgeSelection) Node(fn func(*PageSelection)) *PageEdgeSelection {
+	f := s.sel.field("node")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *PageEdgeSelection) Typename() *PageEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// StringConnectionSelection selects fields of StringConnection for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type StringConnectionSelection struct {
+	sel *selection
+}
+
+// Edges selects edges, and the fields of it selected by fn.
+func (s *StringConnectionSelection) Edges(fn func(*StringEdgeSelection)) *StringConnectionSelection {
+	f := s.sel.field("edges")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&StringEdgeSelection{sel})
+	}
+
+	return s
+}
+
+// PageInfo selects pageInfo, and the fields of it selected by fn.
+func (s *StringConnectionSelection) PageInfo(fn func(*PageInfoSelection)) *StringConnectionSelection {
+	f := s.sel.field("pageInfo")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PageInfoSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *StringConnectionSelection) Typename() *StringConnectionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// StringEdgeSelection selects fields of StringEdge for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type StringEdgeSelection struct {
+	sel *selection
+}
+
+// Cursor selects cursor.
+func (s *StringEdgeSelection) Cursor() *StringEdgeSelection {
+	s.sel.field("cursor")
+
+	return s
+}
+
+// Node selects node.
+func (s *StringEdgeSelection) Node() *StringEdgeSelection {
+	s.sel.field("node")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *StringEdgeSelection) Typename() *StringEdgeSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ApiVersionSelection selects fields of ApiVersion for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ApiVersionSelection struct {
+	sel *selection
+}
+
+// DisplayName selects displayName.
+func (s *ApiVersionSelection) DisplayName() *ApiVersionSelection {
+	s.sel.field("displayName")
+
+	return s
+}
+
+// Handle selects handle.
+func (s *ApiVersionSelection) Handle() *ApiVersionSelection {
+	s.sel.field("handle")
+
+	return s
+}
+
+// Supported selects supported.
+func (s *ApiVersionSelection) Supported() *ApiVersionSelection {
+	s.sel.field("supported")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ApiVersionSelection) Typename() *ApiVersionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// MutationSelection selects fields of Mutation for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type MutationSelection struct {
+	sel *selection
+}
+
+// NewMutation begins building a mutation, the fields of which are selected by the
+// methods of the returned MutationSelection.
+func NewMutation() *MutationSelection {
+	return &MutationSelection{newQueryBuilder("mutation")}
+}
+
+// Build returns the document of the mutation, and the values of the variables
+// through which the arguments given to it are passed.
+func (s *MutationSelection) Build() (string, map[string]interface{}) {
+	return s.sel.build()
+}
+
+// Request returns a Request executing the mutation, for use with Client.Do.
+func (s *MutationSelection) Request() *Request {
+	return s.sel.request()
+}
+
+// MutationCartAttributesUpdateArgs holds the arguments of Mutation.cartAttributesUpdate.
+type MutationCartAttributesUpdateArgs struct {
+	// Attributes: An array of key-value pairs that contains additional information about the cart.
+	Attributes []AttributeInput
+	// CartId: The ID of the cart.
+	CartId string
+}
+
+// CartAttributesUpdate selects cartAttributesUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CartAttributesUpdate(args MutationCartAttributesUpdateArgs, fn func(*CartAttributesUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("cartAttributesUpdate")
+	f.arg("attributes", "[AttributeInput!]!", args.Attributes)
+	f.arg("cartId", "ID!", args.CartId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartAttributesUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCartBuyerIdentityUpdateArgs holds the arguments of Mutation.cartBuyerIdentityUpdate.
+type MutationCartBuyerIdentityUpdateArgs struct {
+	// CartId: The ID of the cart.
+	CartId string
+	// BuyerIdentity: The customer associated with the cart. Used to determine [international pricing](https://shopify.dev/custom-storefronts/products/international-pricing#create-a-checkout). Buyer identity should match the customer's shipping address.
+	BuyerIdentity CartBuyerIdentityInput
+}
+
+// CartBuyerIdentityUpdate selects cartBuyerIdentityUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CartBuyerIdentityUpdate(args MutationCartBuyerIdentityUpdateArgs, fn func(*CartBuyerIdentityUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("cartBuyerIdentityUpdate")
+	f.arg("cartId", "ID!", args.CartId)
+	f.arg("buyerIdentity", "CartBuyerIdentityInput!", args.BuyerIdentity)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartBuyerIdentityUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCartCreateArgs holds the arguments of Mutation.cartCreate.
+type MutationCartCreateArgs struct {
+	// Input: The fields used to create a cart.
+	Input *CartInput
+}
+
+// CartCreate selects cartCreate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CartCreate(args MutationCartCreateArgs, fn func(*CartCreatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("cartCreate")
+	if args.Input != nil {
+		f.arg("input", "CartInput", *args.Input)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartCreatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCartDiscountCodesUpdateArgs holds the arguments of Mutation.cartDiscountCodesUpdate.
+type MutationCartDiscountCodesUpdateArgs struct {
+	// CartId: The ID of the cart.
+	CartId string
+	// DiscountCodes: The discount codes to apply to the cart.
+	DiscountCodes []string
+}
+
+// CartDiscountCodesUpdate selects cartDiscountCodesUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CartDiscountCodesUpdate(args MutationCartDiscountCodesUpdateArgs, fn func(*CartDiscountCodesUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("cartDiscountCodesUpdate")
+	f.arg("cartId", "ID!", args.CartId)
+	if args.DiscountCodes != nil {
+		f.arg("discountCodes", "[String!]", args.DiscountCodes)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartDiscountCodesUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCartLinesAddArgs holds the arguments of Mutation.cartLinesAdd.
+type MutationCartLinesAddArgs struct {
+	// Lines: A list of merchandise lines to add to the cart.
+	Lines []CartLineInput
+	// CartId: The ID of the cart.
+	CartId string
+}
+
+// CartLinesAdd selects cartLinesAdd, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CartLinesAdd(args MutationCartLinesAddArgs, fn func(*CartLinesAddPayloadSelection)) *MutationSelection {
+	f := s.sel.field("cartLinesAdd")
+	f.arg("lines", "[CartLineInput!]!", args.Lines)
+	f.arg("cartId", "ID!", args.CartId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartLinesAddPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCartLinesRemoveArgs holds the arguments of Mutation.cartLinesRemove.
+type MutationCartLinesRemoveArgs struct {
+	// CartId: The ID of the cart.
+	CartId string
+	// LineIds: The merchandise line IDs to remove.
+	LineIds []string
+}
+
+// CartLinesRemove selects cartLinesRemove, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CartLinesRemove(args MutationCartLinesRemoveArgs, fn func(*CartLinesRemovePayloadSelection)) *MutationSelection {
+	f := s.sel.field("cartLinesRemove")
+	f.arg("cartId", "ID!", args.CartId)
+	f.arg("lineIds", "[ID!]!", args.LineIds)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartLinesRemovePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCartLinesUpdateArgs holds the arguments of Mutation.cartLinesUpdate.
+type MutationCartLinesUpdateArgs struct {
+	// CartId: The ID of the cart.
+	CartId string
+	// Lines: The merchandise lines to update.
+	Lines []CartLineUpdateInput
+}
+
+// CartLinesUpdate selects cartLinesUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CartLinesUpdate(args MutationCartLinesUpdateArgs, fn func(*CartLinesUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("cartLinesUpdate")
+	f.arg("cartId", "ID!", args.CartId)
+	f.arg("lines", "[CartLineUpdateInput!]!", args.Lines)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartLinesUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCartNoteUpdateArgs holds the arguments of Mutation.cartNoteUpdate.
+type MutationCartNoteUpdateArgs struct {
+	// CartId: The ID of the cart.
+	CartId string
+	// Note: The note on the cart.
+	Note *string
+}
+
+// CartNoteUpdate selects cartNoteUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CartNoteUpdate(args MutationCartNoteUpdateArgs, fn func(*CartNoteUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("cartNoteUpdate")
+	f.arg("cartId", "ID!", args.CartId)
+	if args.Note != nil {
+		f.arg("note", "String", *args.Note)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartNoteUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutAttributesUpdateArgs holds the arguments of Mutation.checkoutAttributesUpdate.
+type MutationCheckoutAttributesUpdateArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Input: The fields used to update a checkout's attributes.
+	Input CheckoutAttributesUpdateInput
+}
+
+// CheckoutAttributesUpdate selects checkoutAttributesUpdate, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutAttributesUpdateV2` instead
+func (s *MutationSelection) CheckoutAttributesUpdate(args MutationCheckoutAttributesUpdateArgs, fn func(*CheckoutAttributesUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutAttributesUpdate")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("input", "CheckoutAttributesUpdateInput!", args.Input)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutAttributesUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutAttributesUpdateV2Args holds the arguments of Mutation.checkoutAttributesUpdateV2.
+type MutationCheckoutAttributesUpdateV2Args struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Input: The checkout attributes to update.
+	Input CheckoutAttributesUpdateV2Input
+}
+
+// CheckoutAttributesUpdateV2 selects checkoutAttributesUpdateV2, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutAttributesUpdateV2(args MutationCheckoutAttributesUpdateV2Args, fn func(*CheckoutAttributesUpdateV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutAttributesUpdateV2")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("input", "CheckoutAttributesUpdateV2Input!", args.Input)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutAttributesUpdateV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCompleteFreeArgs holds the arguments of Mutation.checkoutCompleteFree.
+type MutationCheckoutCompleteFreeArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutCompleteFree selects checkoutCompleteFree, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutCompleteFree(args MutationCheckoutCompleteFreeArgs, fn func(*CheckoutCompleteFreePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCompleteFree")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCompleteFreePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCompleteWithCreditCardArgs holds the arguments of Mutation.checkoutCompleteWithCreditCard.
+type MutationCheckoutCompleteWithCreditCardArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Payment: The credit card info to apply as a payment.
+	Payment CreditCardPaymentInput
+}
+
+// CheckoutCompleteWithCreditCard selects checkoutCompleteWithCreditCard, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutCompleteWithCreditCardV2` instead
+func (s *MutationSelection) CheckoutCompleteWithCreditCard(args MutationCheckoutCompleteWithCreditCardArgs, fn func(*CheckoutCompleteWithCreditCardPayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCompleteWithCreditCard")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("payment", "CreditCardPaymentInput!", args.Payment)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCompleteWithCreditCardPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCompleteWithCreditCardV2Args holds the arguments of Mutation.checkoutCompleteWithCreditCardV2.
+type MutationCheckoutCompleteWithCreditCardV2Args struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Payment: The credit card info to apply as a payment.
+	Payment CreditCardPaymentInputV2
+}
+
+// CheckoutCompleteWithCreditCardV2 selects checkoutCompleteWithCreditCardV2, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutCompleteWithCreditCardV2(args MutationCheckoutCompleteWithCreditCardV2Args, fn func(*CheckoutCompleteWithCreditCardV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCompleteWithCreditCardV2")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("payment", "CreditCardPaymentInputV2!", args.Payment)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCompleteWithCreditCardV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCompleteWithTokenizedPaymentArgs holds the arguments of Mutation.checkoutCompleteWithTokenizedPayment.
+type MutationCheckoutCompleteWithTokenizedPaymentArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Payment: The info to apply as a tokenized payment.
+	Payment TokenizedPaymentInput
+}
+
+// CheckoutCompleteWithTokenizedPayment selects checkoutCompleteWithTokenizedPayment, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutCompleteWithTokenizedPaymentV2` instead
+func (s *MutationSelection) CheckoutCompleteWithTokenizedPayment(args MutationCheckoutCompleteWithTokenizedPaymentArgs, fn func(*CheckoutCompleteWithTokenizedPaymentPayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCompleteWithTokenizedPayment")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("payment", "TokenizedPaymentInput!", args.Payment)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCompleteWithTokenizedPaymentPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCompleteWithTokenizedPaymentV2Args holds the arguments of Mutation.checkoutCompleteWithTokenizedPaymentV2.
+type MutationCheckoutCompleteWithTokenizedPaymentV2Args struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Payment: The info to apply as a tokenized payment.
+	Payment TokenizedPaymentInputV2
+}
+
+// CheckoutCompleteWithTokenizedPaymentV2 selects checkoutCompleteWithTokenizedPaymentV2, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutCompleteWithTokenizedPaymentV3` instead
+func (s *MutationSelection) CheckoutCompleteWithTokenizedPaymentV2(args MutationCheckoutCompleteWithTokenizedPaymentV2Args, fn func(*CheckoutCompleteWithTokenizedPaymentV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCompleteWithTokenizedPaymentV2")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("payment", "TokenizedPaymentInputV2!", args.Payment)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCompleteWithTokenizedPaymentV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCompleteWithTokenizedPaymentV3Args holds the arguments of Mutation.checkoutCompleteWithTokenizedPaymentV3.
+type MutationCheckoutCompleteWithTokenizedPaymentV3Args struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Payment: The info to apply as a tokenized payment.
+	Payment TokenizedPaymentInputV3
+}
+
+// CheckoutCompleteWithTokenizedPaymentV3 selects checkoutCompleteWithTokenizedPaymentV3, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutCompleteWithTokenizedPaymentV3(args MutationCheckoutCompleteWithTokenizedPaymentV3Args, fn func(*CheckoutCompleteWithTokenizedPaymentV3PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCompleteWithTokenizedPaymentV3")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("payment", "TokenizedPaymentInputV3!", args.Payment)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCompleteWithTokenizedPaymentV3PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCreateArgs holds the arguments of Mutation.checkoutCreate.
+type MutationCheckoutCreateArgs struct {
+	// Input: The fields used to create a checkout.
+	Input CheckoutCreateInput
+	// QueueToken: The checkout queue token. Available only to selected stores.
+	QueueToken *string
+}
+
+// CheckoutCreate selects checkoutCreate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutCreate(args MutationCheckoutCreateArgs, fn func(*CheckoutCreatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCreate")
+	f.arg("input", "CheckoutCreateInput!", args.Input)
+	if args.QueueToken != nil {
+		f.arg("queueToken", "String", *args.QueueToken)
+	}
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCreatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCustomerAssociateArgs holds the arguments of Mutation.checkoutCustomerAssociate.
+type MutationCheckoutCustomerAssociateArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// CustomerAccessToken: The customer access token of the customer to associate.
+	CustomerAccessToken string
+}
+
+// CheckoutCustomerAssociate selects checkoutCustomerAssociate, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutCustomerAssociateV2` instead
+func (s *MutationSelection) CheckoutCustomerAssociate(args MutationCheckoutCustomerAssociateArgs, fn func(*CheckoutCustomerAssociatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCustomerAssociate")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCustomerAssociatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCustomerAssociateV2Args holds the arguments of Mutation.checkoutCustomerAssociateV2.
+type MutationCheckoutCustomerAssociateV2Args struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// CustomerAccessToken: The customer access token of the customer to associate.
+	CustomerAccessToken string
+}
+
+// CheckoutCustomerAssociateV2 selects checkoutCustomerAssociateV2, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutCustomerAssociateV2(args MutationCheckoutCustomerAssociateV2Args, fn func(*CheckoutCustomerAssociateV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCustomerAssociateV2")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCustomerAssociateV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCustomerDisassociateArgs holds the arguments of Mutation.checkoutCustomerDisassociate.
+type MutationCheckoutCustomerDisassociateArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutCustomerDisassociate selects checkoutCustomerDisassociate, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutCustomerDisassociateV2` instead
+func (s *MutationSelection) CheckoutCustomerDisassociate(args MutationCheckoutCustomerDisassociateArgs, fn func(*CheckoutCustomerDisassociatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCustomerDisassociate")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCustomerDisassociatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutCustomerDisassociateV2Args holds the arguments of Mutation.checkoutCustomerDisassociateV2.
+type MutationCheckoutCustomerDisassociateV2Args struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutCustomerDisassociateV2 selects checkoutCustomerDisassociateV2, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutCustomerDisassociateV2(args MutationCheckoutCustomerDisassociateV2Args, fn func(*CheckoutCustomerDisassociateV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutCustomerDisassociateV2")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutCustomerDisassociateV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutDiscountCodeApplyArgs holds the arguments of Mutation.checkoutDiscountCodeApply.
+type MutationCheckoutDiscountCodeApplyArgs struct {
+	// DiscountCode: The discount code to apply to the checkout.
+	DiscountCode string
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutDiscountCodeApply selects checkoutDiscountCodeApply, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutDiscountCodeApplyV2` instead
+func (s *MutationSelection) CheckoutDiscountCodeApply(args MutationCheckoutDiscountCodeApplyArgs, fn func(*CheckoutDiscountCodeApplyPayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutDiscountCodeApply")
+	f.arg("discountCode", "String!", args.DiscountCode)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutDiscountCodeApplyPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutDiscountCodeApplyV2Args holds the arguments of Mutation.checkoutDiscountCodeApplyV2.
+type MutationCheckoutDiscountCodeApplyV2Args struct {
+	// DiscountCode: The discount code to apply to the checkout.
+	DiscountCode string
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutDiscountCodeApplyV2 selects checkoutDiscountCodeApplyV2, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutDiscountCodeApplyV2(args MutationCheckoutDiscountCodeApplyV2Args, fn func(*CheckoutDiscountCodeApplyV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutDiscountCodeApplyV2")
+	f.arg("discountCode", "String!", args.DiscountCode)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutDiscountCodeApplyV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutDiscountCodeRemoveArgs holds the arguments of Mutation.checkoutDiscountCodeRemove.
+type MutationCheckoutDiscountCodeRemoveArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutDiscountCodeRemove selects checkoutDiscountCodeRemove, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutDiscountCodeRemove(args MutationCheckoutDiscountCodeRemoveArgs, fn func(*CheckoutDiscountCodeRemovePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutDiscountCodeRemove")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutDiscountCodeRemovePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutEmailUpdateArgs holds the arguments of Mutation.checkoutEmailUpdate.
+type MutationCheckoutEmailUpdateArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Email: The email to update the checkout with.
+	Email string
+}
+
+// CheckoutEmailUpdate selects checkoutEmailUpdate, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutEmailUpdateV2` instead
+func (s *MutationSelection) CheckoutEmailUpdate(args MutationCheckoutEmailUpdateArgs, fn func(*CheckoutEmailUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutEmailUpdate")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("email", "String!", args.Email)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutEmailUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutEmailUpdateV2Args holds the arguments of Mutation.checkoutEmailUpdateV2.
+type MutationCheckoutEmailUpdateV2Args struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// Email: The email to update the checkout with.
+	Email string
+}
+
+// CheckoutEmailUpdateV2 selects checkoutEmailUpdateV2, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutEmailUpdateV2(args MutationCheckoutEmailUpdateV2Args, fn func(*CheckoutEmailUpdateV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutEmailUpdateV2")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("email", "String!", args.Email)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutEmailUpdateV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutGiftCardApplyArgs holds the arguments of Mutation.checkoutGiftCardApply.
+type MutationCheckoutGiftCardApplyArgs struct {
+	// GiftCardCode: The code of the gift card to apply on the checkout.
+	GiftCardCode string
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutGiftCardApply selects checkoutGiftCardApply, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutGiftCardsAppend` instead
+func (s *MutationSelection) CheckoutGiftCardApply(args MutationCheckoutGiftCardApplyArgs, fn func(*CheckoutGiftCardApplyPayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutGiftCardApply")
+	f.arg("giftCardCode", "String!", args.GiftCardCode)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutGiftCardApplyPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutGiftCardRemoveArgs holds the arguments of Mutation.checkoutGiftCardRemove.
+type MutationCheckoutGiftCardRemoveArgs struct {
+	// AppliedGiftCardId: The ID of the Applied Gift Card to remove from the Checkout.
+	AppliedGiftCardId string
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutGiftCardRemove selects checkoutGiftCardRemove, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutGiftCardRemoveV2` instead
+func (s *MutationSelection) CheckoutGiftCardRemove(args MutationCheckoutGiftCardRemoveArgs, fn func(*CheckoutGiftCardRemovePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutGiftCardRemove")
+	f.arg("appliedGiftCardId", "ID!", args.AppliedGiftCardId)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutGiftCardRemovePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutGiftCardRemoveV2Args holds the arguments of Mutation.checkoutGiftCardRemoveV2.
+type MutationCheckoutGiftCardRemoveV2Args struct {
+	// AppliedGiftCardId: The ID of the Applied Gift Card to remove from the Checkout.
+	AppliedGiftCardId string
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutGiftCardRemoveV2 selects checkoutGiftCardRemoveV2, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutGiftCardRemoveV2(args MutationCheckoutGiftCardRemoveV2Args, fn func(*CheckoutGiftCardRemoveV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutGiftCardRemoveV2")
+	f.arg("appliedGiftCardId", "ID!", args.AppliedGiftCardId)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutGiftCardRemoveV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutGiftCardsAppendArgs holds the arguments of Mutation.checkoutGiftCardsAppend.
+type MutationCheckoutGiftCardsAppendArgs struct {
+	// GiftCardCodes: A list of gift card codes to append to the checkout.
+	GiftCardCodes []string
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutGiftCardsAppend selects checkoutGiftCardsAppend, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutGiftCardsAppend(args MutationCheckoutGiftCardsAppendArgs, fn func(*CheckoutGiftCardsAppendPayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutGiftCardsAppend")
+	f.arg("giftCardCodes", "[String!]!", args.GiftCardCodes)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutGiftCardsAppendPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutLineItemsAddArgs holds the arguments of Mutation.checkoutLineItemsAdd.
+type MutationCheckoutLineItemsAddArgs struct {
+	// LineItems: A list of line item objects to add to the checkout.
+	LineItems []CheckoutLineItemInput
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutLineItemsAdd selects checkoutLineItemsAdd, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutLineItemsAdd(args MutationCheckoutLineItemsAddArgs, fn func(*CheckoutLineItemsAddPayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutLineItemsAdd")
+	f.arg("lineItems", "[CheckoutLineItemInput!]!", args.LineItems)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutLineItemsAddPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutLineItemsRemoveArgs holds the arguments of Mutation.checkoutLineItemsRemove.
+type MutationCheckoutLineItemsRemoveArgs struct {
+	// CheckoutId: The checkout on which to remove line items.
+	CheckoutId string
+	// LineItemIds: Line item ids to remove.
+	LineItemIds []string
+}
+
+// CheckoutLineItemsRemove selects checkoutLineItemsRemove, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutLineItemsRemove(args MutationCheckoutLineItemsRemoveArgs, fn func(*CheckoutLineItemsRemovePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutLineItemsRemove")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("lineItemIds", "[ID!]!", args.LineItemIds)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutLineItemsRemovePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutLineItemsReplaceArgs holds the arguments of Mutation.checkoutLineItemsReplace.
+type MutationCheckoutLineItemsReplaceArgs struct {
+	// LineItems: A list of line item objects to set on the checkout.
+	LineItems []CheckoutLineItemInput
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutLineItemsReplace selects checkoutLineItemsReplace, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutLineItemsReplace(args MutationCheckoutLineItemsReplaceArgs, fn func(*CheckoutLineItemsReplacePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutLineItemsReplace")
+	f.arg("lineItems", "[CheckoutLineItemInput!]!", args.LineItems)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutLineItemsReplacePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutLineItemsUpdateArgs holds the arguments of Mutation.checkoutLineItemsUpdate.
+type MutationCheckoutLineItemsUpdateArgs struct {
+	// CheckoutId: The checkout on which to update line items.
+	CheckoutId string
+	// LineItems: Line items to update.
+	LineItems []CheckoutLineItemUpdateInput
+}
+
+// CheckoutLineItemsUpdate selects checkoutLineItemsUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutLineItemsUpdate(args MutationCheckoutLineItemsUpdateArgs, fn func(*CheckoutLineItemsUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutLineItemsUpdate")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("lineItems", "[CheckoutLineItemUpdateInput!]!", args.LineItems)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutLineItemsUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutShippingAddressUpdateArgs holds the arguments of Mutation.checkoutShippingAddressUpdate.
+type MutationCheckoutShippingAddressUpdateArgs struct {
+	// ShippingAddress: The shipping address to where the line items will be shipped.
+	ShippingAddress MailingAddressInput
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutShippingAddressUpdate selects checkoutShippingAddressUpdate, passing args, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutShippingAddressUpdateV2` instead
+func (s *MutationSelection) CheckoutShippingAddressUpdate(args MutationCheckoutShippingAddressUpdateArgs, fn func(*CheckoutShippingAddressUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutShippingAddressUpdate")
+	f.arg("shippingAddress", "MailingAddressInput!", args.ShippingAddress)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutShippingAddressUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutShippingAddressUpdateV2Args holds the arguments of Mutation.checkoutShippingAddressUpdateV2.
+type MutationCheckoutShippingAddressUpdateV2Args struct {
+	// ShippingAddress: The shipping address to where the line items will be shipped.
+	ShippingAddress MailingAddressInput
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+}
+
+// CheckoutShippingAddressUpdateV2 selects checkoutShippingAddressUpdateV2, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutShippingAddressUpdateV2(args MutationCheckoutShippingAddressUpdateV2Args, fn func(*CheckoutShippingAddressUpdateV2PayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutShippingAddressUpdateV2")
+	f.arg("shippingAddress", "MailingAddressInput!", args.ShippingAddress)
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutShippingAddressUpdateV2PayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCheckoutShippingLineUpdateArgs holds the arguments of Mutation.checkoutShippingLineUpdate.
+type MutationCheckoutShippingLineUpdateArgs struct {
+	// CheckoutId: The ID of the checkout.
+	CheckoutId string
+	// ShippingRateHandle: A unique identifier to a Checkout’s shipping provider, price, and title combination, enabling the customer to select the availableShippingRates.
+	ShippingRateHandle string
+}
+
+// CheckoutShippingLineUpdate selects checkoutShippingLineUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CheckoutShippingLineUpdate(args MutationCheckoutShippingLineUpdateArgs, fn func(*CheckoutShippingLineUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("checkoutShippingLineUpdate")
+	f.arg("checkoutId", "ID!", args.CheckoutId)
+	f.arg("shippingRateHandle", "String!", args.ShippingRateHandle)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutShippingLineUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerAccessTokenCreateArgs holds the arguments of Mutation.customerAccessTokenCreate.
+type MutationCustomerAccessTokenCreateArgs struct {
+	// Input: The fields used to create a customer access token.
+	Input CustomerAccessTokenCreateInput
+}
+
+// CustomerAccessTokenCreate selects customerAccessTokenCreate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerAccessTokenCreate(args MutationCustomerAccessTokenCreateArgs, fn func(*CustomerAccessTokenCreatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerAccessTokenCreate")
+	f.arg("input", "CustomerAccessTokenCreateInput!", args.Input)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenCreatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerAccessTokenCreateWithMultipassArgs holds the arguments of Mutation.customerAccessTokenCreateWithMultipass.
+type MutationCustomerAccessTokenCreateWithMultipassArgs struct {
+	// MultipassToken: A valid multipass token to be authenticated.
+	MultipassToken string
+}
+
+// CustomerAccessTokenCreateWithMultipass selects customerAccessTokenCreateWithMultipass, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerAccessTokenCreateWithMultipass(args MutationCustomerAccessTokenCreateWithMultipassArgs, fn func(*CustomerAccessTokenCreateWithMultipassPayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerAccessTokenCreateWithMultipass")
+	f.arg("multipassToken", "String!", args.MultipassToken)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenCreateWithMultipassPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerAccessTokenDeleteArgs holds the arguments of Mutation.customerAccessTokenDelete.
+type MutationCustomerAccessTokenDeleteArgs struct {
+	// CustomerAccessToken: The access token used to identify the customer.
+	CustomerAccessToken string
+}
+
+// CustomerAccessTokenDelete selects customerAccessTokenDelete, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerAccessTokenDelete(args MutationCustomerAccessTokenDeleteArgs, fn func(*CustomerAccessTokenDeletePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerAccessTokenDelete")
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenDeletePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerAccessTokenRenewArgs holds the arguments of Mutation.customerAccessTokenRenew.
+type MutationCustomerAccessTokenRenewArgs struct {
+	// CustomerAccessToken: The access token used to identify the customer.
+	CustomerAccessToken string
+}
+
+// CustomerAccessTokenRenew selects customerAccessTokenRenew, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerAccessTokenRenew(args MutationCustomerAccessTokenRenewArgs, fn func(*CustomerAccessTokenRenewPayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerAccessTokenRenew")
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenRenewPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerActivateArgs holds the arguments of Mutation.customerActivate.
+type MutationCustomerActivateArgs struct {
+	// Id: Specifies the customer to activate.
+	Id string
+	// Input: The fields used to activate a customer.
+	Input CustomerActivateInput
+}
+
+// CustomerActivate selects customerActivate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerActivate(args MutationCustomerActivateArgs, fn func(*CustomerActivatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerActivate")
+	f.arg("id", "ID!", args.Id)
+	f.arg("input", "CustomerActivateInput!", args.Input)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerActivatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerActivateByURLArgs holds the arguments of Mutation.customerActivateByUrl.
+type MutationCustomerActivateByURLArgs struct {
+	// ActivationURL: The customer activation URL.
+	ActivationURL string
+	// Password: A new password set during activation.
+	Password string
+}
+
+// CustomerActivateByURL selects customerActivateByUrl, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerActivateByURL(args MutationCustomerActivateByURLArgs, fn func(*CustomerActivateByURLPayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerActivateByUrl")
+	f.arg("activationUrl", "URL!", args.ActivationURL)
+	f.arg("password", "String!", args.Password)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerActivateByURLPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerAddressCreateArgs holds the arguments of Mutation.customerAddressCreate.
+type MutationCustomerAddressCreateArgs struct {
+	// CustomerAccessToken: The access token used to identify the customer.
+	CustomerAccessToken string
+	// Address: The customer mailing address to create.
+	Address MailingAddressInput
+}
+
+// CustomerAddressCreate selects customerAddressCreate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerAddressCreate(args MutationCustomerAddressCreateArgs, fn func(*CustomerAddressCreatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerAddressCreate")
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+	f.arg("address", "MailingAddressInput!", args.Address)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAddressCreatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerAddressDeleteArgs holds the arguments of Mutation.customerAddressDelete.
+type MutationCustomerAddressDeleteArgs struct {
+	// Id: Specifies the address to delete.
+	Id string
+	// CustomerAccessToken: The access token used to identify the customer.
+	CustomerAccessToken string
+}
+
+// CustomerAddressDelete selects customerAddressDelete, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerAddressDelete(args MutationCustomerAddressDeleteArgs, fn func(*CustomerAddressDeletePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerAddressDelete")
+	f.arg("id", "ID!", args.Id)
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAddressDeletePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerAddressUpdateArgs holds the arguments of Mutation.customerAddressUpdate.
+type MutationCustomerAddressUpdateArgs struct {
+	// CustomerAccessToken: The access token used to identify the customer.
+	CustomerAccessToken string
+	// Id: Specifies the customer address to update.
+	Id string
+	// Address: The customer’s mailing address.
+	Address MailingAddressInput
+}
+
+// CustomerAddressUpdate selects customerAddressUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerAddressUpdate(args MutationCustomerAddressUpdateArgs, fn func(*CustomerAddressUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerAddressUpdate")
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+	f.arg("id", "ID!", args.Id)
+	f.arg("address", "MailingAddressInput!", args.Address)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAddressUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerCreateArgs holds the arguments of Mutation.customerCreate.
+type MutationCustomerCreateArgs struct {
+	// Input: The fields used to create a new customer.
+	Input CustomerCreateInput
+}
+
+// CustomerCreate selects customerCreate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerCreate(args MutationCustomerCreateArgs, fn func(*CustomerCreatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerCreate")
+	f.arg("input", "CustomerCreateInput!", args.Input)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerCreatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerDefaultAddressUpdateArgs holds the arguments of Mutation.customerDefaultAddressUpdate.
+type MutationCustomerDefaultAddressUpdateArgs struct {
+	// CustomerAccessToken: The access token used to identify the customer.
+	CustomerAccessToken string
+	// AddressId: ID of the address to set as the new default for the customer.
+	AddressId string
+}
+
+// CustomerDefaultAddressUpdate selects customerDefaultAddressUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerDefaultAddressUpdate(args MutationCustomerDefaultAddressUpdateArgs, fn func(*CustomerDefaultAddressUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerDefaultAddressUpdate")
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+	f.arg("addressId", "ID!", args.AddressId)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerDefaultAddressUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerRecoverArgs holds the arguments of Mutation.customerRecover.
+type MutationCustomerRecoverArgs struct {
+	// Email: The email address of the customer to recover.
+	Email string
+}
+
+// CustomerRecover selects customerRecover, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerRecover(args MutationCustomerRecoverArgs, fn func(*CustomerRecoverPayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerRecover")
+	f.arg("email", "String!", args.Email)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerRecoverPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerResetArgs holds the arguments of Mutation.customerReset.
+type MutationCustomerResetArgs struct {
+	// Id: Specifies the customer to reset.
+	Id string
+	// Input: The fields used to reset a customer’s password.
+	Input CustomerResetInput
+}
+
+// CustomerReset selects customerReset, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerReset(args MutationCustomerResetArgs, fn func(*CustomerResetPayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerReset")
+	f.arg("id", "ID!", args.Id)
+	f.arg("input", "CustomerResetInput!", args.Input)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerResetPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerResetByURLArgs holds the arguments of Mutation.customerResetByUrl.
+type MutationCustomerResetByURLArgs struct {
+	// ResetURL: The customer's reset password url.
+	ResetURL string
+	// Password: New password that will be set as part of the reset password process.
+	Password string
+}
+
+// CustomerResetByURL selects customerResetByUrl, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerResetByURL(args MutationCustomerResetByURLArgs, fn func(*CustomerResetByURLPayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerResetByUrl")
+	f.arg("resetUrl", "URL!", args.ResetURL)
+	f.arg("password", "String!", args.Password)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerResetByURLPayloadSelection{sel})
+	}
+
+	return s
+}
+
+// MutationCustomerUpdateArgs holds the arguments of Mutation.customerUpdate.
+type MutationCustomerUpdateArgs struct {
+	// CustomerAccessToken: The access token used to identify the customer.
+	CustomerAccessToken string
+	// Customer: The customer object input.
+	Customer CustomerUpdateInput
+}
+
+// CustomerUpdate selects customerUpdate, passing args, and the fields of it selected by fn.
+func (s *MutationSelection) CustomerUpdate(args MutationCustomerUpdateArgs, fn func(*CustomerUpdatePayloadSelection)) *MutationSelection {
+	f := s.sel.field("customerUpdate")
+	f.arg("customerAccessToken", "String!", args.CustomerAccessToken)
+	f.arg("customer", "CustomerUpdateInput!", args.Customer)
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUpdatePayloadSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *MutationSelection) Typename() *MutationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartAttributesUpdatePayloadSelection selects fields of CartAttributesUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartAttributesUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Cart selects cart, and the fields of it selected by fn.
+func (s *CartAttributesUpdatePayloadSelection) Cart(fn func(*CartSelection)) *CartAttributesUpdatePayloadSelection {
+	f := s.sel.field("cart")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CartAttributesUpdatePayloadSelection) UserErrors(fn func(*CartUserErrorSelection)) *CartAttributesUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartAttributesUpdatePayloadSelection) Typename() *CartAttributesUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartUserErrorSelection selects fields of CartUserError for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartUserErrorSelection struct {
+	sel *selection
+}
+
+// Code selects code.
+func (s *CartUserErrorSelection) Code() *CartUserErrorSelection {
+	s.sel.field("code")
+
+	return s
+}
+
+// Field selects field.
+func (s *CartUserErrorSelection) Field() *CartUserErrorSelection {
+	s.sel.field("field")
+
+	return s
+}
+
+// Message selects message.
+func (s *CartUserErrorSelection) Message() *CartUserErrorSelection {
+	s.sel.field("message")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartUserErrorSelection) Typename() *CartUserErrorSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// DisplayableErrorSelection selects fields of DisplayableError for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type DisplayableErrorSelection struct {
+	sel *selection
+}
+
+// Field selects field.
+func (s *DisplayableErrorSelection) Field() *DisplayableErrorSelection {
+	s.sel.field("field")
+
+	return s
+}
+
+// Message selects message.
+func (s *DisplayableErrorSelection) Message() *DisplayableErrorSelection {
+	s.sel.field("message")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *DisplayableErrorSelection) Typename() *DisplayableErrorSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// OnCartUserError selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a CartUserError. __typename is also selected, to tell
+// which type the value is.
+func (s *DisplayableErrorSelection) OnCartUserError(fn func(*CartUserErrorSelection)) *DisplayableErrorSelection {
+	sel := s.sel.fragment("CartUserError")
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// OnCheckoutUserError selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a CheckoutUserError. __typename is also selected, to tell
+// which type the value is.
+func (s *DisplayableErrorSelection) OnCheckoutUserError(fn func(*CheckoutUserErrorSelection)) *DisplayableErrorSelection {
+	sel := s.sel.fragment("CheckoutUserError")
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// OnUserError selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a UserError. __typename is also selected, to tell
+// which type the value is.
+func (s *DisplayableErrorSelection) OnUserError(fn func(*UserErrorSelection)) *DisplayableErrorSelection {
+	sel := s.sel.fragment("UserError")
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// OnCustomerUserError selects, by way of an inline fragment, the fields of the value
+// selected by fn if it's a CustomerUserError. __typename is also selected, to tell
+// which type the value is.
+func (s *DisplayableErrorSelection) OnCustomerUserError(fn func(*CustomerUserErrorSelection)) *DisplayableErrorSelection {
+	sel := s.sel.fragment("CustomerUserError")
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// CartBuyerIdentityUpdatePayloadSelection selects fields of CartBuyerIdentityUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartBuyerIdentityUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Cart selects cart, and the fields of it selected by fn.
+func (s *CartBuyerIdentityUpdatePayloadSelection) Cart(fn func(*CartSelection)) *CartBuyerIdentityUpdatePayloadSelection {
+	f := s.sel.field("cart")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CartBuyerIdentityUpdatePayloadSelection) UserErrors(fn func(*CartUserErrorSelection)) *CartBuyerIdentityUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartBuyerIdentityUpdatePayloadSelection) Typename() *CartBuyerIdentityUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartCreatePayloadSelection selects fields of CartCreatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartCreatePayloadSelection struct {
+	sel *selection
+}
+
+// Cart selects cart, and the fields of it selected by fn.
+func (s *CartCreatePayloadSelection) Cart(fn func(*CartSelection)) *CartCreatePayloadSelection {
+	f := s.sel.field("cart")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CartCreatePayloadSelection) UserErrors(fn func(*CartUserErrorSelection)) *CartCreatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartCreatePayloadSelection) Typename() *CartCreatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartDiscountCodesUpdatePayloadSelection selects fields of CartDiscountCodesUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartDiscountCodesUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Cart selects cart, and the fields of it selected by fn.
+func (s *CartDiscountCodesUpdatePayloadSelection) Cart(fn func(*CartSelection)) *CartDiscountCodesUpdatePayloadSelection {
+	f := s.sel.field("cart")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CartDiscountCodesUpdatePayloadSelection) UserErrors(fn func(*CartUserErrorSelection)) *CartDiscountCodesUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartDiscountCodesUpdatePayloadSelection) Typename() *CartDiscountCodesUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartLinesAddPayloadSelection selects fields of CartLinesAddPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartLinesAddPayloadSelection struct {
+	sel *selection
+}
+
+// Cart selects cart, and the fields of it selected by fn.
+func (s *CartLinesAddPayloadSelection) Cart(fn func(*CartSelection)) *CartLinesAddPayloadSelection {
+	f := s.sel.field("cart")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CartLinesAddPayloadSelection) UserErrors(fn func(*CartUserErrorSelection)) *CartLinesAddPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartLinesAddPayloadSelection) Typename() *CartLinesAddPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartLinesRemovePayloadSelection selects fields of CartLinesRemovePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartLinesRemovePayloadSelection struct {
+	sel *selection
+}
+
+// Cart selects cart, and the fields of it selected by fn.
+func (s *CartLinesRemovePayloadSelection) Cart(fn func(*CartSelection)) *CartLinesRemovePayloadSelection {
+	f := s.sel.field("cart")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CartLinesRemovePayloadSelection) UserErrors(fn func(*CartUserErrorSelection)) *CartLinesRemovePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartLinesRemovePayloadSelection) Typename() *CartLinesRemovePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartLinesUpdatePayloadSelection selects fields of CartLinesUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartLinesUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Cart selects cart, and the fields of it selected by fn.
+func (s *CartLinesUpdatePayloadSelection) Cart(fn func(*CartSelection)) *CartLinesUpdatePayloadSelection {
+	f := s.sel.field("cart")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CartLinesUpdatePayloadSelection) UserErrors(fn func(*CartUserErrorSelection)) *CartLinesUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartLinesUpdatePayloadSelection) Typename() *CartLinesUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartNoteUpdatePayloadSelection selects fields of CartNoteUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartNoteUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Cart selects cart, and the fields of it selected by fn.
+func (s *CartNoteUpdatePayloadSelection) Cart(fn func(*CartSelection)) *CartNoteUpdatePayloadSelection {
+	f := s.sel.field("cart")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CartNoteUpdatePayloadSelection) UserErrors(fn func(*CartUserErrorSelection)) *CartNoteUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CartUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartNoteUpdatePayloadSelection) Typename() *CartNoteUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutAttributesUpdatePayloadSelection selects fields of CheckoutAttributesUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutAttributesUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutAttributesUpdatePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutAttributesUpdatePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutAttributesUpdatePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutAttributesUpdatePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutAttributesUpdatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutAttributesUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutAttributesUpdatePayloadSelection) Typename() *CheckoutAttributesUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutUserErrorSelection selects fields of CheckoutUserError for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutUserErrorSelection struct {
+	sel *selection
+}
+
+// Code selects code.
+func (s *CheckoutUserErrorSelection) Code() *CheckoutUserErrorSelection {
+	s.sel.field("code")
+
+	return s
+}
+
+// Field selects field.
+func (s *CheckoutUserErrorSelection) Field() *CheckoutUserErrorSelection {
+	s.sel.field("field")
+
+	return s
+}
+
+// Message selects message.
+func (s *CheckoutUserErrorSelection) Message() *CheckoutUserErrorSelection {
+	s.sel.field("message")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutUserErrorSelection) Typename() *CheckoutUserErrorSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// UserErrorSelection selects fields of UserError for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type UserErrorSelection struct {
+	sel *selection
+}
+
+// Field selects field.
+func (s *UserErrorSelection) Field() *UserErrorSelection {
+	s.sel.field("field")
+
+	return s
+}
+
+// Message selects message.
+func (s *UserErrorSelection) Message() *UserErrorSelection {
+	s.sel.field("message")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *UserErrorSelection) Typename() *UserErrorSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutAttributesUpdateV2PayloadSelection selects fields of CheckoutAttributesUpdateV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutAttributesUpdateV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutAttributesUpdateV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutAttributesUpdateV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutAttributesUpdateV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutAttributesUpdateV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutAttributesUpdateV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutAttributesUpdateV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutAttributesUpdateV2PayloadSelection) Typename() *CheckoutAttributesUpdateV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCompleteFreePayloadSelection selects fields of CheckoutCompleteFreePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCompleteFreePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCompleteFreePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCompleteFreePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCompleteFreePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCompleteFreePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCompleteFreePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCompleteFreePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCompleteFreePayloadSelection) Typename() *CheckoutCompleteFreePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCompleteWithCreditCardPayloadSelection selects fields of CheckoutCompleteWithCreditCardPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCompleteWithCreditCardPayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithCreditCardPayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCompleteWithCreditCardPayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithCreditCardPayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCompleteWithCreditCardPayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Payment selects payment, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithCreditCardPayloadSelection) Payment(fn func(*PaymentSelection)) *CheckoutCompleteWithCreditCardPayloadSelection {
+	f := s.sel.field("payment")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PaymentSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCompleteWithCreditCardPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCompleteWithCreditCardPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCompleteWithCreditCardPayloadSelection) Typename() *CheckoutCompleteWithCreditCardPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// PaymentSelection selects fields of Payment for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type PaymentSelection struct {
+	sel *selection
+}
+
+// Amount selects amount.
+//
+// Deprecated: Use `amountV2` instead
+func (s *PaymentSelection) Amount() *PaymentSelection {
+	s.sel.field("amount")
+
+	return s
+}
+
+// AmountV2 selects amountV2, and the fields of it selected by fn.
+func (s *PaymentSelection) AmountV2(fn func(*MoneyV2Selection)) *PaymentSelection {
+	f := s.sel.field("amountV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// BillingAddress selects billingAddress, and the fields of it selected by fn.
+func (s *PaymentSelection) BillingAddress(fn func(*MailingAddressSelection)) *PaymentSelection {
+	f := s.sel.field("billingAddress")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressSelection{sel})
+	}
+
+	return s
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *PaymentSelection) Checkout(fn func(*CheckoutSelection)) *PaymentSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CreditCard selects creditCard, and the fields of it selected by fn.
+func (s *PaymentSelection) CreditCard(fn func(*CreditCardSelection)) *PaymentSelection {
+	f := s.sel.field("creditCard")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CreditCardSelection{sel})
+	}
+
+	return s
+}
+
+// ErrorMessage selects errorMessage.
+func (s *PaymentSelection) ErrorMessage() *PaymentSelection {
+	s.sel.field("errorMessage")
+
+	return s
+}
+
+// Id selects id.
+func (s *PaymentSelection) Id() *PaymentSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// IdempotencyKey selects idempotencyKey.
+func (s *PaymentSelection) IdempotencyKey() *PaymentSelection {
+	s.sel.field("idempotencyKey")
+
+	return s
+}
+
+// NextActionURL selects nextActionUrl.
+func (s *PaymentSelection) NextActionURL() *PaymentSelection {
+	s.sel.field("nextActionUrl")
+
+	return s
+}
+
+// Ready selects ready.
+func (s *PaymentSelection) Ready() *PaymentSelection {
+	s.sel.field("ready")
+
+	return s
+}
+
+// Test selects test.
+func (s *PaymentSelection) Test() *PaymentSelection {
+	s.sel.field("test")
+
+	return s
+}
+
+// Transaction selects transaction, and the fields of it selected by fn.
+func (s *PaymentSelection) Transaction(fn func(*TransactionSelection)) *PaymentSelection {
+	f := s.sel.field("transaction")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&TransactionSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *PaymentSelection) Typename() *PaymentSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CreditCardSelection selects fields of CreditCard for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CreditCardSelection struct {
+	sel *selection
+}
+
+// Brand selects brand.
+func (s *CreditCardSelection) Brand() *CreditCardSelection {
+	s.sel.field("brand")
+
+	return s
+}
+
+// ExpiryMonth selects expiryMonth.
+func (s *CreditCardSelection) ExpiryMonth() *CreditCardSelection {
+	s.sel.field("expiryMonth")
+
+	return s
+}
+
+// ExpiryYear selects expiryYear.
+func (s *CreditCardSelection) ExpiryYear() *CreditCardSelection {
+	s.sel.field("expiryYear")
+
+	return s
+}
+
+// FirstDigits selects firstDigits.
+func (s *CreditCardSelection) FirstDigits() *CreditCardSelection {
+	s.sel.field("firstDigits")
+
+	return s
+}
+
+// FirstName selects firstName.
+func (s *CreditCardSelection) FirstName() *CreditCardSelection {
+	s.sel.field("firstName")
+
+	return s
+}
+
+// LastDigits selects lastDigits.
+func (s *CreditCardSelection) LastDigits() *CreditCardSelection {
+	s.sel.field("lastDigits")
+
+	return s
+}
+
+// LastName selects lastName.
+func (s *CreditCardSelection) LastName() *CreditCardSelection {
+	s.sel.field("lastName")
+
+	return s
+}
+
+// MaskedNumber selects maskedNumber.
+func (s *CreditCardSelection) MaskedNumber() *CreditCardSelection {
+	s.sel.field("maskedNumber")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CreditCardSelection) Typename() *CreditCardSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// TransactionSelection selects fields of Transaction for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type TransactionSelection struct {
+	sel *selection
+}
+
+// Amount selects amount.
+//
+// Deprecated: Use `amountV2` instead
+func (s *TransactionSelection) Amount() *TransactionSelection {
+	s.sel.field("amount")
+
+	return s
+}
+
+// AmountV2 selects amountV2, and the fields of it selected by fn.
+func (s *TransactionSelection) AmountV2(fn func(*MoneyV2Selection)) *TransactionSelection {
+	f := s.sel.field("amountV2")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Kind selects kind.
+func (s *TransactionSelection) Kind() *TransactionSelection {
+	s.sel.field("kind")
+
+	return s
+}
+
+// Status selects status.
+//
+// Deprecated: Use `statusV2` instead
+func (s *TransactionSelection) Status() *TransactionSelection {
+	s.sel.field("status")
+
+	return s
+}
+
+// StatusV2 selects statusV2.
+func (s *TransactionSelection) StatusV2() *TransactionSelection {
+	s.sel.field("statusV2")
+
+	return s
+}
+
+// Test selects test.
+func (s *TransactionSelection) Test() *TransactionSelection {
+	s.sel.field("test")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *TransactionSelection) Typename() *TransactionSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCompleteWithCreditCardV2PayloadSelection selects fields of CheckoutCompleteWithCreditCardV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCompleteWithCreditCardV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithCreditCardV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCompleteWithCreditCardV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithCreditCardV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCompleteWithCreditCardV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Payment selects payment, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithCreditCardV2PayloadSelection) Payment(fn func(*PaymentSelection)) *CheckoutCompleteWithCreditCardV2PayloadSelection {
+	f := s.sel.field("payment")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PaymentSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCompleteWithCreditCardV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCompleteWithCreditCardV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCompleteWithCreditCardV2PayloadSelection) Typename() *CheckoutCompleteWithCreditCardV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCompleteWithTokenizedPaymentPayloadSelection selects fields of CheckoutCompleteWithTokenizedPaymentPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCompleteWithTokenizedPaymentPayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentPayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCompleteWithTokenizedPaymentPayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentPayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCompleteWithTokenizedPaymentPayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Payment selects payment, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentPayloadSelection) Payment(fn func(*PaymentSelection)) *CheckoutCompleteWithTokenizedPaymentPayloadSelection {
+	f := s.sel.field("payment")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PaymentSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCompleteWithTokenizedPaymentPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCompleteWithTokenizedPaymentPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCompleteWithTokenizedPaymentPayloadSelection) Typename() *CheckoutCompleteWithTokenizedPaymentPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCompleteWithTokenizedPaymentV2PayloadSelection selects fields of CheckoutCompleteWithTokenizedPaymentV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCompleteWithTokenizedPaymentV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Payment selects payment, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection) Payment(fn func(*PaymentSelection)) *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection {
+	f := s.sel.field("payment")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PaymentSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection) Typename() *CheckoutCompleteWithTokenizedPaymentV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCompleteWithTokenizedPaymentV3PayloadSelection selects fields of CheckoutCompleteWithTokenizedPaymentV3Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCompleteWithTokenizedPaymentV3PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Payment selects payment, and the fields of it selected by fn.
+func (s *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection) Payment(fn func(*PaymentSelection)) *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection {
+	f := s.sel.field("payment")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PaymentSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection) Typename() *CheckoutCompleteWithTokenizedPaymentV3PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCreatePayloadSelection selects fields of CheckoutCreatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCreatePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCreatePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCreatePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCreatePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCreatePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// QueueToken selects queueToken.
+func (s *CheckoutCreatePayloadSelection) QueueToken() *CheckoutCreatePayloadSelection {
+	s.sel.field("queueToken")
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCreatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCreatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCreatePayloadSelection) Typename() *CheckoutCreatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCustomerAssociatePayloadSelection selects fields of CheckoutCustomerAssociatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCustomerAssociatePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCustomerAssociatePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCustomerAssociatePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CheckoutCustomerAssociatePayloadSelection) Customer(fn func(*CustomerSelection)) *CheckoutCustomerAssociatePayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CheckoutCustomerAssociatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCustomerAssociatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCustomerAssociatePayloadSelection) Typename() *CheckoutCustomerAssociatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCustomerAssociateV2PayloadSelection selects fields of CheckoutCustomerAssociateV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCustomerAssociateV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCustomerAssociateV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCustomerAssociateV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCustomerAssociateV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCustomerAssociateV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CheckoutCustomerAssociateV2PayloadSelection) Customer(fn func(*CustomerSelection)) *CheckoutCustomerAssociateV2PayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCustomerAssociateV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCustomerAssociateV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCustomerAssociateV2PayloadSelection) Typename() *CheckoutCustomerAssociateV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCustomerDisassociatePayloadSelection selects fields of CheckoutCustomerDisassociatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCustomerDisassociatePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCustomerDisassociatePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCustomerDisassociatePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCustomerDisassociatePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCustomerDisassociatePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCustomerDisassociatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCustomerDisassociatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCustomerDisassociatePayloadSelection) Typename() *CheckoutCustomerDisassociatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutCustomerDisassociateV2PayloadSelection selects fields of CheckoutCustomerDisassociateV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutCustomerDisassociateV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutCustomerDisassociateV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutCustomerDisassociateV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutCustomerDisassociateV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutCustomerDisassociateV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutCustomerDisassociateV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutCustomerDisassociateV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutCustomerDisassociateV2PayloadSelection) Typename() *CheckoutCustomerDisassociateV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutDiscountCodeApplyPayloadSelection selects fields of CheckoutDiscountCodeApplyPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutDiscountCodeApplyPayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutDiscountCodeApplyPayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutDiscountCodeApplyPayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutDiscountCodeApplyPayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutDiscountCodeApplyPayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutDiscountCodeApplyPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutDiscountCodeApplyPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutDiscountCodeApplyPayloadSelection) Typename() *CheckoutDiscountCodeApplyPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutDiscountCodeApplyV2PayloadSelection selects fields of CheckoutDiscountCodeApplyV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutDiscountCodeApplyV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutDiscountCodeApplyV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutDiscountCodeApplyV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutDiscountCodeApplyV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutDiscountCodeApplyV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutDiscountCodeApplyV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutDiscountCodeApplyV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutDiscountCodeApplyV2PayloadSelection) Typename() *CheckoutDiscountCodeApplyV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutDiscountCodeRemovePayloadSelection selects fields of CheckoutDiscountCodeRemovePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutDiscountCodeRemovePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutDiscountCodeRemovePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutDiscountCodeRemovePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutDiscountCodeRemovePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutDiscountCodeRemovePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutDiscountCodeRemovePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutDiscountCodeRemovePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutDiscountCodeRemovePayloadSelection) Typename() *CheckoutDiscountCodeRemovePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutEmailUpdatePayloadSelection selects fields of CheckoutEmailUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutEmailUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutEmailUpdatePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutEmailUpdatePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutEmailUpdatePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutEmailUpdatePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutEmailUpdatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutEmailUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutEmailUpdatePayloadSelection) Typename() *CheckoutEmailUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutEmailUpdateV2PayloadSelection selects fields of CheckoutEmailUpdateV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutEmailUpdateV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutEmailUpdateV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutEmailUpdateV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutEmailUpdateV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutEmailUpdateV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutEmailUpdateV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutEmailUpdateV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutEmailUpdateV2PayloadSelection) Typename() *CheckoutEmailUpdateV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutGiftCardApplyPayloadSelection selects fields of CheckoutGiftCardApplyPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutGiftCardApplyPayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutGiftCardApplyPayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutGiftCardApplyPayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutGiftCardApplyPayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutGiftCardApplyPayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutGiftCardApplyPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutGiftCardApplyPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutGiftCardApplyPayloadSelection) Typename() *CheckoutGiftCardApplyPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutGiftCardRemovePayloadSelection selects fields of CheckoutGiftCardRemovePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutGiftCardRemovePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutGiftCardRemovePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutGiftCardRemovePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutGiftCardRemovePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutGiftCardRemovePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutGiftCardRemovePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutGiftCardRemovePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutGiftCardRemovePayloadSelection) Typename() *CheckoutGiftCardRemovePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutGiftCardRemoveV2PayloadSelection selects fields of CheckoutGiftCardRemoveV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutGiftCardRemoveV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutGiftCardRemoveV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutGiftCardRemoveV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutGiftCardRemoveV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutGiftCardRemoveV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutGiftCardRemoveV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutGiftCardRemoveV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutGiftCardRemoveV2PayloadSelection) Typename() *CheckoutGiftCardRemoveV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutGiftCardsAppendPayloadSelection selects fields of CheckoutGiftCardsAppendPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutGiftCardsAppendPayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutGiftCardsAppendPayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutGiftCardsAppendPayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutGiftCardsAppendPayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutGiftCardsAppendPayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutGiftCardsAppendPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutGiftCardsAppendPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutGiftCardsAppendPayloadSelection) Typename() *CheckoutGiftCardsAppendPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutLineItemsAddPayloadSelection selects fields of CheckoutLineItemsAddPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutLineItemsAddPayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutLineItemsAddPayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutLineItemsAddPayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutLineItemsAddPayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutLineItemsAddPayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutLineItemsAddPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutLineItemsAddPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutLineItemsAddPayloadSelection) Typename() *CheckoutLineItemsAddPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutLineItemsRemovePayloadSelection selects fields of CheckoutLineItemsRemovePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutLineItemsRemovePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutLineItemsRemovePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutLineItemsRemovePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutLineItemsRemovePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutLineItemsRemovePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutLineItemsRemovePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutLineItemsRemovePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutLineItemsRemovePayloadSelection) Typename() *CheckoutLineItemsRemovePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutLineItemsReplacePayloadSelection selects fields of CheckoutLineItemsReplacePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutLineItemsReplacePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutLineItemsReplacePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutLineItemsReplacePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CheckoutLineItemsReplacePayloadSelection) UserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutLineItemsReplacePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutLineItemsReplacePayloadSelection) Typename() *CheckoutLineItemsReplacePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutLineItemsUpdatePayloadSelection selects fields of CheckoutLineItemsUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutLineItemsUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutLineItemsUpdatePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutLineItemsUpdatePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutLineItemsUpdatePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutLineItemsUpdatePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutLineItemsUpdatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutLineItemsUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutLineItemsUpdatePayloadSelection) Typename() *CheckoutLineItemsUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutShippingAddressUpdatePayloadSelection selects fields of CheckoutShippingAddressUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutShippingAddressUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutShippingAddressUpdatePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutShippingAddressUpdatePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutShippingAddressUpdatePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutShippingAddressUpdatePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutShippingAddressUpdatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutShippingAddressUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutShippingAddressUpdatePayloadSelection) Typename() *CheckoutShippingAddressUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutShippingAddressUpdateV2PayloadSelection selects fields of CheckoutShippingAddressUpdateV2Payload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutShippingAddressUpdateV2PayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutShippingAddressUpdateV2PayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutShippingAddressUpdateV2PayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutShippingAddressUpdateV2PayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutShippingAddressUpdateV2PayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutShippingAddressUpdateV2PayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutShippingAddressUpdateV2PayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutShippingAddressUpdateV2PayloadSelection) Typename() *CheckoutShippingAddressUpdateV2PayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CheckoutShippingLineUpdatePayloadSelection selects fields of CheckoutShippingLineUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CheckoutShippingLineUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Checkout selects checkout, and the fields of it selected by fn.
+func (s *CheckoutShippingLineUpdatePayloadSelection) Checkout(fn func(*CheckoutSelection)) *CheckoutShippingLineUpdatePayloadSelection {
+	f := s.sel.field("checkout")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutSelection{sel})
+	}
+
+	return s
+}
+
+// CheckoutUserErrors selects checkoutUserErrors, and the fields of it selected by fn.
+func (s *CheckoutShippingLineUpdatePayloadSelection) CheckoutUserErrors(fn func(*CheckoutUserErrorSelection)) *CheckoutShippingLineUpdatePayloadSelection {
+	f := s.sel.field("checkoutUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CheckoutUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `checkoutUserErrors` instead
+func (s *CheckoutShippingLineUpdatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CheckoutShippingLineUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CheckoutShippingLineUpdatePayloadSelection) Typename() *CheckoutShippingLineUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerAccessTokenCreatePayloadSelection selects fields of CustomerAccessTokenCreatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerAccessTokenCreatePayloadSelection struct {
+	sel *selection
+}
+
+// CustomerAccessToken selects customerAccessToken, and the fields of it selected by fn.
+func (s *CustomerAccessTokenCreatePayloadSelection) CustomerAccessToken(fn func(*CustomerAccessTokenSelection)) *CustomerAccessTokenCreatePayloadSelection {
+	f := s.sel.field("customerAccessToken")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerAccessTokenCreatePayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerAccessTokenCreatePayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerAccessTokenCreatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerAccessTokenCreatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerAccessTokenCreatePayloadSelection) Typename() *CustomerAccessTokenCreatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerAccessTokenSelection selects fields of CustomerAccessToken for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerAccessTokenSelection struct {
+	sel *selection
+}
+
+// AccessToken selects accessToken.
+func (s *CustomerAccessTokenSelection) AccessToken() *CustomerAccessTokenSelection {
+	s.sel.field("accessToken")
+
+	return s
+}
+
+// ExpiresAt selects expiresAt.
+func (s *CustomerAccessTokenSelection) ExpiresAt() *CustomerAccessTokenSelection {
+	s.sel.field("expiresAt")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerAccessTokenSelection) Typename() *CustomerAccessTokenSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerUserErrorSelection selects fields of CustomerUserError for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerUserErrorSelection struct {
+	sel *selection
+}
+
+// Code selects code.
+func (s *CustomerUserErrorSelection) Code() *CustomerUserErrorSelection {
+	s.sel.field("code")
+
+	return s
+}
+
+// Field selects field.
+func (s *CustomerUserErrorSelection) Field() *CustomerUserErrorSelection {
+	s.sel.field("field")
+
+	return s
+}
+
+// Message selects message.
+func (s *CustomerUserErrorSelection) Message() *CustomerUserErrorSelection {
+	s.sel.field("message")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerUserErrorSelection) Typename() *CustomerUserErrorSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerAccessTokenCreateWithMultipassPayloadSelection selects fields of CustomerAccessTokenCreateWithMultipassPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerAccessTokenCreateWithMultipassPayloadSelection struct {
+	sel *selection
+}
+
+// CustomerAccessToken selects customerAccessToken, and the fields of it selected by fn.
+func (s *CustomerAccessTokenCreateWithMultipassPayloadSelection) CustomerAccessToken(fn func(*CustomerAccessTokenSelection)) *CustomerAccessTokenCreateWithMultipassPayloadSelection {
+	f := s.sel.field("customerAccessToken")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerAccessTokenCreateWithMultipassPayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerAccessTokenCreateWithMultipassPayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerAccessTokenCreateWithMultipassPayloadSelection) Typename() *CustomerAccessTokenCreateWithMultipassPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerAccessTokenDeletePayloadSelection selects fields of CustomerAccessTokenDeletePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerAccessTokenDeletePayloadSelection struct {
+	sel *selection
+}
+
+// DeletedAccessToken selects deletedAccessToken.
+func (s *CustomerAccessTokenDeletePayloadSelection) DeletedAccessToken() *CustomerAccessTokenDeletePayloadSelection {
+	s.sel.field("deletedAccessToken")
+
+	return s
+}
+
+// DeletedCustomerAccessTokenId selects deletedCustomerAccessTokenId.
+func (s *CustomerAccessTokenDeletePayloadSelection) DeletedCustomerAccessTokenId() *CustomerAccessTokenDeletePayloadSelection {
+	s.sel.field("deletedCustomerAccessTokenId")
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CustomerAccessTokenDeletePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerAccessTokenDeletePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerAccessTokenDeletePayloadSelection) Typename() *CustomerAccessTokenDeletePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerAccessTokenRenewPayloadSelection selects fields of CustomerAccessTokenRenewPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerAccessTokenRenewPayloadSelection struct {
+	sel *selection
+}
+
+// CustomerAccessToken selects customerAccessToken, and the fields of it selected by fn.
+func (s *CustomerAccessTokenRenewPayloadSelection) CustomerAccessToken(fn func(*CustomerAccessTokenSelection)) *CustomerAccessTokenRenewPayloadSelection {
+	f := s.sel.field("customerAccessToken")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+func (s *CustomerAccessTokenRenewPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerAccessTokenRenewPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerAccessTokenRenewPayloadSelection) Typename() *CustomerAccessTokenRenewPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerActivatePayloadSelection selects fields of CustomerActivatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerActivatePayloadSelection struct {
+	sel *selection
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CustomerActivatePayloadSelection) Customer(fn func(*CustomerSelection)) *CustomerActivatePayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerAccessToken selects customerAccessToken, and the fields of it selected by fn.
+func (s *CustomerActivatePayloadSelection) CustomerAccessToken(fn func(*CustomerAccessTokenSelection)) *CustomerActivatePayloadSelection {
+	f := s.sel.field("customerAccessToken")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerActivatePayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerActivatePayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerActivatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerActivatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerActivatePayloadSelection) Typename() *CustomerActivatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerActivateByURLPayloadSelection selects fields of CustomerActivateByURLPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerActivateByURLPayloadSelection struct {
+	sel *selection
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CustomerActivateByURLPayloadSelection) Customer(fn func(*CustomerSelection)) *CustomerActivateByURLPayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerAccessToken selects customerAccessToken, and the fields of it selected by fn.
+func (s *CustomerActivateByURLPayloadSelection) CustomerAccessToken(fn func(*CustomerAccessTokenSelection)) *CustomerActivateByURLPayloadSelection {
+	f := s.sel.field("customerAccessToken")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerActivateByURLPayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerActivateByURLPayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerActivateByURLPayloadSelection) Typename() *CustomerActivateByURLPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerAddressCreatePayloadSelection selects fields of CustomerAddressCreatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerAddressCreatePayloadSelection struct {
+	sel *selection
+}
+
+// CustomerAddress selects customerAddress, and the fields of it selected by fn.
+func (s *CustomerAddressCreatePayloadSelection) CustomerAddress(fn func(*MailingAddressSelection)) *CustomerAddressCreatePayloadSelection {
+	f := s.sel.field("customerAddress")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerAddressCreatePayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerAddressCreatePayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerAddressCreatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerAddressCreatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerAddressCreatePayloadSelection) Typename() *CustomerAddressCreatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerAddressDeletePayloadSelection selects fields of CustomerAddressDeletePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerAddressDeletePayloadSelection struct {
+	sel *selection
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerAddressDeletePayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerAddressDeletePayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// DeletedCustomerAddressId selects deletedCustomerAddressId.
+func (s *CustomerAddressDeletePayloadSelection) DeletedCustomerAddressId() *CustomerAddressDeletePayloadSelection {
+	s.sel.field("deletedCustomerAddressId")
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerAddressDeletePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerAddressDeletePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerAddressDeletePayloadSelection) Typename() *CustomerAddressDeletePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerAddressUpdatePayloadSelection selects fields of CustomerAddressUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerAddressUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// CustomerAddress selects customerAddress, and the fields of it selected by fn.
+func (s *CustomerAddressUpdatePayloadSelection) CustomerAddress(fn func(*MailingAddressSelection)) *CustomerAddressUpdatePayloadSelection {
+	f := s.sel.field("customerAddress")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MailingAddressSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerAddressUpdatePayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerAddressUpdatePayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerAddressUpdatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerAddressUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerAddressUpdatePayloadSelection) Typename() *CustomerAddressUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerCreatePayloadSelection selects fields of CustomerCreatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerCreatePayloadSelection struct {
+	sel *selection
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CustomerCreatePayloadSelection) Customer(fn func(*CustomerSelection)) *CustomerCreatePayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerCreatePayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerCreatePayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerCreatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerCreatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerCreatePayloadSelection) Typename() *CustomerCreatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerDefaultAddressUpdatePayloadSelection selects fields of CustomerDefaultAddressUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerDefaultAddressUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CustomerDefaultAddressUpdatePayloadSelection) Customer(fn func(*CustomerSelection)) *CustomerDefaultAddressUpdatePayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerDefaultAddressUpdatePayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerDefaultAddressUpdatePayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerDefaultAddressUpdatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerDefaultAddressUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerDefaultAddressUpdatePayloadSelection) Typename() *CustomerDefaultAddressUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerRecoverPayloadSelection selects fields of CustomerRecoverPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerRecoverPayloadSelection struct {
+	sel *selection
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerRecoverPayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerRecoverPayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerRecoverPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerRecoverPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerRecoverPayloadSelection) Typename() *CustomerRecoverPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerResetPayloadSelection selects fields of CustomerResetPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerResetPayloadSelection struct {
+	sel *selection
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CustomerResetPayloadSelection) Customer(fn func(*CustomerSelection)) *CustomerResetPayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerAccessToken selects customerAccessToken, and the fields of it selected by fn.
+func (s *CustomerResetPayloadSelection) CustomerAccessToken(fn func(*CustomerAccessTokenSelection)) *CustomerResetPayloadSelection {
+	f := s.sel.field("customerAccessToken")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerResetPayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerResetPayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerResetPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerResetPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerResetPayloadSelection) Typename() *CustomerResetPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerResetByURLPayloadSelection selects fields of CustomerResetByURLPayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerResetByURLPayloadSelection struct {
+	sel *selection
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CustomerResetByURLPayloadSelection) Customer(fn func(*CustomerSelection)) *CustomerResetByURLPayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerAccessToken selects customerAccessToken, and the fields of it selected by fn.
+func (s *CustomerResetByURLPayloadSelection) CustomerAccessToken(fn func(*CustomerAccessTokenSelection)) *CustomerResetByURLPayloadSelection {
+	f := s.sel.field("customerAccessToken")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerResetByURLPayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerResetByURLPayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerResetByURLPayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerResetByURLPayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerResetByURLPayloadSelection) Typename() *CustomerResetByURLPayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CustomerUpdatePayloadSelection selects fields of CustomerUpdatePayload for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CustomerUpdatePayloadSelection struct {
+	sel *selection
+}
+
+// Customer selects customer, and the fields of it selected by fn.
+func (s *CustomerUpdatePayloadSelection) Customer(fn func(*CustomerSelection)) *CustomerUpdatePayloadSelection {
+	f := s.sel.field("customer")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerAccessToken selects customerAccessToken, and the fields of it selected by fn.
+func (s *CustomerUpdatePayloadSelection) CustomerAccessToken(fn func(*CustomerAccessTokenSelection)) *CustomerUpdatePayloadSelection {
+	f := s.sel.field("customerAccessToken")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerAccessTokenSelection{sel})
+	}
+
+	return s
+}
+
+// CustomerUserErrors selects customerUserErrors, and the fields of it selected by fn.
+func (s *CustomerUpdatePayloadSelection) CustomerUserErrors(fn func(*CustomerUserErrorSelection)) *CustomerUpdatePayloadSelection {
+	f := s.sel.field("customerUserErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&CustomerUserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// UserErrors selects userErrors, and the fields of it selected by fn.
+//
+// Deprecated: Use `customerUserErrors` instead
+func (s *CustomerUpdatePayloadSelection) UserErrors(fn func(*UserErrorSelection)) *CustomerUpdatePayloadSelection {
+	f := s.sel.field("userErrors")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&UserErrorSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CustomerUpdatePayloadSelection) Typename() *CustomerUpdatePayloadSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// AutomaticDiscountApplicationSelection selects fields of AutomaticDiscountApplication for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type AutomaticDiscountApplicationSelection struct {
+	sel *selection
+}
+
+// AllocationMethod selects allocationMethod.
+func (s *AutomaticDiscountApplicationSelection) AllocationMethod() *AutomaticDiscountApplicationSelection {
+	s.sel.field("allocationMethod")
+
+	return s
+}
+
+// TargetSelection selects targetSelection.
+func (s *AutomaticDiscountApplicationSelection) TargetSelection() *AutomaticDiscountApplicationSelection {
+	s.sel.field("targetSelection")
+
+	return s
+}
+
+// TargetType selects targetType.
+func (s *AutomaticDiscountApplicationSelection) TargetType() *AutomaticDiscountApplicationSelection {
+	s.sel.field("targetType")
+
+	return s
+}
+
+// Title selects title.
+func (s *AutomaticDiscountApplicationSelection) Title() *AutomaticDiscountApplicationSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Value selects value, and the fields of it selected by fn.
+func (s *AutomaticDiscountApplicationSelection) Value(fn func(*PricingValueSelection)) *AutomaticDiscountApplicationSelection {
+	f := s.sel.field("value")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PricingValueSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *AutomaticDiscountApplicationSelection) Typename() *AutomaticDiscountApplicationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartAutomaticDiscountAllocationSelection selects fields of CartAutomaticDiscountAllocation for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartAutomaticDiscountAllocationSelection struct {
+	sel *selection
+}
+
+// DiscountedAmount selects discountedAmount, and the fields of it selected by fn.
+func (s *CartAutomaticDiscountAllocationSelection) DiscountedAmount(fn func(*MoneyV2Selection)) *CartAutomaticDiscountAllocationSelection {
+	f := s.sel.field("discountedAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Title selects title.
+func (s *CartAutomaticDiscountAllocationSelection) Title() *CartAutomaticDiscountAllocationSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartAutomaticDiscountAllocationSelection) Typename() *CartAutomaticDiscountAllocationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// CartCodeDiscountAllocationSelection selects fields of CartCodeDiscountAllocation for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type CartCodeDiscountAllocationSelection struct {
+	sel *selection
+}
+
+// Code selects code.
+func (s *CartCodeDiscountAllocationSelection) Code() *CartCodeDiscountAllocationSelection {
+	s.sel.field("code")
+
+	return s
+}
+
+// DiscountedAmount selects discountedAmount, and the fields of it selected by fn.
+func (s *CartCodeDiscountAllocationSelection) DiscountedAmount(fn func(*MoneyV2Selection)) *CartCodeDiscountAllocationSelection {
+	f := s.sel.field("discountedAmount")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&MoneyV2Selection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *CartCodeDiscountAllocationSelection) Typename() *CartCodeDiscountAllocationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// DiscountCodeApplicationSelection selects fields of DiscountCodeApplication for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type DiscountCodeApplicationSelection struct {
+	sel *selection
+}
+
+// AllocationMethod selects allocationMethod.
+func (s *DiscountCodeApplicationSelection) AllocationMethod() *DiscountCodeApplicationSelection {
+	s.sel.field("allocationMethod")
+
+	return s
+}
+
+// Applicable selects applicable.
+func (s *DiscountCodeApplicationSelection) Applicable() *DiscountCodeApplicationSelection {
+	s.sel.field("applicable")
+
+	return s
+}
+
+// Code selects code.
+func (s *DiscountCodeApplicationSelection) Code() *DiscountCodeApplicationSelection {
+	s.sel.field("code")
+
+	return s
+}
+
+// TargetSelection selects targetSelection.
+func (s *DiscountCodeApplicationSelection) TargetSelection() *DiscountCodeApplicationSelection {
+	s.sel.field("targetSelection")
+
+	return s
+}
+
+// TargetType selects targetType.
+func (s *DiscountCodeApplicationSelection) TargetType() *DiscountCodeApplicationSelection {
+	s.sel.field("targetType")
+
+	return s
+}
+
+// Value selects value, and the fields of it selected by fn.
+func (s *DiscountCodeApplicationSelection) Value(fn func(*PricingValueSelection)) *DiscountCodeApplicationSelection {
+	f := s.sel.field("value")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PricingValueSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *DiscountCodeApplicationSelection) Typename() *DiscountCodeApplicationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ExternalVideoSelection selects fields of ExternalVideo for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ExternalVideoSelection struct {
+	sel *selection
+}
+
+// Alt selects alt.
+func (s *ExternalVideoSelection) Alt() *ExternalVideoSelection {
+	s.sel.field("alt")
+
+	return s
+}
+
+// EmbeddedURL selects embeddedUrl.
+//
+// Deprecated: Use `originUrl` instead
+func (s *ExternalVideoSelection) EmbeddedURL() *ExternalVideoSelection {
+	s.sel.field("embeddedUrl")
+
+	return s
+}
+
+// Host selects host.
+func (s *ExternalVideoSelection) Host() *ExternalVideoSelection {
+	s.sel.field("host")
+
+	return s
+}
+
+// Id selects id.
+func (s *ExternalVideoSelection) Id() *ExternalVideoSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// MediaContentType selects mediaContentType.
+func (s *ExternalVideoSelection) MediaContentType() *ExternalVideoSelection {
+	s.sel.field("mediaContentType")
+
+	return s
+}
+
+// PreviewImage selects previewImage, and the fields of it selected by fn.
+func (s *ExternalVideoSelection) PreviewImage(fn func(*ImageSelection)) *ExternalVideoSelection {
+	f := s.sel.field("previewImage")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ExternalVideoSelection) Typename() *ExternalVideoSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ManualDiscountApplicationSelection selects fields of ManualDiscountApplication for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ManualDiscountApplicationSelection struct {
+	sel *selection
+}
+
+// AllocationMethod selects allocationMethod.
+func (s *ManualDiscountApplicationSelection) AllocationMethod() *ManualDiscountApplicationSelection {
+	s.sel.field("allocationMethod")
+
+	return s
+}
+
+// Description selects description.
+func (s *ManualDiscountApplicationSelection) Description() *ManualDiscountApplicationSelection {
+	s.sel.field("description")
+
+	return s
+}
+
+// TargetSelection selects targetSelection.
+func (s *ManualDiscountApplicationSelection) TargetSelection() *ManualDiscountApplicationSelection {
+	s.sel.field("targetSelection")
+
+	return s
+}
+
+// TargetType selects targetType.
+func (s *ManualDiscountApplicationSelection) TargetType() *ManualDiscountApplicationSelection {
+	s.sel.field("targetType")
+
+	return s
+}
+
+// Title selects title.
+func (s *ManualDiscountApplicationSelection) Title() *ManualDiscountApplicationSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Value selects value, and the fields of it selected by fn.
+func (s *ManualDiscountApplicationSelection) Value(fn func(*PricingValueSelection)) *ManualDiscountApplicationSelection {
+	f := s.sel.field("value")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PricingValueSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ManualDiscountApplicationSelection) Typename() *ManualDiscountApplicationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// Model3DSelection selects fields of Model3D for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type Model3DSelection struct {
+	sel *selection
+}
+
+// Alt selects alt.
+func (s *Model3DSelection) Alt() *Model3DSelection {
+	s.sel.field("alt")
+
+	return s
+}
+
+// Id selects id.
+func (s *Model3DSelection) Id() *Model3DSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// MediaContentType selects mediaContentType.
+func (s *Model3DSelection) MediaContentType() *Model3DSelection {
+	s.sel.field("mediaContentType")
+
+	return s
+}
+
+// PreviewImage selects previewImage, and the fields of it selected by fn.
+func (s *Model3DSelection) PreviewImage(fn func(*ImageSelection)) *Model3DSelection {
+	f := s.sel.field("previewImage")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// Sources selects sources, and the fields of it selected by fn.
+func (s *Model3DSelection) Sources(fn func(*Model3DSourceSelection)) *Model3DSelection {
+	f := s.sel.field("sources")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&Model3DSourceSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *Model3DSelection) Typename() *Model3DSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// Model3DSourceSelection selects fields of Model3DSource for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type Model3DSourceSelection struct {
+	sel *selection
+}
+
+// Filesize selects filesize.
+func (s *Model3DSourceSelection) Filesize() *Model3DSourceSelection {
+	s.sel.field("filesize")
+
+	return s
+}
+
+// Format selects format.
+func (s *Model3DSourceSelection) Format() *Model3DSourceSelection {
+	s.sel.field("format")
+
+	return s
+}
+
+// MimeType selects mimeType.
+func (s *Model3DSourceSelection) MimeType() *Model3DSourceSelection {
+	s.sel.field("mimeType")
+
+	return s
+}
+
+// URL selects url.
+func (s *Model3DSourceSelection) URL() *Model3DSourceSelection {
+	s.sel.field("url")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *Model3DSourceSelection) Typename() *Model3DSourceSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// ScriptDiscountApplicationSelection selects fields of ScriptDiscountApplication for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type ScriptDiscountApplicationSelection struct {
+	sel *selection
+}
+
+// AllocationMethod selects allocationMethod.
+func (s *ScriptDiscountApplicationSelection) AllocationMethod() *ScriptDiscountApplicationSelection {
+	s.sel.field("allocationMethod")
+
+	return s
+}
+
+// TargetSelection selects targetSelection.
+func (s *ScriptDiscountApplicationSelection) TargetSelection() *ScriptDiscountApplicationSelection {
+	s.sel.field("targetSelection")
+
+	return s
+}
+
+// TargetType selects targetType.
+func (s *ScriptDiscountApplicationSelection) TargetType() *ScriptDiscountApplicationSelection {
+	s.sel.field("targetType")
+
+	return s
+}
+
+// Title selects title.
+func (s *ScriptDiscountApplicationSelection) Title() *ScriptDiscountApplicationSelection {
+	s.sel.field("title")
+
+	return s
+}
+
+// Value selects value, and the fields of it selected by fn.
+func (s *ScriptDiscountApplicationSelection) Value(fn func(*PricingValueSelection)) *ScriptDiscountApplicationSelection {
+	f := s.sel.field("value")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&PricingValueSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *ScriptDiscountApplicationSelection) Typename() *ScriptDiscountApplicationSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// VideoSelection selects fields of Video for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type VideoSelection struct {
+	sel *selection
+}
+
+// Alt selects alt.
+func (s *VideoSelection) Alt() *VideoSelection {
+	s.sel.field("alt")
+
+	return s
+}
+
+// Id selects id.
+func (s *VideoSelection) Id() *VideoSelection {
+	s.sel.field("id")
+
+	return s
+}
+
+// MediaContentType selects mediaContentType.
+func (s *VideoSelection) MediaContentType() *VideoSelection {
+	s.sel.field("mediaContentType")
+
+	return s
+}
+
+// PreviewImage selects previewImage, and the fields of it selected by fn.
+func (s *VideoSelection) PreviewImage(fn func(*ImageSelection)) *VideoSelection {
+	f := s.sel.field("previewImage")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&ImageSelection{sel})
+	}
+
+	return s
+}
+
+// Sources selects sources, and the fields of it selected by fn.
+func (s *VideoSelection) Sources(fn func(*VideoSourceSelection)) *VideoSelection {
+	f := s.sel.field("sources")
+
+	sel := f.selection()
+	if fn != nil {
+		fn(&VideoSourceSelection{sel})
+	}
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *VideoSelection) Typename() *VideoSelection {
+	s.sel.field("__typename")
+	return s
+}
+
+// VideoSourceSelection selects fields of VideoSource for a query built with NewQuery or
+// NewMutation. Each of its methods adds to the selection and returns it, so
+// that calls can be chained.
+type VideoSourceSelection struct {
+	sel *selection
+}
+
+// Format selects format.
+func (s *VideoSourceSelection) Format() *VideoSourceSelection {
+	s.sel.field("format")
+
+	return s
+}
+
+// Height selects height.
+func (s *VideoSourceSelection) Height() *VideoSourceSelection {
+	s.sel.field("height")
+
+	return s
+}
+
+// MimeType selects mimeType.
+func (s *VideoSourceSelection) MimeType() *VideoSourceSelection {
+	s.sel.field("mimeType")
+
+	return s
+}
+
+// URL selects url.
+func (s *VideoSourceSelection) URL() *VideoSourceSelection {
+	s.sel.field("url")
+
+	return s
+}
+
+// Width selects width.
+func (s *VideoSourceSelection) Width() *VideoSourceSelection {
+	s.sel.field("width")
+
+	return s
+}
+
+// Typename selects __typename, the name of the object type of the value.
+func (s *VideoSourceSelection) Typename() *VideoSourceSelection {
+	s.sel.field("__typename")
+	return s
+}
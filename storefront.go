@@ -1,20 +1,43 @@
 package storefront
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"path"
-	"strings"
+	"regexp"
 	"time"
 )
 
-// APIVersion is the Shopify Storefront API version. This value should match
-// that of the generated types.
-var APIVersion = "2022-01"
+// APIVersion is the Shopify Storefront API version clients target by default.
+// It defaults to SchemaVersion, the version of the generated types.
+//
+// Deprecated: Changing APIVersion affects every client constructed afterward.
+// Use WithAPIVersion to set the version of an individual client instead.
+var APIVersion = SchemaVersion
+
+// ErrInvalidAPIVersion indicates that an API version isn't of the form
+// YYYY-MM, naming a quarterly release, or "unstable".
+var ErrInvalidAPIVersion = errors.New("storefront: invalid API version")
+
+// apiVersionRgx matches the names of quarterly API versions.
+var apiVersionRgx = regexp.MustCompile(`^\d{4}-(01|04|07|10)$`)
+
+// validateAPIVersion returns an error wrapping ErrInvalidAPIVersion if version
+// doesn't name a possible Storefront API version.
+func validateAPIVersion(version string) error {
+	if version != "unstable" && !apiVersionRgx.MatchString(version) {
+		return fmt.Errorf("%w: %q", ErrInvalidAPIVersion, version)
+	}
+
+	return nil
+}
 
 // Set describes the full result set from a query. It encloses multiple result
 // sets within an enclosed data property.
@@ -29,7 +52,69 @@ type Connection[T any] struct {
 	// Edges is a list edges.
 	Edges []Edge[T] `json:"edges,omitempty"`
 	// PageInfo is a set of data to aid in pagination.
-	PageInfo map[string]interface{} `json:"pageInfo,omitempty"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// Nodes returns the node of each of c's edges.
+func (c Connection[T]) Nodes() []T {
+	nodes := make([]T, len(c.Edges))
+	for i, e := range c.Edges {
+		nodes[i] = e.Node
+	}
+
+	return nodes
+}
+
+// FirstCursor returns the cursor from which to fetch the page preceding c, for
+// use as the before argument. It's PageInfo.StartCursor if that was selected,
+// or else the cursor of the first edge. It's empty if neither is available.
+func (c Connection[T]) FirstCursor() string {
+	if c.PageInfo.StartCursor != nil {
+		return *c.PageInfo.StartCursor
+	}
+
+	if len(c.Edges) == 0 {
+		return ""
+	}
+
+	return c.Edges[0].Cursor
+}
+
+// LastCursor returns the cursor from which to fetch the page following c, for
+// use as the after argument. It's PageInfo.EndCursor if that was selected, or
+// else the cursor of the last edge. It's empty if neither is available.
+func (c Connection[T]) LastCursor() string {
+	if c.PageInfo.EndCursor != nil {
+		return *c.PageInfo.EndCursor
+	}
+
+	if len(c.Edges) == 0 {
+		return ""
+	}
+
+	return c.Edges[len(c.Edges)-1].Cursor
+}
+
+// HasNext reports whether there are more pages following c. It requires
+// pageInfo { hasNextPage } to have been selected.
+func (c Connection[T]) HasNext() bool {
+	return c.PageInfo.HasNextPage
+}
+
+// PageInfo describes information about pagination in a connection, in
+// accordance with the Relay specification.
+type PageInfo struct {
+	// HasNextPage is whether there are more pages to fetch following the
+	// current page.
+	HasNextPage bool `json:"hasNextPage"`
+	// HasPreviousPage is whether there are any pages prior to the current page.
+	HasPreviousPage bool `json:"hasPreviousPage"`
+	// StartCursor is the cursor corresponding to the first node in edges. It's
+	// only available in API versions from 2022-04, so is nil otherwise.
+	StartCursor *string `json:"startCursor"`
+	// EndCursor is the cursor corresponding to the last node in edges. It's
+	// only available in API versions from 2022-04, so is nil otherwise.
+	EndCursor *string `json:"endCursor"`
 }
 
 // Edge describes a node/cursor pair.
@@ -40,57 +125,256 @@ type Edge[T any] struct {
 	Node T `json:"node,omitempty"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler. It decodes nodes of union and
+// interface types according to their __typename.
+func (e *Edge[T]) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Cursor string          `json:"cursor"`
+		Node   json.RawMessage `json:"node"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e.Cursor = raw.Cursor
+	return unmarshalAbstract(raw.Node, &e.Node)
+}
+
+// Ptr returns a pointer to v. It's a convenience for setting the optional
+// arguments of fields selected with the query builder, which are pointers so
+// that an omitted argument can be distinguished from a zero value.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
 // Client describes a wrapper object of an HTTP client for the Storefront API
 // with credentials.
 type Client struct {
-	endpoint    string
-	accessToken string
-	HTTPClient  *http.Client
+	domain       string
+	baseURL      *url.URL
+	apiVersion   string
+	endpoint     string
+	accessToken  string
+	privateToken string
+	userAgent    string
+	header       http.Header
+	HTTPClient   *http.Client
+	// Timeout bounds each request whose context carries no deadline of its own.
+	// A zero value applies no limit beyond that of HTTPClient. The timeout
+	// spans all attempts made for a request, including any retries.
+	Timeout time.Duration
+	// RetryPolicy determines whether and how failed requests are retried. A nil
+	// RetryPolicy disables retries.
+	RetryPolicy *RetryPolicy
+	// strictEnums rejects responses holding enum values unknown to the schema.
+	strictEnums bool
 }
 
+// DefaultTimeout is the per-request timeout applied by clients constructed
+// with the default HTTP client.
+const DefaultTimeout = 10 * time.Second
+
 // NewClient constructs a new instance of a Storefront client given the store
 // domain and access token. Optionally, provide a single *http.Client to use
-// rather than a defaulted http.Client.
+// rather than a defaulted http.Client. To configure the client further, use
+// New instead.
 //
-// Note that the default HTTP client sets a 10-second timeout.
+// Note that a client using the default HTTP client applies DefaultTimeout to
+// any request whose context has no deadline. Supplying a deadline on the
+// context passed to QueryContext or DoContext overrides it.
 func NewClient(domain, accessToken string, httpClient ...*http.Client) *Client {
-	endpoint := url.URL{
-		Scheme: "https",
-		Host:   domain,
-		Path:   path.Join("api", APIVersion, "graphql.json"),
-	}
+	c := newClient(domain, accessToken)
 
 	if len(httpClient) != 0 {
-		return &Client{
-			endpoint:    endpoint.String(),
-			accessToken: accessToken,
-			HTTPClient:  httpClient[0],
+		c.HTTPClient = httpClient[0]
+		c.Timeout = 0
+	}
+
+	c.endpoint = c.buildEndpoint()
+
+	return c
+}
+
+// New constructs a new instance of a Storefront client given the store domain
+// and access token, configured by any number of options. Unless configured
+// otherwise, the client targets APIVersion, uses a default http.Client and
+// applies DefaultTimeout to any request whose context has no deadline.
+//
+// New returns an error wrapping ErrInvalidAPIVersion if the client's API
+// version isn't well-formed.
+func New(domain, accessToken string, opts ...Option) (*Client, error) {
+	c := newClient(domain, accessToken)
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
 		}
 	}
 
-	httpC := &http.Client{
-		Timeout: 10 * time.Second,
+	if err := validateAPIVersion(c.apiVersion); err != nil {
+		return nil, err
 	}
 
+	c.endpoint = c.buildEndpoint()
+
+	return c, nil
+}
+
+// APIVersion returns the Storefront API version the client targets.
+func (c *Client) APIVersion() string {
+	return c.apiVersion
+}
+
+// newClient returns a client with default settings.
+func newClient(domain, accessToken string) *Client {
 	return &Client{
-		endpoint:    endpoint.String(),
+		domain:      domain,
+		apiVersion:  APIVersion,
 		accessToken: accessToken,
-		HTTPClient:  httpC,
+		header:      http.Header{},
+		HTTPClient:  &http.Client{},
+		Timeout:     DefaultTimeout,
+	}
+}
+
+// buildEndpoint returns the URL of the GraphQL endpoint for the client's
+// domain and API version, or its base URL if one was specified.
+func (c *Client) buildEndpoint() string {
+	if c.baseURL != nil {
+		endpoint := *c.baseURL
+		if endpoint.Path == "" || endpoint.Path == "/" {
+			endpoint.Path = "/" + path.Join("api", c.apiVersion, "graphql.json")
+		}
+
+		return endpoint.String()
 	}
+
+	endpoint := url.URL{
+		Scheme: "https",
+		Host:   c.domain,
+		Path:   path.Join("api", c.apiVersion, "graphql.json"),
+	}
+
+	return endpoint.String()
+}
+
+// Endpoint returns the URL of the GraphQL endpoint the client sends requests
+// to.
+func (c *Client) Endpoint() string {
+	return c.endpoint
+}
+
+// Request describes a GraphQL request body, as sent to the Storefront API.
+type Request struct {
+	// Query is the GraphQL document to execute.
+	Query string `json:"query"`
+	// Variables is a set of values for the variables defined by the document.
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	// OperationName selects the operation to execute when the document
+	// contains more than one.
+	OperationName string `json:"operationName,omitempty"`
 }
 
 // Query executes a query against the Storefront API endpoint.
 func (c *Client) Query(q string, out interface{}) error {
-	reader := strings.NewReader(q)
+	return c.QueryContext(context.Background(), q, out)
+}
+
+// QueryContext executes a query against the Storefront API endpoint, bound to
+// the lifetime of ctx.
+func (c *Client) QueryContext(ctx context.Context, q string, out interface{}) error {
+	return c.DoContext(ctx, &Request{Query: q}, out)
+}
+
+// QueryWithVariables executes a query against the Storefront API endpoint,
+// supplying vars as the values for any variables the query defines.
+func (c *Client) QueryWithVariables(q string, vars map[string]interface{}, out interface{}) error {
+	return c.QueryWithVariablesContext(context.Background(), q, vars, out)
+}
+
+// QueryWithVariablesContext is as QueryWithVariables, bound to the lifetime of
+// ctx.
+func (c *Client) QueryWithVariablesContext(ctx context.Context, q string, vars map[string]interface{}, out interface{}) error {
+	return c.DoContext(ctx, &Request{Query: q, Variables: vars}, out)
+}
+
+// Do sends a GraphQL request to the Storefront API endpoint as a JSON body,
+// unmarshaling the response into out. If the response contains any errors,
+// Do returns them as GraphQLErrors after unmarshaling any partial data. A
+// response with a non-2xx status code results in an *HTTPError.
+func (c *Client) Do(r *Request, out interface{}) error {
+	return c.DoContext(context.Background(), r, out)
+}
+
+// DoContext is as Do, bound to the lifetime of ctx. If ctx has no deadline and
+// the client has a non-zero Timeout, the request is bounded by Timeout.
+//
+// If the client has a RetryPolicy, transient failures are retried according
+// to it until ctx is done.
+func (c *Client) DoContext(ctx context.Context, r *Request, out interface{}) error {
+	if _, ok := ctx.Deadline(); !ok && c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	if err := checkVariables(r.Variables); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	p := c.RetryPolicy
+	if p == nil || (!p.RetryMutations && isMutation(r)) {
+		return c.send(ctx, r, body, out)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err = c.send(ctx, r, body, out)
+		if err == nil || attempt >= p.MaxAttempts || !retryable(ctx, err) {
+			return err
+		}
+
+		if err := sleep(ctx, p.backoff(attempt, err)); err != nil {
+			return err
+		}
+	}
+}
 
-	req, err := http.NewRequest(http.MethodPost, c.endpoint, reader)
+// send makes a single attempt at posting body, the marshaled request r, to the
+// endpoint, unmarshaling the response into out.
+func (c *Client) send(ctx context.Context, r *Request, body []byte, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
 	if err != nil {
 		return err
 	}
 
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/graphql")
-	req.Header.Add("X-Shopify-Storefront-Access-Token", c.accessToken)
+	for k, v := range c.header {
+		req.Header[k] = append([]string(nil), v...)
+	}
+
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.privateToken != "" {
+		req.Header.Set("Shopify-Storefront-Private-Token", c.privateToken)
+	}
+
+	if c.accessToken != "" || c.privateToken == "" {
+		req.Header.Set("X-Shopify-Storefront-Access-Token", c.accessToken)
+	}
+
+	if ip, ok := BuyerIPFromContext(ctx); ok {
+		req.Header.Set("Shopify-Storefront-Buyer-IP", ip)
+	}
 
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -104,10 +388,32 @@ func (c *Client) Query(q string, out interface{}) error {
 		return err
 	}
 
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return newHTTPError(res, bs)
+	}
+
+	var envelope struct {
+		Errors GraphQLErrors `json:"errors"`
+	}
+
+	if err := json.Unmarshal(bs, &envelope); err != nil {
+		return err
+	}
+
+	if c.strictEnums {
+		if err := checkEnums(r, bs); err != nil {
+			return err
+		}
+	}
+
 	if err := json.Unmarshal(bs, &out); err != nil {
 		return err
 	}
 
+	if len(envelope.Errors) != 0 {
+		return envelope.Errors
+	}
+
 	return nil
 }
 
@@ -115,8 +421,7 @@ func (c *Client) Query(q string, out interface{}) error {
 var ErrEmptyFilename = errors.New("an empty filename was specified")
 
 // LoadQuery opens the specified file and returns a string value of the query.
-// It's the caller's responsibility to verify the file contains a valid
-// GraphQL query.
+// It doesn't check the query, which Validate can do against the schema.
 func LoadQuery(filename string) (string, error) {
 	if filename == "" {
 		return "", ErrEmptyFilename
@@ -1,11 +1,13 @@
 package storefront
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"testing"
@@ -64,6 +66,7 @@ func TestNewClient(t *testing.T) {
 			c.endpoint,
 		)
 		assert.Equal(accessToken, c.accessToken)
+		assert.Equal(DefaultTimeout, c.Timeout)
 	})
 
 	t.Run("CustomHTTPClient", func(t *testing.T) {
@@ -126,6 +129,197 @@ func TestClient_Query(t *testing.T) {
 	})
 }
 
+func TestClient_QueryWithVariables(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("application/json", r.Header.Get("Content-Type"))
+		assert.Equal("API_KEY", r.Header.Get("X-Shopify-Storefront-Access-Token"))
+
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+
+		assert.Equal("query ($handle: String!) { shop { name } }", req.Query)
+		assert.Equal(map[string]interface{}{"handle": "frontpage"}, req.Variables)
+
+		fmt.Fprint(w, `{"data":{"shop":{"name":"Shop name"}}}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("DOMAIN", "API_KEY")
+	c.endpoint = srv.URL
+
+	var set Set
+
+	assert.NoError(c.QueryWithVariables(
+		"query ($handle: String!) { shop { name } }",
+		map[string]interface{}{"handle": "frontpage"},
+		&set,
+	))
+	assert.Equal("Shop name", set.Data.Shop.Name)
+}
+
+func TestClient_DoContext(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		fmt.Fprint(w, `{"data":{"shop":{"name":"Shop name"}}}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("DOMAIN", "API_KEY")
+	c.endpoint = srv.URL
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var set Set
+		assert.ErrorIs(c.QueryContext(ctx, "{ shop { name } }", &set), context.Canceled)
+	})
+
+	t.Run("DeadlineExceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		var set Set
+		assert.ErrorIs(c.QueryContext(ctx, "{ shop { name } }", &set), context.DeadlineExceeded)
+	})
+
+	t.Run("DeadlineOverridesTimeout", func(t *testing.T) {
+		c := NewClient("DOMAIN", "API_KEY")
+		c.endpoint = srv.URL
+		c.Timeout = 10 * time.Millisecond
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		var set Set
+		assert.NoError(c.QueryContext(ctx, "{ shop { name } }", &set))
+		assert.Equal("Shop name", set.Data.Shop.Name)
+
+		assert.ErrorIs(c.Query("{ shop { name } }", &set), context.DeadlineExceeded)
+	})
+}
+
+func TestInputTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	input := CartInput{
+		Lines: Some([]CartLineInput{
+			{MerchandiseId: "gid://shopify/ProductVariant/1", Quantity: Some(2)},
+			{MerchandiseId: "gid://shopify/ProductVariant/2", Quantity: Some(0)},
+		}),
+	}
+
+	bs, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.JSONEq(`{"lines": [
+		{"merchandiseId": "gid://shopify/ProductVariant/1", "quantity": 2},
+		{"merchandiseId": "gid://shopify/ProductVariant/2", "quantity": 0}
+	]}`, string(bs))
+
+	// Unset fields are omitted, while those set to null or an empty list are
+	// sent.
+	update := CartLineUpdateInput{
+		Id:            "gid://shopify/CartLine/1",
+		Attributes:    Some([]AttributeInput{}),
+		SellingPlanId: Null[string](),
+	}
+
+	bs, err = json.Marshal(&update)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.JSONEq(`{"id": "gid://shopify/CartLine/1", "attributes": [], "sellingPlanId": null}`, string(bs))
+}
+
+func TestNullableFields(t *testing.T) {
+	assert := assert.New(t)
+
+	var set Set
+
+	assert.NoError(json.Unmarshal([]byte(`{"data": {
+		"product": {"title": "Example Product", "onlineStoreUrl": null},
+		"collection": null
+	}}`), &set))
+
+	if assert.NotNil(set.Data.Product) {
+		assert.Equal("Example Product", set.Data.Product.Title)
+		assert.Nil(set.Data.Product.OnlineStoreURL)
+	}
+
+	assert.Nil(set.Data.Collection)
+}
+
+func TestCyclicFields(t *testing.T) {
+	assert := assert.New(t)
+
+	var v ProductVariant
+
+	assert.NoError(json.Unmarshal([]byte(`{
+		"title": "Small",
+		"product": {"title": "Example Product", "handle": "example-product"}
+	}`), &v))
+
+	assert.Equal("Example Product", v.Product.Title)
+	assert.Equal("example-product", v.Product.Handle)
+}
+
+func TestConnection(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Edges", func(t *testing.T) {
+		var c Connection[Product]
+
+		assert.NoError(json.Unmarshal([]byte(`{
+			"edges": [
+				{"cursor": "a", "node": {"title": "First"}},
+				{"cursor": "b", "node": {"title": "Second"}}
+			],
+			"pageInfo": {"hasNextPage": true, "hasPreviousPage": false}
+		}`), &c))
+
+		if nodes := c.Nodes(); assert.Len(nodes, 2) {
+			assert.Equal("First", nodes[0].Title)
+			assert.Equal("Second", nodes[1].Title)
+		}
+
+		assert.True(c.HasNext())
+		assert.Nil(c.PageInfo.EndCursor)
+		assert.Equal("b", c.LastCursor())
+	})
+
+	t.Run("EndCursor", func(t *testing.T) {
+		var c Connection[Product]
+
+		assert.NoError(json.Unmarshal([]byte(`{
+			"edges": [],
+			"pageInfo": {"hasNextPage": false, "startCursor": null, "endCursor": "c"}
+		}`), &c))
+
+		assert.Empty(c.Nodes())
+		assert.False(c.HasNext())
+		assert.Equal("c", c.LastCursor())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		var c Connection[Product]
+		assert.Equal("", c.LastCursor())
+	})
+}
+
 func TestLoadQuery(t *testing.T) {
 	assert := assert.New(t)
 
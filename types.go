@@ -1,74 +1,117 @@
 package storefront
 
-import "time"
+import (
+	_ "embed"
+	"encoding/json"
+	"time"
+)
+
+// SchemaVersion is the Storefront API version of the schema from which the
+// types in this package were generated.
+const SchemaVersion = "2022-01"
+
+// schemaSDL is the schema from which the types in this package were
+// generated, in SDL, against which Validate checks queries.
+//
+//go:embed schema/2022-01/schema.graphqls
+var schemaSDL string
 
 // QueryRoot: The schema’s entry-point for queries. This acts as the public, top-level API from which all queries must start.
 type QueryRoot struct {
 	// Articles is a list of the shop's articles.
-	Articles Connection[Article] `json:"articles,omitempty"`
+	Articles Connection[Article] `json:"articles"`
 	// Blog is a specific `Blog` by one of its unique attributes.
-	Blog Blog `json:"blog,omitempty"`
+	Blog *Blog `json:"blog"`
 	// BlogByHandle is a blog by its handle.
-	BlogByHandle Blog `json:"blogByHandle,omitempty"`
+	BlogByHandle *Blog `json:"blogByHandle"`
 	// Blogs is a list of the shop's blogs.
-	Blogs Connection[Blog] `json:"blogs,omitempty"`
+	Blogs Connection[Blog] `json:"blogs"`
 	// Cart is a cart by its ID.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// Collection is a specific `Collection` by one of its unique attributes.
-	Collection Collection `json:"collection,omitempty"`
+	Collection *Collection `json:"collection"`
 	// CollectionByHandle is a collection by its handle.
-	CollectionByHandle Collection `json:"collectionByHandle,omitempty"`
+	CollectionByHandle *Collection `json:"collectionByHandle"`
 	// Collections is a list of the shop’s collections.
-	Collections Connection[Collection] `json:"collections,omitempty"`
+	Collections Connection[Collection] `json:"collections"`
 	// Customer is a customer by its access token.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// Localization is the localized experiences configured for the shop.
-	Localization Localization `json:"localization,omitempty"`
+	Localization Localization `json:"localization"`
 	/*
 	   Locations is a list of the shop's locations that support in-store pickup.
 
 	   When sorting by distance, you must specify a location via the `near` argument.
 	*/
-	Locations Connection[Location] `json:"locations,omitempty"`
+	Locations Connection[Location] `json:"locations"`
 	// Node is a specific node by ID.
-	Node Node `json:"node,omitempty"`
+	Node Node `json:"node"`
 	// Nodes is the list of nodes with the given IDs.
-	Nodes []Node `json:"nodes,omitempty"`
+	Nodes []Node `json:"nodes"`
 	// Page is a specific `Page` by one of its unique attributes.
-	Page Page `json:"page,omitempty"`
+	Page *Page `json:"page"`
 	// PageByHandle is a page by its handle.
-	PageByHandle Page `json:"pageByHandle,omitempty"`
+	PageByHandle *Page `json:"pageByHandle"`
 	// Pages is a list of the shop's pages.
-	Pages Connection[Page] `json:"pages,omitempty"`
+	Pages Connection[Page] `json:"pages"`
 	// Product is a specific `Product` by one of its unique attributes.
-	Product Product `json:"product,omitempty"`
+	Product *Product `json:"product"`
 	// ProductByHandle is a product by its handle.
-	ProductByHandle Product `json:"productByHandle,omitempty"`
+	ProductByHandle *Product `json:"productByHandle"`
 	/*
 	   ProductRecommendations is the find recommended products related to a given `product_id`.
 	   To learn more about how recommendations are generated, see
 	   [*Showing product recommendations on product pages*](https://help.shopify.com/themes/development/recommended-products).
 	*/
-	ProductRecommendations []Product `json:"productRecommendations,omitempty"`
+	ProductRecommendations []Product `json:"productRecommendations"`
 	/*
 	   ProductTags is the tags added to products.
 	   Additional access scope required: unauthenticated_read_product_tags.
 	*/
-	ProductTags Connection[string] `json:"productTags,omitempty"`
+	ProductTags Connection[string] `json:"productTags"`
 	// ProductTypes is a list of product types for the shop's products that are published to your app.
-	ProductTypes Connection[string] `json:"productTypes,omitempty"`
+	ProductTypes Connection[string] `json:"productTypes"`
 	// Products is a list of the shop’s products.
-	Products Connection[Product] `json:"products,omitempty"`
+	Products Connection[Product] `json:"products"`
 	// PublicApiVersions is the list of public Storefront API versions, including supported, release candidate and unstable versions.
-	PublicApiVersions []ApiVersion `json:"publicApiVersions,omitempty"`
+	PublicApiVersions []ApiVersion `json:"publicApiVersions"`
 	// Shop is the shop associated with the storefront access token.
-	Shop Shop `json:"shop,omitempty"`
+	Shop Shop `json:"shop"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *QueryRoot) UnmarshalJSON(data []byte) error {
+	type alias QueryRoot
+
+	var raw struct {
+		*alias
+		Node  json.RawMessage `json:"node"`
+		Nodes json.RawMessage `json:"nodes"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstract(raw.Node, &t.Node); err != nil {
+		return err
+	}
+	if err := unmarshalAbstractList(raw.Nodes, &t.Nodes); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // ArticleSortKeys: The set of valid sort keys for the Article query.
 type ArticleSortKeys string
 
 const (
+	// ArticleSortKeysUnknown is the zero value of ArticleSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	ArticleSortKeysUnknown     ArticleSortKeys = ""
 	ArticleSortKeysTitle       ArticleSortKeys = "TITLE"
 	ArticleSortKeysBlogTitle   ArticleSortKeys = "BLOG_TITLE"
 	ArticleSortKeysAuthor      ArticleSortKeys = "AUTHOR"
@@ -78,46 +121,86 @@ const (
 	ArticleSortKeysRelevance   ArticleSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of ArticleSortKeys, in schema order.
+func (ArticleSortKeys) Values() []ArticleSortKeys {
+	return []ArticleSortKeys{
+		ArticleSortKeysTitle,
+		ArticleSortKeysBlogTitle,
+		ArticleSortKeysAuthor,
+		ArticleSortKeysUpdatedAt,
+		ArticleSortKeysPublishedAt,
+		ArticleSortKeysId,
+		ArticleSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e ArticleSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of ArticleSortKeys.
+func (e ArticleSortKeys) IsValid() bool {
+	switch e {
+	case ArticleSortKeysTitle, ArticleSortKeysBlogTitle, ArticleSortKeysAuthor, ArticleSortKeysUpdatedAt, ArticleSortKeysPublishedAt, ArticleSortKeysId, ArticleSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e ArticleSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// ArticleSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *ArticleSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // Article: An article in an online store blog.
 type Article struct {
 	// Author is the article's author.
-	Author ArticleAuthor `json:"author,omitempty"`
+	Author ArticleAuthor `json:"author"`
 	// AuthorV2 is the article's author.
-	AuthorV2 ArticleAuthor `json:"authorV2,omitempty"`
+	AuthorV2 *ArticleAuthor `json:"authorV2"`
 	// Blog is the blog that the article belongs to.
-	Blog interface{} `json:"blog,omitempty"`
+	Blog Blog `json:"blog"`
 	// Comments is a list of comments posted on the article.
-	Comments Connection[Comment] `json:"comments,omitempty"`
+	Comments Connection[Comment] `json:"comments"`
 	// Content is the stripped content of the article, single line with HTML tags removed.
-	Content string `json:"content,omitempty"`
+	Content string `json:"content"`
 	// ContentHTML is the content of the article, complete with HTML formatting.
-	ContentHTML string `json:"contentHtml,omitempty"`
+	ContentHTML string `json:"contentHtml"`
 	// Excerpt is the stripped excerpt of the article, single line with HTML tags removed.
-	Excerpt string `json:"excerpt,omitempty"`
+	Excerpt *string `json:"excerpt"`
 	// ExcerptHTML is the excerpt of the article, complete with HTML formatting.
-	ExcerptHTML string `json:"excerptHtml,omitempty"`
+	ExcerptHTML *string `json:"excerptHtml"`
 	/*
 	   Handle is a human-friendly unique string for the Article automatically generated from its title.
 	*/
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Image is the image associated with the article.
-	Image Image `json:"image,omitempty"`
+	Image *Image `json:"image"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	// OnlineStoreURL is the URL used for viewing the resource on the shop's Online Store. Returns `null` if the resource is currently not published to the Online Store sales channel.
-	OnlineStoreURL string `json:"onlineStoreUrl,omitempty"`
+	OnlineStoreURL *string `json:"onlineStoreUrl"`
 	// PublishedAt is the date and time when the article was published.
-	PublishedAt time.Time `json:"publishedAt,omitempty"`
+	PublishedAt time.Time `json:"publishedAt"`
 	// SEO is the article’s SEO information.
-	SEO SEO `json:"seo,omitempty"`
+	SEO *SEO `json:"seo"`
 	// Tags is a categorization that a article can be tagged with.
-	Tags []string `json:"tags,omitempty"`
+	Tags []string `json:"tags"`
 	// Title is the article’s name.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 }
 
 /*
@@ -126,17 +209,416 @@ Node: An object with an ID field to support global identification, in accordance
 This interface is used by the [node](https://shopify.dev/api/admin-graphql/unstable/queries/node)
 and [nodes](https://shopify.dev/api/admin-graphql/unstable/queries/nodes) queries.
 */
-type Node struct {
-	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+//
+// It's implemented by pointers to the types implementing it: Article, Metafield, Blog, Collection, Product, ProductOption, ProductVariant, Location, MailingAddress, Checkout, AppliedGiftCard, CheckoutLineItem, Order, Page, ShopPolicy, MediaImage, Comment, Cart, CartLine, Payment, ExternalVideo, Model3D, Video.
+type Node interface {
+	isNode()
+	// GetId returns the id field shared by the types implementing it.
+	GetId() string
+}
+
+func (*Article) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Article) GetId() string {
+	return t.Id
+}
+
+func (*Metafield) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Metafield) GetId() string {
+	return t.Id
+}
+
+func (*Blog) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Blog) GetId() string {
+	return t.Id
+}
+
+func (*Collection) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Collection) GetId() string {
+	return t.Id
+}
+
+func (*Product) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Product) GetId() string {
+	return t.Id
+}
+
+func (*ProductOption) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *ProductOption) GetId() string {
+	return t.Id
+}
+
+func (*ProductVariant) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *ProductVariant) GetId() string {
+	return t.Id
+}
+
+func (*Location) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Location) GetId() string {
+	return t.Id
+}
+
+func (*MailingAddress) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *MailingAddress) GetId() string {
+	return t.Id
+}
+
+func (*Checkout) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Checkout) GetId() string {
+	return t.Id
+}
+
+func (*AppliedGiftCard) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *AppliedGiftCard) GetId() string {
+	return t.Id
+}
+
+func (*CheckoutLineItem) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *CheckoutLineItem) GetId() string {
+	return t.Id
+}
+
+func (*Order) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Order) GetId() string {
+	return t.Id
+}
+
+func (*Page) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Page) GetId() string {
+	return t.Id
+}
+
+func (*ShopPolicy) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *ShopPolicy) GetId() string {
+	return t.Id
+}
+
+func (*MediaImage) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *MediaImage) GetId() string {
+	return t.Id
+}
+
+func (*Comment) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Comment) GetId() string {
+	return t.Id
+}
+
+func (*Cart) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Cart) GetId() string {
+	return t.Id
+}
+
+func (*CartLine) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *CartLine) GetId() string {
+	return t.Id
+}
+
+func (*Payment) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Payment) GetId() string {
+	return t.Id
+}
+
+func (*ExternalVideo) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *ExternalVideo) GetId() string {
+	return t.Id
+}
+
+func (*Model3D) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Model3D) GetId() string {
+	return t.Id
+}
+
+func (*Video) isNode() {}
+
+// GetId returns the Id of t, implementing Node.
+func (t *Video) GetId() string {
+	return t.Id
+}
+
+// unmarshalNode decodes a Node according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalNode(data []byte) (Node, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "Article":
+		v := new(Article)
+		return v, json.Unmarshal(data, v)
+	case "Metafield":
+		v := new(Metafield)
+		return v, json.Unmarshal(data, v)
+	case "Blog":
+		v := new(Blog)
+		return v, json.Unmarshal(data, v)
+	case "Collection":
+		v := new(Collection)
+		return v, json.Unmarshal(data, v)
+	case "Product":
+		v := new(Product)
+		return v, json.Unmarshal(data, v)
+	case "ProductOption":
+		v := new(ProductOption)
+		return v, json.Unmarshal(data, v)
+	case "ProductVariant":
+		v := new(ProductVariant)
+		return v, json.Unmarshal(data, v)
+	case "Location":
+		v := new(Location)
+		return v, json.Unmarshal(data, v)
+	case "MailingAddress":
+		v := new(MailingAddress)
+		return v, json.Unmarshal(data, v)
+	case "Checkout":
+		v := new(Checkout)
+		return v, json.Unmarshal(data, v)
+	case "AppliedGiftCard":
+		v := new(AppliedGiftCard)
+		return v, json.Unmarshal(data, v)
+	case "CheckoutLineItem":
+		v := new(CheckoutLineItem)
+		return v, json.Unmarshal(data, v)
+	case "Order":
+		v := new(Order)
+		return v, json.Unmarshal(data, v)
+	case "Page":
+		v := new(Page)
+		return v, json.Unmarshal(data, v)
+	case "ShopPolicy":
+		v := new(ShopPolicy)
+		return v, json.Unmarshal(data, v)
+	case "MediaImage":
+		v := new(MediaImage)
+		return v, json.Unmarshal(data, v)
+	case "Comment":
+		v := new(Comment)
+		return v, json.Unmarshal(data, v)
+	case "Cart":
+		v := new(Cart)
+		return v, json.Unmarshal(data, v)
+	case "CartLine":
+		v := new(CartLine)
+		return v, json.Unmarshal(data, v)
+	case "Payment":
+		v := new(Payment)
+		return v, json.Unmarshal(data, v)
+	case "ExternalVideo":
+		v := new(ExternalVideo)
+		return v, json.Unmarshal(data, v)
+	case "Model3d":
+		v := new(Model3D)
+		return v, json.Unmarshal(data, v)
+	case "Video":
+		v := new(Video)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // HasMetafields: Represents information about the metafields associated to the specified resource.
-type HasMetafields struct {
-	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
-	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+//
+// It's implemented by pointers to the types implementing it: Article, Blog, Collection, Product, ProductVariant, Customer, Order, Page, Shop.
+type HasMetafields interface {
+	isHasMetafields()
+	// GetMetafield returns the metafield field shared by the types implementing it.
+	GetMetafield() *Metafield
+	// GetMetafields returns the metafields field shared by the types implementing it.
+	GetMetafields() Connection[Metafield]
+}
+
+func (*Article) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *Article) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *Article) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+func (*Blog) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *Blog) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *Blog) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+func (*Collection) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *Collection) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *Collection) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+func (*Product) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *Product) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *Product) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+func (*ProductVariant) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *ProductVariant) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *ProductVariant) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+func (*Customer) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *Customer) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *Customer) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+func (*Order) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *Order) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *Order) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+func (*Page) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *Page) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *Page) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+func (*Shop) isHasMetafields() {}
+
+// GetMetafield returns the Metafield of t, implementing HasMetafields.
+func (t *Shop) GetMetafield() *Metafield {
+	return t.Metafield
+}
+
+// GetMetafields returns the Metafields of t, implementing HasMetafields.
+func (t *Shop) GetMetafields() Connection[Metafield] {
+	return t.Metafields
+}
+
+// unmarshalHasMetafields decodes a HasMetafields according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalHasMetafields(data []byte) (HasMetafields, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "Article":
+		v := new(Article)
+		return v, json.Unmarshal(data, v)
+	case "Blog":
+		v := new(Blog)
+		return v, json.Unmarshal(data, v)
+	case "Collection":
+		v := new(Collection)
+		return v, json.Unmarshal(data, v)
+	case "Product":
+		v := new(Product)
+		return v, json.Unmarshal(data, v)
+	case "ProductVariant":
+		v := new(ProductVariant)
+		return v, json.Unmarshal(data, v)
+	case "Customer":
+		v := new(Customer)
+		return v, json.Unmarshal(data, v)
+	case "Order":
+		v := new(Order)
+		return v, json.Unmarshal(data, v)
+	case "Page":
+		v := new(Page)
+		return v, json.Unmarshal(data, v)
+	case "Shop":
+		v := new(Shop)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 /*
@@ -145,147 +627,295 @@ comprised of keys, values, and value types.
 */
 type Metafield struct {
 	// CreatedAt is the date and time when the storefront metafield was created.
-	CreatedAt time.Time `json:"createdAt,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 	// Description is the description of a metafield.
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Key is the key name for a metafield.
-	Key string `json:"key,omitempty"`
+	Key string `json:"key"`
 	// Namespace is the namespace for a metafield.
-	Namespace string `json:"namespace,omitempty"`
+	Namespace string `json:"namespace"`
 	// ParentResource is the parent object that the metafield belongs to.
-	ParentResource string `json:"parentResource,omitempty"`
+	ParentResource MetafieldParentResource `json:"parentResource"`
 	// Reference is a reference object if the metafield definition's type is a resource reference.
-	Reference string `json:"reference,omitempty"`
+	Reference MetafieldReference `json:"reference"`
 	/*
 	   Type is the type name of the metafield.
 	   See the list of [supported types](https://shopify.dev/apps/metafields/definitions/types).
 	*/
-	Type string `json:"type,omitempty"`
+	Type string `json:"type"`
 	// UpdatedAt is the date and time when the storefront metafield was updated.
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
 	// Value is the value of a metafield.
-	Value string `json:"value,omitempty"`
+	Value string `json:"value"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *Metafield) UnmarshalJSON(data []byte) error {
+	type alias Metafield
+
+	var raw struct {
+		*alias
+		ParentResource json.RawMessage `json:"parentResource"`
+		Reference      json.RawMessage `json:"reference"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstract(raw.ParentResource, &t.ParentResource); err != nil {
+		return err
+	}
+	if err := unmarshalAbstract(raw.Reference, &t.Reference); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MetafieldParentResource: A resource that the metafield belongs to.
+//
+// It's implemented by pointers to its member types: Article, Blog, Collection, Customer, Order, Page, Product, ProductVariant, Shop.
+type MetafieldParentResource interface {
+	isMetafieldParentResource()
+}
+
+func (*Article) isMetafieldParentResource() {}
+
+func (*Blog) isMetafieldParentResource() {}
+
+func (*Collection) isMetafieldParentResource() {}
+
+func (*Customer) isMetafieldParentResource() {}
+
+func (*Order) isMetafieldParentResource() {}
+
+func (*Page) isMetafieldParentResource() {}
+
+func (*Product) isMetafieldParentResource() {}
+
+func (*ProductVariant) isMetafieldParentResource() {}
+
+func (*Shop) isMetafieldParentResource() {}
+
+// unmarshalMetafieldParentResource decodes a MetafieldParentResource according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalMetafieldParentResource(data []byte) (MetafieldParentResource, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "Article":
+		v := new(Article)
+		return v, json.Unmarshal(data, v)
+	case "Blog":
+		v := new(Blog)
+		return v, json.Unmarshal(data, v)
+	case "Collection":
+		v := new(Collection)
+		return v, json.Unmarshal(data, v)
+	case "Customer":
+		v := new(Customer)
+		return v, json.Unmarshal(data, v)
+	case "Order":
+		v := new(Order)
+		return v, json.Unmarshal(data, v)
+	case "Page":
+		v := new(Page)
+		return v, json.Unmarshal(data, v)
+	case "Product":
+		v := new(Product)
+		return v, json.Unmarshal(data, v)
+	case "ProductVariant":
+		v := new(ProductVariant)
+		return v, json.Unmarshal(data, v)
+	case "Shop":
+		v := new(Shop)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // Blog: An online store blog.
 type Blog struct {
 	// ArticleByHandle is an article by its handle.
-	ArticleByHandle Article `json:"articleByHandle,omitempty"`
+	ArticleByHandle *Article `json:"articleByHandle"`
 	// Articles is a list of the blog's articles.
-	Articles Connection[Article] `json:"articles,omitempty"`
+	Articles Connection[Article] `json:"articles"`
 	// Authors is the authors who have contributed to the blog.
-	Authors []ArticleAuthor `json:"authors,omitempty"`
+	Authors []ArticleAuthor `json:"authors"`
 	/*
 	   Handle is a human-friendly unique string for the Blog automatically generated from its title.
 	*/
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	// OnlineStoreURL is the URL used for viewing the resource on the shop's Online Store. Returns `null` if the resource is currently not published to the Online Store sales channel.
-	OnlineStoreURL string `json:"onlineStoreUrl,omitempty"`
+	OnlineStoreURL *string `json:"onlineStoreUrl"`
 	// SEO is the blog's SEO information.
-	SEO SEO `json:"seo,omitempty"`
+	SEO *SEO `json:"seo"`
 	// Title is the blogs’s title.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 }
 
 // OnlineStorePublishable: Represents a resource that can be published to the Online Store sales channel.
-type OnlineStorePublishable struct {
-	// OnlineStoreURL is the URL used for viewing the resource on the shop's Online Store. Returns `null` if the resource is currently not published to the Online Store sales channel.
-	OnlineStoreURL string `json:"onlineStoreUrl,omitempty"`
+//
+// It's implemented by pointers to the types implementing it: Article, Blog, Collection, Product, Page.
+type OnlineStorePublishable interface {
+	isOnlineStorePublishable()
+	// GetOnlineStoreURL returns the onlineStoreUrl field shared by the types implementing it.
+	GetOnlineStoreURL() *string
+}
+
+func (*Article) isOnlineStorePublishable() {}
+
+// GetOnlineStoreURL returns the OnlineStoreURL of t, implementing OnlineStorePublishable.
+func (t *Article) GetOnlineStoreURL() *string {
+	return t.OnlineStoreURL
+}
+
+func (*Blog) isOnlineStorePublishable() {}
+
+// GetOnlineStoreURL returns the OnlineStoreURL of t, implementing OnlineStorePublishable.
+func (t *Blog) GetOnlineStoreURL() *string {
+	return t.OnlineStoreURL
+}
+
+func (*Collection) isOnlineStorePublishable() {}
+
+// GetOnlineStoreURL returns the OnlineStoreURL of t, implementing OnlineStorePublishable.
+func (t *Collection) GetOnlineStoreURL() *string {
+	return t.OnlineStoreURL
+}
+
+func (*Product) isOnlineStorePublishable() {}
+
+// GetOnlineStoreURL returns the OnlineStoreURL of t, implementing OnlineStorePublishable.
+func (t *Product) GetOnlineStoreURL() *string {
+	return t.OnlineStoreURL
+}
+
+func (*Page) isOnlineStorePublishable() {}
+
+// GetOnlineStoreURL returns the OnlineStoreURL of t, implementing OnlineStorePublishable.
+func (t *Page) GetOnlineStoreURL() *string {
+	return t.OnlineStoreURL
+}
+
+// unmarshalOnlineStorePublishable decodes a OnlineStorePublishable according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalOnlineStorePublishable(data []byte) (OnlineStorePublishable, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "Article":
+		v := new(Article)
+		return v, json.Unmarshal(data, v)
+	case "Blog":
+		v := new(Blog)
+		return v, json.Unmarshal(data, v)
+	case "Collection":
+		v := new(Collection)
+		return v, json.Unmarshal(data, v)
+	case "Product":
+		v := new(Product)
+		return v, json.Unmarshal(data, v)
+	case "Page":
+		v := new(Page)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // ArticleAuthor: The author of an article.
 type ArticleAuthor struct {
 	// Bio is the author's bio.
-	Bio string `json:"bio,omitempty"`
+	Bio *string `json:"bio"`
 	// Email is the author’s email.
-	Email string `json:"email,omitempty"`
+	Email string `json:"email"`
 	// FirstName is the author's first name.
-	FirstName string `json:"firstName,omitempty"`
+	FirstName string `json:"firstName"`
 	// LastName is the author's last name.
-	LastName string `json:"lastName,omitempty"`
+	LastName string `json:"lastName"`
 	// Name is the author's full name.
-	Name string `json:"name,omitempty"`
-}
-
-/*
-PageInfo: Returns information about pagination in a connection, in accordance with the
-[Relay specification](https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo).
-*/
-type PageInfo struct {
-	// HasNextPage is whether there are more pages to fetch following the current page.
-	HasNextPage bool `json:"hasNextPage,omitempty"`
-	// HasPreviousPage is whether there are any pages prior to the current page.
-	HasPreviousPage bool `json:"hasPreviousPage,omitempty"`
+	Name string `json:"name"`
 }
 
 // SEO: SEO information.
 type SEO struct {
 	// Description is the meta description.
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description"`
 	// Title is the SEO title.
-	Title string `json:"title,omitempty"`
+	Title *string `json:"title"`
 }
 
 // Collection: A collection represents a grouping of products that a shop owner can create to organize them or make their shops easier to browse.
 type Collection struct {
 	// Description is the stripped description of the collection, single line with HTML tags removed.
-	Description string `json:"description,omitempty"`
+	Description string `json:"description"`
 	// DescriptionHTML is the description of the collection, complete with HTML formatting.
-	DescriptionHTML string `json:"descriptionHtml,omitempty"`
+	DescriptionHTML string `json:"descriptionHtml"`
 	/*
 	   Handle is a human-friendly unique string for the collection automatically generated from its title.
 	   Limit of 255 characters.
 	*/
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Image is the image associated with the collection.
-	Image Image `json:"image,omitempty"`
+	Image *Image `json:"image"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	// OnlineStoreURL is the URL used for viewing the resource on the shop's Online Store. Returns `null` if the resource is currently not published to the Online Store sales channel.
-	OnlineStoreURL string `json:"onlineStoreUrl,omitempty"`
+	OnlineStoreURL *string `json:"onlineStoreUrl"`
 	// Products is a list of products in the collection.
-	Products Connection[Product] `json:"products,omitempty"`
+	Products Connection[Product] `json:"products"`
 	// Title is the collection’s name. Limit of 255 characters.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// UpdatedAt is the date and time when the collection was last modified.
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // Image: Represents an image resource.
 type Image struct {
 	// AltText is a word or phrase to share the nature or contents of an image.
-	AltText string `json:"altText,omitempty"`
+	AltText *string `json:"altText"`
 	// Height is the original height of the image in pixels. Returns `null` if the image is not hosted by Shopify.
-	Height int `json:"height,omitempty"`
+	Height *int `json:"height"`
 	// Id is a unique identifier for the image.
-	Id string `json:"id,omitempty"`
+	Id *string `json:"id"`
 	/*
 	   OriginalSrc is the location of the original image as a URL.
 
 	   If there are any existing transformations in the original source URL, they will remain and not be stripped.
 	*/
-	OriginalSrc string `json:"originalSrc,omitempty"`
+	OriginalSrc string `json:"originalSrc"`
 	// Src is the location of the image as a URL.
-	Src string `json:"src,omitempty"`
+	Src string `json:"src"`
 	/*
 	   TransformedSrc is the location of the transformed image as a URL.
 
 	   All transformation arguments are considered "best-effort". If they can be applied to an image, they will be.
 	   Otherwise any transformations which an image type does not support will be ignored.
 	*/
-	TransformedSrc string `json:"transformedSrc,omitempty"`
+	TransformedSrc string `json:"transformedSrc"`
 	/*
 	   URL is the location of the image as a URL.
 
@@ -295,35 +925,150 @@ type Image struct {
 
 	   If you need multiple variations of the same image, then you can use [GraphQL aliases](https://graphql.org/learn/queries/#aliases).
 	*/
-	URL string `json:"url,omitempty"`
+	URL string `json:"url"`
 	// Width is the original width of the image in pixels. Returns `null` if the image is not hosted by Shopify.
-	Width int `json:"width,omitempty"`
+	Width *int `json:"width"`
 }
 
 // CropRegion: The part of the image that should remain after cropping.
 type CropRegion string
 
 const (
-	CropRegionCenter CropRegion = "CENTER"
-	CropRegionTop    CropRegion = "TOP"
-	CropRegionBottom CropRegion = "BOTTOM"
-	CropRegionLeft   CropRegion = "LEFT"
-	CropRegionRight  CropRegion = "RIGHT"
+	// CropRegionUnknown is the zero value of CropRegion. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	CropRegionUnknown CropRegion = ""
+	CropRegionCenter  CropRegion = "CENTER"
+	CropRegionTop     CropRegion = "TOP"
+	CropRegionBottom  CropRegion = "BOTTOM"
+	CropRegionLeft    CropRegion = "LEFT"
+	CropRegionRight   CropRegion = "RIGHT"
 )
 
+// Values returns all the values of CropRegion, in schema order.
+func (CropRegion) Values() []CropRegion {
+	return []CropRegion{
+		CropRegionCenter,
+		CropRegionTop,
+		CropRegionBottom,
+		CropRegionLeft,
+		CropRegionRight,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e CropRegion) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of CropRegion.
+func (e CropRegion) IsValid() bool {
+	switch e {
+	case CropRegionCenter, CropRegionTop, CropRegionBottom, CropRegionLeft, CropRegionRight:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e CropRegion) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// CropRegionUnknown, or returning an error if StrictEnums is set.
+func (e *CropRegion) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // ImageContentType: List of supported image content types.
 type ImageContentType string
 
 const (
-	ImageContentTypePNG  ImageContentType = "PNG"
-	ImageContentTypeJPG  ImageContentType = "JPG"
-	ImageContentTypeWebP ImageContentType = "WEBP"
+	// ImageContentTypeUnknown is the zero value of ImageContentType. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	ImageContentTypeUnknown ImageContentType = ""
+	ImageContentTypePNG     ImageContentType = "PNG"
+	ImageContentTypeJPG     ImageContentType = "JPG"
+	ImageContentTypeWebP    ImageContentType = "WEBP"
 )
 
+// Values returns all the values of ImageContentType, in schema order.
+func (ImageContentType) Values() []ImageContentType {
+	return []ImageContentType{
+		ImageContentTypePNG,
+		ImageContentTypeJPG,
+		ImageContentTypeWebP,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e ImageContentType) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of ImageContentType.
+func (e ImageContentType) IsValid() bool {
+	switch e {
+	case ImageContentTypePNG, ImageContentTypeJPG, ImageContentTypeWebP:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e ImageContentType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// ImageContentTypeUnknown, or returning an error if StrictEnums is set.
+func (e *ImageContentType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+/*
+ImageTransformInput: The available options for transforming an image.
+
+All transformation options are considered "best-effort". Any transformation that the original image type doesn't support will be ignored.
+*/
+type ImageTransformInput struct {
+	// Crop is the crop the image according to the specified region.
+	Crop Optional[CropRegion] `json:"crop"`
+	/*
+	   MaxWidth is the image width in pixels between 1 and 5760.
+	*/
+	MaxWidth Optional[int] `json:"maxWidth"`
+	/*
+	   MaxHeight is the image height in pixels between 1 and 5760.
+	*/
+	MaxHeight Optional[int] `json:"maxHeight"`
+	/*
+	   Scale is the image size multiplier for high-resolution retina displays. Must be within 1..3.
+	*/
+	Scale Optional[int] `json:"scale"`
+	/*
+	   PreferredContentType is the convert the source image into the preferred content type.
+	   Supported conversions: `.svg` to `.png`, any file type to `.jpg`, and any file type to `.webp`.
+	*/
+	PreferredContentType Optional[ImageContentType] `json:"preferredContentType"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t ImageTransformInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
 // ProductCollectionSortKeys: The set of valid sort keys for the ProductCollection query.
 type ProductCollectionSortKeys string
 
 const (
+	// ProductCollectionSortKeysUnknown is the zero value of ProductCollectionSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	ProductCollectionSortKeysUnknown           ProductCollectionSortKeys = ""
 	ProductCollectionSortKeysTitle             ProductCollectionSortKeys = "TITLE"
 	ProductCollectionSortKeysPrice             ProductCollectionSortKeys = "PRICE"
 	ProductCollectionSortKeysBestSelling       ProductCollectionSortKeys = "BEST_SELLING"
@@ -334,94 +1079,196 @@ const (
 	ProductCollectionSortKeysRelevance         ProductCollectionSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of ProductCollectionSortKeys, in schema order.
+func (ProductCollectionSortKeys) Values() []ProductCollectionSortKeys {
+	return []ProductCollectionSortKeys{
+		ProductCollectionSortKeysTitle,
+		ProductCollectionSortKeysPrice,
+		ProductCollectionSortKeysBestSelling,
+		ProductCollectionSortKeysCreated,
+		ProductCollectionSortKeysId,
+		ProductCollectionSortKeysManual,
+		ProductCollectionSortKeysCollectionDefault,
+		ProductCollectionSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e ProductCollectionSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of ProductCollectionSortKeys.
+func (e ProductCollectionSortKeys) IsValid() bool {
+	switch e {
+	case ProductCollectionSortKeysTitle, ProductCollectionSortKeysPrice, ProductCollectionSortKeysBestSelling, ProductCollectionSortKeysCreated, ProductCollectionSortKeysId, ProductCollectionSortKeysManual, ProductCollectionSortKeysCollectionDefault, ProductCollectionSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e ProductCollectionSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// ProductCollectionSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *ProductCollectionSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+// ProductFilter: A filter used to view a subset of products in a collection.
+type ProductFilter struct {
+	// Available is the filter on if the product is available for sale.
+	Available Optional[bool] `json:"available"`
+	// VariantOption is a variant option to filter on.
+	VariantOption Optional[VariantOptionFilter] `json:"variantOption"`
+	// ProductType is the product type to filter on.
+	ProductType Optional[string] `json:"productType"`
+	// ProductVendor is the product vendor to filter on.
+	ProductVendor Optional[string] `json:"productVendor"`
+	// Price is a range of prices to filter with-in.
+	Price Optional[PriceRangeFilter] `json:"price"`
+	// ProductMetafield is a product metafield to filter on.
+	ProductMetafield Optional[MetafieldFilter] `json:"productMetafield"`
+	// VariantMetafield is a variant metafield to filter on.
+	VariantMetafield Optional[MetafieldFilter] `json:"variantMetafield"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t ProductFilter) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
+// VariantOptionFilter: A filter used to view a subset of products in a collection matching a specific variant option.
+type VariantOptionFilter struct {
+	// Name is the name of the variant option to filter on.
+	Name string `json:"name"`
+	// Value is the value of the variant option to filter on.
+	Value string `json:"value"`
+}
+
+// PriceRangeFilter: A filter used to view a subset of products in a collection matching a specific price range.
+type PriceRangeFilter struct {
+	// Min is the minimum price in the range. Defaults to zero.
+	Min Optional[float64] `json:"min"`
+	// Max is the maximum price in the range. Empty indicates no max price.
+	Max Optional[float64] `json:"max"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t PriceRangeFilter) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
+/*
+MetafieldFilter: A filter used to view a subset of products in a collection matching a specific metafield value.
+
+Only the following metafield types are currently supported:
+- `number_integer`
+- `number_decimal`
+- `single_line_text_field`
+*/
+type MetafieldFilter struct {
+	// Namespace is the namespace of the metafield to filter on.
+	Namespace string `json:"namespace"`
+	// Key is the key of the metafield to filter on.
+	Key string `json:"key"`
+	// Value is the value of the metafield.
+	Value string `json:"value"`
+}
+
 /*
 Product: A product represents an individual item for sale in a Shopify store. Products are often physical, but they don't have to be.
 For example, a digital download (such as a movie, music or ebook file) also qualifies as a product, as do services (such as equipment rental, work for hire, customization of another product or an extended warranty).
 */
 type Product struct {
 	// AvailableForSale indicates if at least one product variant is available for sale.
-	AvailableForSale bool `json:"availableForSale,omitempty"`
+	AvailableForSale bool `json:"availableForSale"`
 	// Collections is a list of collections a product belongs to.
-	Collections Connection[Collection] `json:"collections,omitempty"`
+	Collections Connection[Collection] `json:"collections"`
 	// CompareAtPriceRange is the compare at price of the product across all variants.
-	CompareAtPriceRange ProductPriceRange `json:"compareAtPriceRange,omitempty"`
+	CompareAtPriceRange ProductPriceRange `json:"compareAtPriceRange"`
 	// CreatedAt is the date and time when the product was created.
-	CreatedAt time.Time `json:"createdAt,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 	// Description is the stripped description of the product, single line with HTML tags removed.
-	Description string `json:"description,omitempty"`
+	Description string `json:"description"`
 	// DescriptionHTML is the description of the product, complete with HTML formatting.
-	DescriptionHTML string `json:"descriptionHtml,omitempty"`
+	DescriptionHTML string `json:"descriptionHtml"`
 	/*
 	   FeaturedImage is the featured image for the product.
 
 	   This field is functionally equivalent to `images(first: 1)`.
 	*/
-	FeaturedImage Image `json:"featuredImage,omitempty"`
+	FeaturedImage *Image `json:"featuredImage"`
 	/*
 	   Handle is a human-friendly unique string for the Product automatically generated from its title.
 	   They are used by the Liquid templating language to refer to objects.
 	*/
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Images is a list of images associated with the product.
-	Images Connection[Image] `json:"images,omitempty"`
+	Images Connection[Image] `json:"images"`
 	// Media is the media associated with the product.
-	Media Connection[Media] `json:"media,omitempty"`
+	Media Connection[Media] `json:"media"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	// OnlineStoreURL is the URL used for viewing the resource on the shop's Online Store. Returns `null` if the resource is currently not published to the Online Store sales channel.
-	OnlineStoreURL string `json:"onlineStoreUrl,omitempty"`
+	OnlineStoreURL *string `json:"onlineStoreUrl"`
 	// Options is a list of product options.
-	Options []ProductOption `json:"options,omitempty"`
+	Options []ProductOption `json:"options"`
 	// PriceRange is the price range.
-	PriceRange ProductPriceRange `json:"priceRange,omitempty"`
+	PriceRange ProductPriceRange `json:"priceRange"`
 	// ProductType is a categorization that a product can be tagged with, commonly used for filtering and searching.
-	ProductType string `json:"productType,omitempty"`
+	ProductType string `json:"productType"`
 	// PublishedAt is the date and time when the product was published to the channel.
-	PublishedAt time.Time `json:"publishedAt,omitempty"`
+	PublishedAt time.Time `json:"publishedAt"`
 	// RequiresSellingPlan is whether the product can only be purchased with a selling plan.
-	RequiresSellingPlan bool `json:"requiresSellingPlan,omitempty"`
+	RequiresSellingPlan bool `json:"requiresSellingPlan"`
 	// SellingPlanGroups is a list of a product's available selling plan groups. A selling plan group represents a selling method. For example, 'Subscribe and save' is a selling method where customers pay for goods or services per delivery. A selling plan group contains individual selling plans.
-	SellingPlanGroups Connection[SellingPlanGroup] `json:"sellingPlanGroups,omitempty"`
+	SellingPlanGroups Connection[SellingPlanGroup] `json:"sellingPlanGroups"`
 	// SEO is the product's SEO information.
-	SEO SEO `json:"seo,omitempty"`
+	SEO SEO `json:"seo"`
 	/*
 	   Tags is a comma separated list of tags that have been added to the product.
 	   Additional access scope required for private apps: unauthenticated_read_product_tags.
 	*/
-	Tags []string `json:"tags,omitempty"`
+	Tags []string `json:"tags"`
 	// Title is the product’s title.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// TotalInventory is the total quantity of inventory in stock for this Product.
-	TotalInventory int `json:"totalInventory,omitempty"`
+	TotalInventory *int `json:"totalInventory"`
 	/*
 	   UpdatedAt is the date and time when the product was last modified.
 	   A product's `updatedAt` value can change for different reasons. For example, if an order
 	   is placed for a product that has inventory tracking set up, then the inventory adjustment
 	   is counted as an update.
 	*/
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
 	/*
 	   VariantBySelectedOptions is a product’s variant based on its selected options.
 	   This is useful for converting a user’s selection of product options into a single matching variant.
 	   If there is not a variant for the selected options, `null` will be returned.
 	*/
-	VariantBySelectedOptions ProductVariant `json:"variantBySelectedOptions,omitempty"`
+	VariantBySelectedOptions *ProductVariant `json:"variantBySelectedOptions"`
 	// Variants is a list of the product’s variants.
-	Variants Connection[ProductVariant] `json:"variants,omitempty"`
+	Variants Connection[ProductVariant] `json:"variants"`
 	// Vendor is the product’s vendor name.
-	Vendor string `json:"vendor,omitempty"`
+	Vendor string `json:"vendor"`
 }
 
 // ProductPriceRange: The price range of the product.
 type ProductPriceRange struct {
 	// MaxVariantPrice is the highest variant's price.
-	MaxVariantPrice MoneyV2 `json:"maxVariantPrice,omitempty"`
+	MaxVariantPrice MoneyV2 `json:"maxVariantPrice"`
 	// MinVariantPrice is the lowest variant's price.
-	MinVariantPrice MoneyV2 `json:"minVariantPrice,omitempty"`
+	MinVariantPrice MoneyV2 `json:"minVariantPrice"`
 }
 
 /*
@@ -429,50 +1276,266 @@ MoneyV2: A monetary value with currency.
 */
 type MoneyV2 struct {
 	// Amount is the decimal money amount.
-	Amount float64 `json:"amount,omitempty"`
+	Amount Decimal `json:"amount"`
 	// CurrencyCode is the currency of the money.
-	CurrencyCode string `json:"currencyCode,omitempty"`
+	CurrencyCode CurrencyCode `json:"currencyCode"`
 }
 
 // ProductImageSortKeys: The set of valid sort keys for the ProductImage query.
 type ProductImageSortKeys string
 
 const (
+	// ProductImageSortKeysUnknown is the zero value of ProductImageSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	ProductImageSortKeysUnknown   ProductImageSortKeys = ""
 	ProductImageSortKeysCreatedAt ProductImageSortKeys = "CREATED_AT"
 	ProductImageSortKeysPosition  ProductImageSortKeys = "POSITION"
 	ProductImageSortKeysId        ProductImageSortKeys = "ID"
 	ProductImageSortKeysRelevance ProductImageSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of ProductImageSortKeys, in schema order.
+func (ProductImageSortKeys) Values() []ProductImageSortKeys {
+	return []ProductImageSortKeys{
+		ProductImageSortKeysCreatedAt,
+		ProductImageSortKeysPosition,
+		ProductImageSortKeysId,
+		ProductImageSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e ProductImageSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of ProductImageSortKeys.
+func (e ProductImageSortKeys) IsValid() bool {
+	switch e {
+	case ProductImageSortKeysCreatedAt, ProductImageSortKeysPosition, ProductImageSortKeysId, ProductImageSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e ProductImageSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// ProductImageSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *ProductImageSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // ProductMediaSortKeys: The set of valid sort keys for the ProductMedia query.
 type ProductMediaSortKeys string
 
 const (
+	// ProductMediaSortKeysUnknown is the zero value of ProductMediaSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	ProductMediaSortKeysUnknown   ProductMediaSortKeys = ""
 	ProductMediaSortKeysPosition  ProductMediaSortKeys = "POSITION"
 	ProductMediaSortKeysId        ProductMediaSortKeys = "ID"
 	ProductMediaSortKeysRelevance ProductMediaSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of ProductMediaSortKeys, in schema order.
+func (ProductMediaSortKeys) Values() []ProductMediaSortKeys {
+	return []ProductMediaSortKeys{
+		ProductMediaSortKeysPosition,
+		ProductMediaSortKeysId,
+		ProductMediaSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e ProductMediaSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of ProductMediaSortKeys.
+func (e ProductMediaSortKeys) IsValid() bool {
+	switch e {
+	case ProductMediaSortKeysPosition, ProductMediaSortKeysId, ProductMediaSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e ProductMediaSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// ProductMediaSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *ProductMediaSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // Media: Represents a media interface.
-type Media struct {
-	// Alt is a word or phrase to share the nature or contents of a media.
-	Alt string `json:"alt,omitempty"`
-	// MediaContentType is the media content type.
-	MediaContentType MediaContentType `json:"mediaContentType,omitempty"`
-	// PreviewImage is the preview image for the media.
-	PreviewImage Image `json:"previewImage,omitempty"`
+//
+// It's implemented by pointers to the types implementing it: MediaImage, ExternalVideo, Model3D, Video.
+type Media interface {
+	isMedia()
+	// GetAlt returns the alt field shared by the types implementing it.
+	GetAlt() *string
+	// GetMediaContentType returns the mediaContentType field shared by the types implementing it.
+	GetMediaContentType() MediaContentType
+	// GetPreviewImage returns the previewImage field shared by the types implementing it.
+	GetPreviewImage() *Image
+}
+
+func (*MediaImage) isMedia() {}
+
+// GetAlt returns the Alt of t, implementing Media.
+func (t *MediaImage) GetAlt() *string {
+	return t.Alt
+}
+
+// GetMediaContentType returns the MediaContentType of t, implementing Media.
+func (t *MediaImage) GetMediaContentType() MediaContentType {
+	return t.MediaContentType
+}
+
+// GetPreviewImage returns the PreviewImage of t, implementing Media.
+func (t *MediaImage) GetPreviewImage() *Image {
+	return t.PreviewImage
+}
+
+func (*ExternalVideo) isMedia() {}
+
+// GetAlt returns the Alt of t, implementing Media.
+func (t *ExternalVideo) GetAlt() *string {
+	return t.Alt
+}
+
+// GetMediaContentType returns the MediaContentType of t, implementing Media.
+func (t *ExternalVideo) GetMediaContentType() MediaContentType {
+	return t.MediaContentType
+}
+
+// GetPreviewImage returns the PreviewImage of t, implementing Media.
+func (t *ExternalVideo) GetPreviewImage() *Image {
+	return t.PreviewImage
+}
+
+func (*Model3D) isMedia() {}
+
+// GetAlt returns the Alt of t, implementing Media.
+func (t *Model3D) GetAlt() *string {
+	return t.Alt
+}
+
+// GetMediaContentType returns the MediaContentType of t, implementing Media.
+func (t *Model3D) GetMediaContentType() MediaContentType {
+	return t.MediaContentType
+}
+
+// GetPreviewImage returns the PreviewImage of t, implementing Media.
+func (t *Model3D) GetPreviewImage() *Image {
+	return t.PreviewImage
+}
+
+func (*Video) isMedia() {}
+
+// GetAlt returns the Alt of t, implementing Media.
+func (t *Video) GetAlt() *string {
+	return t.Alt
+}
+
+// GetMediaContentType returns the MediaContentType of t, implementing Media.
+func (t *Video) GetMediaContentType() MediaContentType {
+	return t.MediaContentType
+}
+
+// GetPreviewImage returns the PreviewImage of t, implementing Media.
+func (t *Video) GetPreviewImage() *Image {
+	return t.PreviewImage
+}
+
+// unmarshalMedia decodes a Media according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalMedia(data []byte) (Media, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "MediaImage":
+		v := new(MediaImage)
+		return v, json.Unmarshal(data, v)
+	case "ExternalVideo":
+		v := new(ExternalVideo)
+		return v, json.Unmarshal(data, v)
+	case "Model3d":
+		v := new(Model3D)
+		return v, json.Unmarshal(data, v)
+	case "Video":
+		v := new(Video)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // MediaContentType: The possible content types for a media object.
 type MediaContentType string
 
 const (
+	// MediaContentTypeUnknown is the zero value of MediaContentType. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	MediaContentTypeUnknown       MediaContentType = ""
 	MediaContentTypeExternalVideo MediaContentType = "EXTERNAL_VIDEO"
 	MediaContentTypeImage         MediaContentType = "IMAGE"
 	MediaContentTypeModel3D       MediaContentType = "MODEL_3D"
 	MediaContentTypeVideo         MediaContentType = "VIDEO"
 )
 
+// Values returns all the values of MediaContentType, in schema order.
+func (MediaContentType) Values() []MediaContentType {
+	return []MediaContentType{
+		MediaContentTypeExternalVideo,
+		MediaContentTypeImage,
+		MediaContentTypeModel3D,
+		MediaContentTypeVideo,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e MediaContentType) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of MediaContentType.
+func (e MediaContentType) IsValid() bool {
+	switch e {
+	case MediaContentTypeExternalVideo, MediaContentTypeImage, MediaContentTypeModel3D, MediaContentTypeVideo:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e MediaContentType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// MediaContentTypeUnknown, or returning an error if StrictEnums is set.
+func (e *MediaContentType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 /*
 ProductOption: Product property names like "Size", "Color", and "Material" that the customers can select.
 Variants are selected based on permutations of these options.
@@ -480,133 +1543,199 @@ Variants are selected based on permutations of these options.
 */
 type ProductOption struct {
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Name is the product option’s name.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// Values is the corresponding value to the product option name.
-	Values []string `json:"values,omitempty"`
+	Values []string `json:"values"`
 }
 
 // SellingPlanGroup: Represents a selling method. For example, 'Subscribe and save' is a selling method where customers pay for goods or services per delivery. A selling plan group contains individual selling plans.
 type SellingPlanGroup struct {
 	// AppName is a display friendly name for the app that created the selling plan group.
-	AppName string `json:"appName,omitempty"`
+	AppName *string `json:"appName"`
 	// Name is the name of the selling plan group.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// Options is the represents the selling plan options available in the drop-down list in the storefront. For example, 'Delivery every week' or 'Delivery every 2 weeks' specifies the delivery frequency options for the product.
-	Options []SellingPlanGroupOption `json:"options,omitempty"`
+	Options []SellingPlanGroupOption `json:"options"`
 	// SellingPlans is a list of selling plans in a selling plan group. A selling plan is a representation of how products and variants can be sold and purchased. For example, an individual selling plan could be '6 weeks of prepaid granola, delivered weekly'.
-	SellingPlans Connection[SellingPlan] `json:"sellingPlans,omitempty"`
+	SellingPlans Connection[SellingPlan] `json:"sellingPlans"`
 }
 
 // SellingPlanGroupOption: Represents an option on a selling plan group that's available in the drop-down list in the storefront.
 type SellingPlanGroupOption struct {
 	// Name is the name of the option. For example, 'Delivery every'.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// Values is the values for the options specified by the selling plans in the selling plan group. For example, '1 week', '2 weeks', '3 weeks'.
-	Values []string `json:"values,omitempty"`
+	Values []string `json:"values"`
 }
 
 // SellingPlan: Represents how products and variants can be sold and purchased.
 type SellingPlan struct {
 	// Description is the description of the selling plan.
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Name is the name of the selling plan. For example, '6 weeks of prepaid granola, delivered weekly'.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// Options is the represents the selling plan options available in the drop-down list in the storefront. For example, 'Delivery every week' or 'Delivery every 2 weeks' specifies the delivery frequency options for the product.
-	Options []SellingPlanOption `json:"options,omitempty"`
+	Options []SellingPlanOption `json:"options"`
 	// PriceAdjustments is the represents how a selling plan affects pricing when a variant is purchased with a selling plan.
-	PriceAdjustments []SellingPlanPriceAdjustment `json:"priceAdjustments,omitempty"`
+	PriceAdjustments []SellingPlanPriceAdjustment `json:"priceAdjustments"`
 	// RecurringDeliveries is the whether purchasing the selling plan will result in multiple deliveries.
-	RecurringDeliveries bool `json:"recurringDeliveries,omitempty"`
+	RecurringDeliveries bool `json:"recurringDeliveries"`
 }
 
 // SellingPlanOption: An option provided by a Selling Plan.
 type SellingPlanOption struct {
 	// Name is the name of the option (ie "Delivery every").
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name"`
 	// Value is the value of the option (ie "Month").
-	Value string `json:"value,omitempty"`
+	Value *string `json:"value"`
 }
 
 // SellingPlanPriceAdjustment: Represents by how much the price of a variant associated with a selling plan is adjusted. Each variant can have up to two price adjustments.
 type SellingPlanPriceAdjustment struct {
 	// AdjustmentValue is the type of price adjustment. An adjustment value can have one of three types: percentage, amount off, or a new price.
-	AdjustmentValue string `json:"adjustmentValue,omitempty"`
+	AdjustmentValue SellingPlanPriceAdjustmentValue `json:"adjustmentValue"`
 	// OrderCount is the number of orders that the price adjustment applies to If the price adjustment always applies, then this field is `null`.
-	OrderCount int `json:"orderCount,omitempty"`
+	OrderCount *int `json:"orderCount"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *SellingPlanPriceAdjustment) UnmarshalJSON(data []byte) error {
+	type alias SellingPlanPriceAdjustment
+
+	var raw struct {
+		*alias
+		AdjustmentValue json.RawMessage `json:"adjustmentValue"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstract(raw.AdjustmentValue, &t.AdjustmentValue); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SellingPlanPriceAdjustmentValue: Represents by how much the price of a variant associated with a selling plan is adjusted. Each variant can have up to two price adjustments.
+//
+// It's implemented by pointers to its member types: SellingPlanFixedAmountPriceAdjustment, SellingPlanFixedPriceAdjustment, SellingPlanPercentagePriceAdjustment.
+type SellingPlanPriceAdjustmentValue interface {
+	isSellingPlanPriceAdjustmentValue()
+}
+
+func (*SellingPlanFixedAmountPriceAdjustment) isSellingPlanPriceAdjustmentValue() {}
+
+func (*SellingPlanFixedPriceAdjustment) isSellingPlanPriceAdjustmentValue() {}
+
+func (*SellingPlanPercentagePriceAdjustment) isSellingPlanPriceAdjustmentValue() {}
+
+// unmarshalSellingPlanPriceAdjustmentValue decodes a SellingPlanPriceAdjustmentValue according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalSellingPlanPriceAdjustmentValue(data []byte) (SellingPlanPriceAdjustmentValue, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "SellingPlanFixedAmountPriceAdjustment":
+		v := new(SellingPlanFixedAmountPriceAdjustment)
+		return v, json.Unmarshal(data, v)
+	case "SellingPlanFixedPriceAdjustment":
+		v := new(SellingPlanFixedPriceAdjustment)
+		return v, json.Unmarshal(data, v)
+	case "SellingPlanPercentagePriceAdjustment":
+		v := new(SellingPlanPercentagePriceAdjustment)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // SellingPlanFixedAmountPriceAdjustment: A fixed amount that's deducted from the original variant price. For example, $10.00 off.
 type SellingPlanFixedAmountPriceAdjustment struct {
 	// AdjustmentAmount is the money value of the price adjustment.
-	AdjustmentAmount MoneyV2 `json:"adjustmentAmount,omitempty"`
+	AdjustmentAmount MoneyV2 `json:"adjustmentAmount"`
 }
 
 // SellingPlanFixedPriceAdjustment: A fixed price adjustment for a variant that's purchased with a selling plan.
 type SellingPlanFixedPriceAdjustment struct {
 	// Price is a new price of the variant when it's purchased with the selling plan.
-	Price MoneyV2 `json:"price,omitempty"`
+	Price MoneyV2 `json:"price"`
 }
 
 // SellingPlanPercentagePriceAdjustment: A percentage amount that's deducted from the original variant price. For example, 10% off.
 type SellingPlanPercentagePriceAdjustment struct {
 	// AdjustmentPercentage is the percentage value of the price adjustment.
-	AdjustmentPercentage int `json:"adjustmentPercentage,omitempty"`
+	AdjustmentPercentage int `json:"adjustmentPercentage"`
+}
+
+// SelectedOptionInput: Specifies the input fields required for a selected option.
+type SelectedOptionInput struct {
+	// Name is the product option’s name.
+	Name string `json:"name"`
+	// Value is the product option’s value.
+	Value string `json:"value"`
 }
 
 // ProductVariant: A product variant represents a different version of a product, such as differing sizes or differing colors.
 type ProductVariant struct {
 	// AvailableForSale indicates if the product variant is available for sale.
-	AvailableForSale bool `json:"availableForSale,omitempty"`
+	AvailableForSale bool `json:"availableForSale"`
 	// Barcode is the barcode (for example, ISBN, UPC, or GTIN) associated with the variant.
-	Barcode string `json:"barcode,omitempty"`
+	Barcode *string `json:"barcode"`
 	// CompareAtPrice is the compare at price of the variant. This can be used to mark a variant as on sale, when `compareAtPrice` is higher than `price`.
-	CompareAtPrice string `json:"compareAtPrice,omitempty"`
+	CompareAtPrice *Decimal `json:"compareAtPrice"`
 	// CompareAtPriceV2 is the compare at price of the variant. This can be used to mark a variant as on sale, when `compareAtPriceV2` is higher than `priceV2`.
-	CompareAtPriceV2 MoneyV2 `json:"compareAtPriceV2,omitempty"`
+	CompareAtPriceV2 *MoneyV2 `json:"compareAtPriceV2"`
 	// CurrentlyNotInStock is whether a product is out of stock but still available for purchase (used for backorders).
-	CurrentlyNotInStock bool `json:"currentlyNotInStock,omitempty"`
+	CurrentlyNotInStock bool `json:"currentlyNotInStock"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	/*
 	   Image is the image associated with the product variant. This field falls back to the product image if no image is available.
 	*/
-	Image Image `json:"image,omitempty"`
+	Image *Image `json:"image"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	// Price is the product variant’s price.
-	Price string `json:"price,omitempty"`
+	Price Decimal `json:"price"`
 	// PriceV2 is the product variant’s price.
-	PriceV2 MoneyV2 `json:"priceV2,omitempty"`
+	PriceV2 MoneyV2 `json:"priceV2"`
 	// Product is the product object that the product variant belongs to.
-	Product interface{} `json:"product,omitempty"`
+	Product Product `json:"product"`
 	// QuantityAvailable is the total sellable quantity of the variant for online sales channels.
-	QuantityAvailable int `json:"quantityAvailable,omitempty"`
+	QuantityAvailable *int `json:"quantityAvailable"`
 	// RequiresShipping is whether a customer needs to provide a shipping address when placing an order for the product variant.
-	RequiresShipping bool `json:"requiresShipping,omitempty"`
+	RequiresShipping bool `json:"requiresShipping"`
 	// SelectedOptions is a list of product options applied to the variant.
-	SelectedOptions []SelectedOption `json:"selectedOptions,omitempty"`
+	SelectedOptions []SelectedOption `json:"selectedOptions"`
 	// SellingPlanAllocations is the represents an association between a variant and a selling plan. Selling plan allocations describe which selling plans are available for each variant, and what their impact is on pricing.
-	SellingPlanAllocations Connection[SellingPlanAllocation] `json:"sellingPlanAllocations,omitempty"`
+	SellingPlanAllocations Connection[SellingPlanAllocation] `json:"sellingPlanAllocations"`
 	// SKU is the SKU (stock keeping unit) associated with the variant.
-	SKU string `json:"sku,omitempty"`
+	SKU *string `json:"sku"`
 	// StoreAvailability is the in-store pickup availability of this variant by location.
-	StoreAvailability Connection[StoreAvailability] `json:"storeAvailability,omitempty"`
+	StoreAvailability Connection[StoreAvailability] `json:"storeAvailability"`
 	// Title is the product variant’s title.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// UnitPrice is the unit price value for the variant based on the variant's measurement.
-	UnitPrice MoneyV2 `json:"unitPrice,omitempty"`
+	UnitPrice *MoneyV2 `json:"unitPrice"`
 	// UnitPriceMeasurement is the unit price measurement for the variant.
-	UnitPriceMeasurement UnitPriceMeasurement `json:"unitPriceMeasurement,omitempty"`
+	UnitPriceMeasurement *UnitPriceMeasurement `json:"unitPriceMeasurement"`
 	// Weight is the weight of the product variant in the unit system specified with `weight_unit`.
-	Weight float64 `json:"weight,omitempty"`
+	Weight *float64 `json:"weight"`
 	// WeightUnit is the unit of measurement for weight.
-	WeightUnit string `json:"weightUnit,omitempty"`
+	WeightUnit WeightUnit `json:"weightUnit"`
 }
 
 /*
@@ -615,29 +1744,29 @@ Products can have multiple options, like different sizes or colors.
 */
 type SelectedOption struct {
 	// Name is the product option’s name.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// Value is the product option’s value.
-	Value string `json:"value,omitempty"`
+	Value string `json:"value"`
 }
 
 // SellingPlanAllocation: Represents an association between a variant and a selling plan. Selling plan allocations describe the options offered for each variant, and the price of the variant when purchased with a selling plan.
 type SellingPlanAllocation struct {
 	// PriceAdjustments is a list of price adjustments, with a maximum of two. When there are two, the first price adjustment goes into effect at the time of purchase, while the second one starts after a certain number of orders.
-	PriceAdjustments []SellingPlanAllocationPriceAdjustment `json:"priceAdjustments,omitempty"`
+	PriceAdjustments []SellingPlanAllocationPriceAdjustment `json:"priceAdjustments"`
 	// SellingPlan is a representation of how products and variants can be sold and purchased. For example, an individual selling plan could be '6 weeks of prepaid granola, delivered weekly'.
-	SellingPlan SellingPlan `json:"sellingPlan,omitempty"`
+	SellingPlan SellingPlan `json:"sellingPlan"`
 }
 
 // SellingPlanAllocationPriceAdjustment: The resulting prices for variants when they're purchased with a specific selling plan.
 type SellingPlanAllocationPriceAdjustment struct {
 	// CompareAtPrice is the price of the variant when it's purchased without a selling plan for the same number of deliveries. For example, if a customer purchases 6 deliveries of $10.00 granola separately, then the price is 6 x $10.00 = $60.00.
-	CompareAtPrice MoneyV2 `json:"compareAtPrice,omitempty"`
+	CompareAtPrice MoneyV2 `json:"compareAtPrice"`
 	// PerDeliveryPrice is the effective price for a single delivery. For example, for a prepaid subscription plan that includes 6 deliveries at the price of $48.00, the per delivery price is $8.00.
-	PerDeliveryPrice MoneyV2 `json:"perDeliveryPrice,omitempty"`
+	PerDeliveryPrice MoneyV2 `json:"perDeliveryPrice"`
 	// Price is the price of the variant when it's purchased with a selling plan For example, for a prepaid subscription plan that includes 6 deliveries of $10.00 granola, where the customer gets 20% off, the price is 6 x $10.00 x 0.80 = $48.00.
-	Price MoneyV2 `json:"price,omitempty"`
+	Price MoneyV2 `json:"price"`
 	// UnitPrice is the resulting price per unit for the variant associated with the selling plan. If the variant isn't sold by quantity or measurement, then this field returns `null`.
-	UnitPrice MoneyV2 `json:"unitPrice,omitempty"`
+	UnitPrice *MoneyV2 `json:"unitPrice"`
 }
 
 /*
@@ -646,21 +1775,21 @@ Local pick-up must be enabled in the  store's shipping settings, otherwise this
 */
 type StoreAvailability struct {
 	// Available is the whether or not this product variant is in-stock at this location.
-	Available bool `json:"available,omitempty"`
+	Available bool `json:"available"`
 	// Location is the location where this product variant is stocked at.
-	Location Location `json:"location,omitempty"`
+	Location Location `json:"location"`
 	// PickUpTime is the estimated amount of time it takes for pickup to be ready (Example: Usually ready in 24 hours).
-	PickUpTime string `json:"pickUpTime,omitempty"`
+	PickUpTime string `json:"pickUpTime"`
 }
 
 // Location: Represents a location where product inventory is held.
 type Location struct {
 	// Address is the address of the location.
-	Address LocationAddress `json:"address,omitempty"`
+	Address LocationAddress `json:"address"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Name is the name of the location.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 }
 
 /*
@@ -668,31 +1797,31 @@ LocationAddress: Represents the address of a location.
 */
 type LocationAddress struct {
 	// Address1 is the first line of the address for the location.
-	Address1 string `json:"address1,omitempty"`
+	Address1 *string `json:"address1"`
 	// Address2 is the second line of the address for the location.
-	Address2 string `json:"address2,omitempty"`
+	Address2 *string `json:"address2"`
 	// City is the city of the location.
-	City string `json:"city,omitempty"`
+	City *string `json:"city"`
 	// Country is the country of the location.
-	Country string `json:"country,omitempty"`
+	Country *string `json:"country"`
 	// CountryCode is the country code of the location.
-	CountryCode string `json:"countryCode,omitempty"`
+	CountryCode *string `json:"countryCode"`
 	// Formatted is a formatted version of the address for the location.
-	Formatted []string `json:"formatted,omitempty"`
+	Formatted []string `json:"formatted"`
 	// Latitude is the latitude coordinates of the location.
-	Latitude float64 `json:"latitude,omitempty"`
+	Latitude *float64 `json:"latitude"`
 	// Longitude is the longitude coordinates of the location.
-	Longitude float64 `json:"longitude,omitempty"`
+	Longitude *float64 `json:"longitude"`
 	// Phone is the phone number of the location.
-	Phone string `json:"phone,omitempty"`
+	Phone *string `json:"phone"`
 	// Province is the province of the location.
-	Province string `json:"province,omitempty"`
+	Province *string `json:"province"`
 	/*
 	   ProvinceCode is the code for the province, state, or district of the address of the location.
 	*/
-	ProvinceCode string `json:"provinceCode,omitempty"`
+	ProvinceCode *string `json:"provinceCode"`
 	// ZIP is the ZIP code of the location.
-	ZIP string `json:"zip,omitempty"`
+	ZIP *string `json:"zip"`
 }
 
 /*
@@ -700,21 +1829,24 @@ UnitPriceMeasurement: The measurement used to calculate a unit price for a produ
 */
 type UnitPriceMeasurement struct {
 	// MeasuredType is the type of unit of measurement for the unit price measurement.
-	MeasuredType string `json:"measuredType,omitempty"`
+	MeasuredType *UnitPriceMeasurementMeasuredType `json:"measuredType"`
 	// QuantityUnit is the quantity unit for the unit price measurement.
-	QuantityUnit string `json:"quantityUnit,omitempty"`
+	QuantityUnit *UnitPriceMeasurementMeasuredUnit `json:"quantityUnit"`
 	// QuantityValue is the quantity value for the unit price measurement.
-	QuantityValue float64 `json:"quantityValue,omitempty"`
+	QuantityValue float64 `json:"quantityValue"`
 	// ReferenceUnit is the reference unit for the unit price measurement.
-	ReferenceUnit string `json:"referenceUnit,omitempty"`
+	ReferenceUnit *UnitPriceMeasurementMeasuredUnit `json:"referenceUnit"`
 	// ReferenceValue is the reference value for the unit price measurement.
-	ReferenceValue int `json:"referenceValue,omitempty"`
+	ReferenceValue int `json:"referenceValue"`
 }
 
 // ProductVariantSortKeys: The set of valid sort keys for the ProductVariant query.
 type ProductVariantSortKeys string
 
 const (
+	// ProductVariantSortKeysUnknown is the zero value of ProductVariantSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	ProductVariantSortKeysUnknown   ProductVariantSortKeys = ""
 	ProductVariantSortKeysTitle     ProductVariantSortKeys = "TITLE"
 	ProductVariantSortKeysSKU       ProductVariantSortKeys = "SKU"
 	ProductVariantSortKeysPosition  ProductVariantSortKeys = "POSITION"
@@ -722,246 +1854,322 @@ const (
 	ProductVariantSortKeysRelevance ProductVariantSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of ProductVariantSortKeys, in schema order.
+func (ProductVariantSortKeys) Values() []ProductVariantSortKeys {
+	return []ProductVariantSortKeys{
+		ProductVariantSortKeysTitle,
+		ProductVariantSortKeysSKU,
+		ProductVariantSortKeysPosition,
+		ProductVariantSortKeysId,
+		ProductVariantSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e ProductVariantSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of ProductVariantSortKeys.
+func (e ProductVariantSortKeys) IsValid() bool {
+	switch e {
+	case ProductVariantSortKeysTitle, ProductVariantSortKeysSKU, ProductVariantSortKeysPosition, ProductVariantSortKeysId, ProductVariantSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e ProductVariantSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// ProductVariantSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *ProductVariantSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // Filter: A filter that is supported on the parent field.
 type Filter struct {
 	// Id is a unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Label is a human-friendly string for this filter.
-	Label string `json:"label,omitempty"`
+	Label string `json:"label"`
 	// Type is an enumeration that denotes the type of data this filter represents.
-	Type FilterType `json:"type,omitempty"`
+	Type FilterType `json:"type"`
 	// Values is the list of values for this filter.
-	Values []FilterValue `json:"values,omitempty"`
+	Values []FilterValue `json:"values"`
 }
 
 // FilterType: Denotes the type of data this filter group represents.
 type FilterType string
 
 const (
+	// FilterTypeUnknown is the zero value of FilterType. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	FilterTypeUnknown    FilterType = ""
 	FilterTypeList       FilterType = "LIST"
 	FilterTypePriceRange FilterType = "PRICE_RANGE"
 )
 
+// Values returns all the values of FilterType, in schema order.
+func (FilterType) Values() []FilterType {
+	return []FilterType{
+		FilterTypeList,
+		FilterTypePriceRange,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e FilterType) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of FilterType.
+func (e FilterType) IsValid() bool {
+	switch e {
+	case FilterTypeList, FilterTypePriceRange:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e FilterType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// FilterTypeUnknown, or returning an error if StrictEnums is set.
+func (e *FilterType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // FilterValue: A selectable value within a filter.
 type FilterValue struct {
 	// Count is the number of results that match this filter value.
-	Count int `json:"count,omitempty"`
+	Count int `json:"count"`
 	// Id is a unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	/*
 	   Input is an input object that can be used to filter by this value on the parent field.
 
 	   The value is provided as a helper for building dynamic filtering UI. For example, if you have a list of selected `FilterValue` objects, you can combine their respective `input` values to use in a subsequent query.
 	*/
-	Input map[string]interface{} `json:"input,omitempty"`
+	Input map[string]interface{} `json:"input"`
 	// Label is a human-friendly string for this filter value.
-	Label string `json:"label,omitempty"`
+	Label string `json:"label"`
 }
 
 // Customer: A customer represents a customer account with the shop. Customer accounts store contact information for the customer, saving logged-in customers the trouble of having to provide it at every checkout.
 type Customer struct {
 	// AcceptsMarketing indicates whether the customer has consented to be sent marketing material via email.
-	AcceptsMarketing bool `json:"acceptsMarketing,omitempty"`
+	AcceptsMarketing bool `json:"acceptsMarketing"`
 	// Addresses is a list of addresses for the customer.
-	Addresses Connection[MailingAddress] `json:"addresses,omitempty"`
+	Addresses Connection[MailingAddress] `json:"addresses"`
 	// CreatedAt is the date and time when the customer was created.
-	CreatedAt time.Time `json:"createdAt,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 	// DefaultAddress is the customer’s default address.
-	DefaultAddress MailingAddress `json:"defaultAddress,omitempty"`
+	DefaultAddress *MailingAddress `json:"defaultAddress"`
 	// DisplayName is the customer’s name, email or phone number.
-	DisplayName string `json:"displayName,omitempty"`
+	DisplayName string `json:"displayName"`
 	// Email is the customer’s email address.
-	Email string `json:"email,omitempty"`
+	Email *string `json:"email"`
 	// FirstName is the customer’s first name.
-	FirstName string `json:"firstName,omitempty"`
+	FirstName *string `json:"firstName"`
 	// Id is a unique identifier for the customer.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// LastIncompleteCheckout is the customer's most recently updated, incomplete checkout.
-	LastIncompleteCheckout Checkout `json:"lastIncompleteCheckout,omitempty"`
+	LastIncompleteCheckout *Checkout `json:"lastIncompleteCheckout"`
 	// LastName is the customer’s last name.
-	LastName string `json:"lastName,omitempty"`
+	LastName *string `json:"lastName"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	// Orders is the orders associated with the customer.
-	Orders Connection[Order] `json:"orders,omitempty"`
+	Orders Connection[Order] `json:"orders"`
 	// Phone is the customer’s phone number.
-	Phone string `json:"phone,omitempty"`
+	Phone *string `json:"phone"`
 	/*
 	   Tags is a comma separated list of tags that have been added to the customer.
 	   Additional access scope required: unauthenticated_read_customer_tags.
 	*/
-	Tags []string `json:"tags,omitempty"`
+	Tags []string `json:"tags"`
 	// UpdatedAt is the date and time when the customer information was updated.
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // MailingAddress: Represents a mailing address for customers and shipping.
 type MailingAddress struct {
 	// Address1 is the first line of the address. Typically the street address or PO Box number.
-	Address1 string `json:"address1,omitempty"`
+	Address1 *string `json:"address1"`
 	/*
 	   Address2 is the second line of the address. Typically the number of the apartment, suite, or unit.
 	*/
-	Address2 string `json:"address2,omitempty"`
+	Address2 *string `json:"address2"`
 	/*
 	   City is the name of the city, district, village, or town.
 	*/
-	City string `json:"city,omitempty"`
+	City *string `json:"city"`
 	/*
 	   Company is the name of the customer's company or organization.
 	*/
-	Company string `json:"company,omitempty"`
+	Company *string `json:"company"`
 	/*
 	   Country is the name of the country.
 	*/
-	Country string `json:"country,omitempty"`
+	Country *string `json:"country"`
 	/*
 	   CountryCode is the two-letter code for the country of the address.
 
 	   For example, US.
 	*/
-	CountryCode string `json:"countryCode,omitempty"`
+	CountryCode *string `json:"countryCode"`
 	/*
 	   CountryCodeV2 is the two-letter code for the country of the address.
 
 	   For example, US.
 	*/
-	CountryCodeV2 string `json:"countryCodeV2,omitempty"`
+	CountryCodeV2 *CountryCode `json:"countryCodeV2"`
 	// FirstName is the first name of the customer.
-	FirstName string `json:"firstName,omitempty"`
+	FirstName *string `json:"firstName"`
 	// Formatted is a formatted version of the address, customized by the provided arguments.
-	Formatted []string `json:"formatted,omitempty"`
+	Formatted []string `json:"formatted"`
 	// FormattedArea is a comma-separated list of the values for city, province, and country.
-	FormattedArea string `json:"formattedArea,omitempty"`
+	FormattedArea *string `json:"formattedArea"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// LastName is the last name of the customer.
-	LastName string `json:"lastName,omitempty"`
+	LastName *string `json:"lastName"`
 	// Latitude is the latitude coordinate of the customer address.
-	Latitude float64 `json:"latitude,omitempty"`
+	Latitude *float64 `json:"latitude"`
 	// Longitude is the longitude coordinate of the customer address.
-	Longitude float64 `json:"longitude,omitempty"`
+	Longitude *float64 `json:"longitude"`
 	/*
 	   Name is the full name of the customer, based on firstName and lastName.
 	*/
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name"`
 	/*
 	   Phone is a unique phone number for the customer.
 
 	   Formatted using E.164 standard. For example, _+16135551111_.
 	*/
-	Phone string `json:"phone,omitempty"`
+	Phone *string `json:"phone"`
 	// Province is the region of the address, such as the province, state, or district.
-	Province string `json:"province,omitempty"`
+	Province *string `json:"province"`
 	/*
 	   ProvinceCode is the two-letter code for the region.
 
 	   For example, ON.
 	*/
-	ProvinceCode string `json:"provinceCode,omitempty"`
+	ProvinceCode *string `json:"provinceCode"`
 	// ZIP is the zip or postal code of the address.
-	ZIP string `json:"zip,omitempty"`
+	ZIP *string `json:"zip"`
 }
 
 // Checkout: A container for all the information required to checkout items and pay.
 type Checkout struct {
 	// AppliedGiftCards is the gift cards used on the checkout.
-	AppliedGiftCards []AppliedGiftCard `json:"appliedGiftCards,omitempty"`
+	AppliedGiftCards []AppliedGiftCard `json:"appliedGiftCards"`
 	/*
 	   AvailableShippingRates is the available shipping rates for this Checkout.
 	   Should only be used when checkout `requiresShipping` is `true` and
 	   the shipping address is valid.
 	*/
-	AvailableShippingRates AvailableShippingRates `json:"availableShippingRates,omitempty"`
+	AvailableShippingRates *AvailableShippingRates `json:"availableShippingRates"`
 	// BuyerIdentity is the identity of the customer associated with the checkout.
-	BuyerIdentity CheckoutBuyerIdentity `json:"buyerIdentity,omitempty"`
+	BuyerIdentity CheckoutBuyerIdentity `json:"buyerIdentity"`
 	// CompletedAt is the date and time when the checkout was completed.
-	CompletedAt time.Time `json:"completedAt,omitempty"`
+	CompletedAt *time.Time `json:"completedAt"`
 	// CreatedAt is the date and time when the checkout was created.
-	CreatedAt time.Time `json:"createdAt,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 	// CurrencyCode is the currency code for the Checkout.
-	CurrencyCode string `json:"currencyCode,omitempty"`
+	CurrencyCode CurrencyCode `json:"currencyCode"`
 	// CustomAttributes is a list of extra information that is added to the checkout.
-	CustomAttributes []Attribute `json:"customAttributes,omitempty"`
+	CustomAttributes []Attribute `json:"customAttributes"`
 	// DiscountApplications is the discounts that have been applied on the checkout.
-	DiscountApplications Connection[DiscountApplication] `json:"discountApplications,omitempty"`
+	DiscountApplications Connection[DiscountApplication] `json:"discountApplications"`
 	// Email is the email attached to this checkout.
-	Email string `json:"email,omitempty"`
+	Email *string `json:"email"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// LineItems is a list of line item objects, each one containing information about an item in the checkout.
-	LineItems Connection[CheckoutLineItem] `json:"lineItems,omitempty"`
+	LineItems Connection[CheckoutLineItem] `json:"lineItems"`
 	// LineItemsSubtotalPrice is the sum of all the prices of all the items in the checkout. Duties, taxes, shipping and discounts excluded.
-	LineItemsSubtotalPrice MoneyV2 `json:"lineItemsSubtotalPrice,omitempty"`
+	LineItemsSubtotalPrice MoneyV2 `json:"lineItemsSubtotalPrice"`
 	// Note is the note associated with the checkout.
-	Note string `json:"note,omitempty"`
+	Note *string `json:"note"`
 	// Order is the resulting order from a paid checkout.
-	Order Order `json:"order,omitempty"`
+	Order *Order `json:"order"`
 	// OrderStatusURL is the Order Status Page for this Checkout, null when checkout is not completed.
-	OrderStatusURL string `json:"orderStatusUrl,omitempty"`
+	OrderStatusURL *string `json:"orderStatusUrl"`
 	// PaymentDue is the amount left to be paid. This is equal to the cost of the line items, taxes and shipping minus discounts and gift cards.
-	PaymentDue string `json:"paymentDue,omitempty"`
+	PaymentDue Decimal `json:"paymentDue"`
 	// PaymentDueV2 is the amount left to be paid. This is equal to the cost of the line items, duties, taxes and shipping minus discounts and gift cards.
-	PaymentDueV2 MoneyV2 `json:"paymentDueV2,omitempty"`
+	PaymentDueV2 MoneyV2 `json:"paymentDueV2"`
 	/*
 	   Ready is the whether or not the Checkout is ready and can be completed. Checkouts may
 	   have asynchronous operations that can take time to finish. If you want
 	   to complete a checkout or ensure all the fields are populated and up to
 	   date, polling is required until the value is true.
 	*/
-	Ready bool `json:"ready,omitempty"`
+	Ready bool `json:"ready"`
 	// RequiresShipping is the states whether or not the fulfillment requires shipping.
-	RequiresShipping bool `json:"requiresShipping,omitempty"`
+	RequiresShipping bool `json:"requiresShipping"`
 	// ShippingAddress is the shipping address to where the line items will be shipped.
-	ShippingAddress MailingAddress `json:"shippingAddress,omitempty"`
+	ShippingAddress *MailingAddress `json:"shippingAddress"`
 	/*
 	   ShippingDiscountAllocations is the discounts that have been allocated onto the shipping line by discount applications.
 	*/
-	ShippingDiscountAllocations []DiscountAllocation `json:"shippingDiscountAllocations,omitempty"`
+	ShippingDiscountAllocations []DiscountAllocation `json:"shippingDiscountAllocations"`
 	// ShippingLine is the once a shipping rate is selected by the customer it is transitioned to a `shipping_line` object.
-	ShippingLine ShippingRate `json:"shippingLine,omitempty"`
+	ShippingLine *ShippingRate `json:"shippingLine"`
 	// SubtotalPrice is the price of the checkout before shipping and taxes.
-	SubtotalPrice string `json:"subtotalPrice,omitempty"`
+	SubtotalPrice Decimal `json:"subtotalPrice"`
 	// SubtotalPriceV2 is the price of the checkout before duties, shipping and taxes.
-	SubtotalPriceV2 MoneyV2 `json:"subtotalPriceV2,omitempty"`
+	SubtotalPriceV2 MoneyV2 `json:"subtotalPriceV2"`
 	// TaxExempt is the specifies if the Checkout is tax exempt.
-	TaxExempt bool `json:"taxExempt,omitempty"`
+	TaxExempt bool `json:"taxExempt"`
 	// TaxesIncluded is the specifies if taxes are included in the line item and shipping line prices.
-	TaxesIncluded bool `json:"taxesIncluded,omitempty"`
+	TaxesIncluded bool `json:"taxesIncluded"`
 	// TotalDuties is the sum of all the duties applied to the line items in the checkout.
-	TotalDuties MoneyV2 `json:"totalDuties,omitempty"`
+	TotalDuties *MoneyV2 `json:"totalDuties"`
 	// TotalPrice is the sum of all the prices of all the items in the checkout, taxes and discounts included.
-	TotalPrice string `json:"totalPrice,omitempty"`
+	TotalPrice Decimal `json:"totalPrice"`
 	// TotalPriceV2 is the sum of all the prices of all the items in the checkout, duties, taxes and discounts included.
-	TotalPriceV2 MoneyV2 `json:"totalPriceV2,omitempty"`
+	TotalPriceV2 MoneyV2 `json:"totalPriceV2"`
 	// TotalTax is the sum of all the taxes applied to the line items and shipping lines in the checkout.
-	TotalTax string `json:"totalTax,omitempty"`
+	TotalTax Decimal `json:"totalTax"`
 	// TotalTaxV2 is the sum of all the taxes applied to the line items and shipping lines in the checkout.
-	TotalTaxV2 MoneyV2 `json:"totalTaxV2,omitempty"`
+	TotalTaxV2 MoneyV2 `json:"totalTaxV2"`
 	// UpdatedAt is the date and time when the checkout was last updated.
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
 	// WebURL is the url pointing to the checkout accessible from the web.
-	WebURL string `json:"webUrl,omitempty"`
+	WebURL string `json:"webUrl"`
 }
 
 // AppliedGiftCard: Details about the gift card used on the checkout.
 type AppliedGiftCard struct {
 	// AmountUsed is the amount that was taken from the gift card by applying it.
-	AmountUsed string `json:"amountUsed,omitempty"`
+	AmountUsed Decimal `json:"amountUsed"`
 	// AmountUsedV2 is the amount that was taken from the gift card by applying it.
-	AmountUsedV2 MoneyV2 `json:"amountUsedV2,omitempty"`
+	AmountUsedV2 MoneyV2 `json:"amountUsedV2"`
 	// Balance is the amount left on the gift card.
-	Balance string `json:"balance,omitempty"`
+	Balance Decimal `json:"balance"`
 	// BalanceV2 is the amount left on the gift card.
-	BalanceV2 MoneyV2 `json:"balanceV2,omitempty"`
+	BalanceV2 MoneyV2 `json:"balanceV2"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// LastCharacters is the last characters of the gift card.
-	LastCharacters string `json:"lastCharacters,omitempty"`
+	LastCharacters string `json:"lastCharacters"`
 	// PresentmentAmountUsed is the amount that was applied to the checkout in its currency.
-	PresentmentAmountUsed MoneyV2 `json:"presentmentAmountUsed,omitempty"`
+	PresentmentAmountUsed MoneyV2 `json:"presentmentAmountUsed"`
 }
 
 // AvailableShippingRates: A collection of available shipping rates for a checkout.
@@ -971,61 +2179,217 @@ type AvailableShippingRates struct {
 	   The `shippingRates` field is `null` when this value is `false`.
 	   This field should be polled until its value becomes `true`.
 	*/
-	Ready bool `json:"ready,omitempty"`
+	Ready bool `json:"ready"`
 	// ShippingRates is the fetched shipping rates. `null` until the `ready` field is `true`.
-	ShippingRates []ShippingRate `json:"shippingRates,omitempty"`
+	ShippingRates []ShippingRate `json:"shippingRates"`
 }
 
 // ShippingRate: A shipping rate to be applied to a checkout.
 type ShippingRate struct {
 	// Handle is the human-readable unique identifier for this shipping rate.
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Price is the price of this shipping rate.
-	Price string `json:"price,omitempty"`
+	Price Decimal `json:"price"`
 	// PriceV2 is the price of this shipping rate.
-	PriceV2 MoneyV2 `json:"priceV2,omitempty"`
+	PriceV2 MoneyV2 `json:"priceV2"`
 	// Title is the title of this shipping rate.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 }
 
 // CheckoutBuyerIdentity: The identity of the customer associated with the checkout.
 type CheckoutBuyerIdentity struct {
 	// CountryCode is the country code for the checkout. For example, `CA`.
-	CountryCode string `json:"countryCode,omitempty"`
+	CountryCode *CountryCode `json:"countryCode"`
 }
 
 // Attribute: Represents a generic custom attribute.
 type Attribute struct {
 	// Key is the key or name of the attribute.
-	Key string `json:"key,omitempty"`
+	Key string `json:"key"`
 	// Value is the value of the attribute.
-	Value string `json:"value,omitempty"`
+	Value *string `json:"value"`
+}
+
+/*
+DiscountApplication: Discount applications capture the intentions of a discount source at
+the time of application.
+*/
+//
+// It's implemented by pointers to the types implementing it: AutomaticDiscountApplication, DiscountCodeApplication, ManualDiscountApplication, ScriptDiscountApplication.
+type DiscountApplication interface {
+	isDiscountApplication()
+	// GetAllocationMethod returns the allocationMethod field shared by the types implementing it.
+	GetAllocationMethod() DiscountApplicationAllocationMethod
+	// GetTargetSelection returns the targetSelection field shared by the types implementing it.
+	GetTargetSelection() DiscountApplicationTargetSelection
+	// GetTargetType returns the targetType field shared by the types implementing it.
+	GetTargetType() DiscountApplicationTargetType
+	// GetValue returns the value field shared by the types implementing it.
+	GetValue() PricingValue
+}
+
+func (*AutomaticDiscountApplication) isDiscountApplication() {}
+
+// GetAllocationMethod returns the AllocationMethod of t, implementing DiscountApplication.
+func (t *AutomaticDiscountApplication) GetAllocationMethod() DiscountApplicationAllocationMethod {
+	return t.AllocationMethod
+}
+
+// GetTargetSelection returns the TargetSelection of t, implementing DiscountApplication.
+func (t *AutomaticDiscountApplication) GetTargetSelection() DiscountApplicationTargetSelection {
+	return t.TargetSelection
+}
+
+// GetTargetType returns the TargetType of t, implementing DiscountApplication.
+func (t *AutomaticDiscountApplication) GetTargetType() DiscountApplicationTargetType {
+	return t.TargetType
+}
+
+// GetValue returns the Value of t, implementing DiscountApplication.
+func (t *AutomaticDiscountApplication) GetValue() PricingValue {
+	return t.Value
+}
+
+func (*DiscountCodeApplication) isDiscountApplication() {}
+
+// GetAllocationMethod returns the AllocationMethod of t, implementing DiscountApplication.
+func (t *DiscountCodeApplication) GetAllocationMethod() DiscountApplicationAllocationMethod {
+	return t.AllocationMethod
+}
+
+// GetTargetSelection returns the TargetSelection of t, implementing DiscountApplication.
+func (t *DiscountCodeApplication) GetTargetSelection() DiscountApplicationTargetSelection {
+	return t.TargetSelection
+}
+
+// GetTargetType returns the TargetType of t, implementing DiscountApplication.
+func (t *DiscountCodeApplication) GetTargetType() DiscountApplicationTargetType {
+	return t.TargetType
+}
+
+// GetValue returns the Value of t, implementing DiscountApplication.
+func (t *DiscountCodeApplication) GetValue() PricingValue {
+	return t.Value
+}
+
+func (*ManualDiscountApplication) isDiscountApplication() {}
+
+// GetAllocationMethod returns the AllocationMethod of t, implementing DiscountApplication.
+func (t *ManualDiscountApplication) GetAllocationMethod() DiscountApplicationAllocationMethod {
+	return t.AllocationMethod
+}
+
+// GetTargetSelection returns the TargetSelection of t, implementing DiscountApplication.
+func (t *ManualDiscountApplication) GetTargetSelection() DiscountApplicationTargetSelection {
+	return t.TargetSelection
+}
+
+// GetTargetType returns the TargetType of t, implementing DiscountApplication.
+func (t *ManualDiscountApplication) GetTargetType() DiscountApplicationTargetType {
+	return t.TargetType
+}
+
+// GetValue returns the Value of t, implementing DiscountApplication.
+func (t *ManualDiscountApplication) GetValue() PricingValue {
+	return t.Value
+}
+
+func (*ScriptDiscountApplication) isDiscountApplication() {}
+
+// GetAllocationMethod returns the AllocationMethod of t, implementing DiscountApplication.
+func (t *ScriptDiscountApplication) GetAllocationMethod() DiscountApplicationAllocationMethod {
+	return t.AllocationMethod
+}
+
+// GetTargetSelection returns the TargetSelection of t, implementing DiscountApplication.
+func (t *ScriptDiscountApplication) GetTargetSelection() DiscountApplicationTargetSelection {
+	return t.TargetSelection
+}
+
+// GetTargetType returns the TargetType of t, implementing DiscountApplication.
+func (t *ScriptDiscountApplication) GetTargetType() DiscountApplicationTargetType {
+	return t.TargetType
 }
 
-/*
-DiscountApplication: Discount applications capture the intentions of a discount source at
-the time of application.
-*/
-type DiscountApplication struct {
-	// AllocationMethod is the method by which the discount's value is allocated to its entitled items.
-	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod,omitempty"`
-	// TargetSelection is the which lines of targetType that the discount is allocated over.
-	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection,omitempty"`
-	// TargetType is the type of line that the discount is applicable towards.
-	TargetType DiscountApplicationTargetType `json:"targetType,omitempty"`
-	// Value is the value of the discount application.
-	Value string `json:"value,omitempty"`
+// GetValue returns the Value of t, implementing DiscountApplication.
+func (t *ScriptDiscountApplication) GetValue() PricingValue {
+	return t.Value
+}
+
+// unmarshalDiscountApplication decodes a DiscountApplication according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalDiscountApplication(data []byte) (DiscountApplication, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "AutomaticDiscountApplication":
+		v := new(AutomaticDiscountApplication)
+		return v, json.Unmarshal(data, v)
+	case "DiscountCodeApplication":
+		v := new(DiscountCodeApplication)
+		return v, json.Unmarshal(data, v)
+	case "ManualDiscountApplication":
+		v := new(ManualDiscountApplication)
+		return v, json.Unmarshal(data, v)
+	case "ScriptDiscountApplication":
+		v := new(ScriptDiscountApplication)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // DiscountApplicationAllocationMethod: The method by which the discount's value is allocated onto its entitled lines.
 type DiscountApplicationAllocationMethod string
 
 const (
-	DiscountApplicationAllocationMethodAcross DiscountApplicationAllocationMethod = "ACROSS"
-	DiscountApplicationAllocationMethodEach   DiscountApplicationAllocationMethod = "EACH"
-	DiscountApplicationAllocationMethodOne    DiscountApplicationAllocationMethod = "ONE"
+	// DiscountApplicationAllocationMethodUnknown is the zero value of DiscountApplicationAllocationMethod. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	DiscountApplicationAllocationMethodUnknown DiscountApplicationAllocationMethod = ""
+	DiscountApplicationAllocationMethodAcross  DiscountApplicationAllocationMethod = "ACROSS"
+	DiscountApplicationAllocationMethodEach    DiscountApplicationAllocationMethod = "EACH"
+	DiscountApplicationAllocationMethodOne     DiscountApplicationAllocationMethod = "ONE"
 )
 
+// Values returns all the values of DiscountApplicationAllocationMethod, in schema order.
+func (DiscountApplicationAllocationMethod) Values() []DiscountApplicationAllocationMethod {
+	return []DiscountApplicationAllocationMethod{
+		DiscountApplicationAllocationMethodAcross,
+		DiscountApplicationAllocationMethodEach,
+		DiscountApplicationAllocationMethodOne,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e DiscountApplicationAllocationMethod) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of DiscountApplicationAllocationMethod.
+func (e DiscountApplicationAllocationMethod) IsValid() bool {
+	switch e {
+	case DiscountApplicationAllocationMethodAcross, DiscountApplicationAllocationMethodEach, DiscountApplicationAllocationMethodOne:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e DiscountApplicationAllocationMethod) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// DiscountApplicationAllocationMethodUnknown, or returning an error if StrictEnums is set.
+func (e *DiscountApplicationAllocationMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 /*
 DiscountApplicationTargetSelection: Which lines on the order that the discount is allocated over, of the type
 defined by the Discount Application's target_type.
@@ -1033,43 +2397,151 @@ defined by the Discount Application's target_type.
 type DiscountApplicationTargetSelection string
 
 const (
+	// DiscountApplicationTargetSelectionUnknown is the zero value of DiscountApplicationTargetSelection. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	DiscountApplicationTargetSelectionUnknown  DiscountApplicationTargetSelection = ""
 	DiscountApplicationTargetSelectionAll      DiscountApplicationTargetSelection = "ALL"
 	DiscountApplicationTargetSelectionEntitled DiscountApplicationTargetSelection = "ENTITLED"
 	DiscountApplicationTargetSelectionExplicit DiscountApplicationTargetSelection = "EXPLICIT"
 )
 
+// Values returns all the values of DiscountApplicationTargetSelection, in schema order.
+func (DiscountApplicationTargetSelection) Values() []DiscountApplicationTargetSelection {
+	return []DiscountApplicationTargetSelection{
+		DiscountApplicationTargetSelectionAll,
+		DiscountApplicationTargetSelectionEntitled,
+		DiscountApplicationTargetSelectionExplicit,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e DiscountApplicationTargetSelection) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of DiscountApplicationTargetSelection.
+func (e DiscountApplicationTargetSelection) IsValid() bool {
+	switch e {
+	case DiscountApplicationTargetSelectionAll, DiscountApplicationTargetSelectionEntitled, DiscountApplicationTargetSelectionExplicit:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e DiscountApplicationTargetSelection) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// DiscountApplicationTargetSelectionUnknown, or returning an error if StrictEnums is set.
+func (e *DiscountApplicationTargetSelection) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 /*
 DiscountApplicationTargetType: The type of line (i.e. line item or shipping line) on an order that the discount is applicable towards.
 */
 type DiscountApplicationTargetType string
 
 const (
+	// DiscountApplicationTargetTypeUnknown is the zero value of DiscountApplicationTargetType. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	DiscountApplicationTargetTypeUnknown      DiscountApplicationTargetType = ""
 	DiscountApplicationTargetTypeLineItem     DiscountApplicationTargetType = "LINE_ITEM"
 	DiscountApplicationTargetTypeShippingLine DiscountApplicationTargetType = "SHIPPING_LINE"
 )
 
+// Values returns all the values of DiscountApplicationTargetType, in schema order.
+func (DiscountApplicationTargetType) Values() []DiscountApplicationTargetType {
+	return []DiscountApplicationTargetType{
+		DiscountApplicationTargetTypeLineItem,
+		DiscountApplicationTargetTypeShippingLine,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e DiscountApplicationTargetType) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of DiscountApplicationTargetType.
+func (e DiscountApplicationTargetType) IsValid() bool {
+	switch e {
+	case DiscountApplicationTargetTypeLineItem, DiscountApplicationTargetTypeShippingLine:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e DiscountApplicationTargetType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// DiscountApplicationTargetTypeUnknown, or returning an error if StrictEnums is set.
+func (e *DiscountApplicationTargetType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+// PricingValue: The price value (fixed or percentage) for a discount application.
+//
+// It's implemented by pointers to its member types: MoneyV2, PricingPercentageValue.
+type PricingValue interface {
+	isPricingValue()
+}
+
+func (*MoneyV2) isPricingValue() {}
+
+func (*PricingPercentageValue) isPricingValue() {}
+
+// unmarshalPricingValue decodes a PricingValue according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalPricingValue(data []byte) (PricingValue, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "MoneyV2":
+		v := new(MoneyV2)
+		return v, json.Unmarshal(data, v)
+	case "PricingPercentageValue":
+		v := new(PricingPercentageValue)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
+}
+
 // PricingPercentageValue: The value of the percentage pricing object.
 type PricingPercentageValue struct {
 	// Percentage is the percentage value of the object.
-	Percentage float64 `json:"percentage,omitempty"`
+	Percentage float64 `json:"percentage"`
 }
 
 // CheckoutLineItem: A single line item in the checkout, grouped by variant and attributes.
 type CheckoutLineItem struct {
 	// CustomAttributes is the extra information in the form of an array of Key-Value pairs about the line item.
-	CustomAttributes []Attribute `json:"customAttributes,omitempty"`
+	CustomAttributes []Attribute `json:"customAttributes"`
 	// DiscountAllocations is the discounts that have been allocated onto the checkout line item by discount applications.
-	DiscountAllocations []DiscountAllocation `json:"discountAllocations,omitempty"`
+	DiscountAllocations []DiscountAllocation `json:"discountAllocations"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Quantity is the quantity of the line item.
-	Quantity int `json:"quantity,omitempty"`
+	Quantity int `json:"quantity"`
 	// Title is the title of the line item. Defaults to the product's title.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// UnitPrice is the unit price of the line item.
-	UnitPrice MoneyV2 `json:"unitPrice,omitempty"`
+	UnitPrice *MoneyV2 `json:"unitPrice"`
 	// Variant is the product variant of the line item.
-	Variant ProductVariant `json:"variant,omitempty"`
+	Variant *ProductVariant `json:"variant"`
 }
 
 /*
@@ -1077,104 +2549,129 @@ DiscountAllocation: An amount discounting the line that has been allocated by a
 */
 type DiscountAllocation struct {
 	// AllocatedAmount is amount of discount allocated.
-	AllocatedAmount MoneyV2 `json:"allocatedAmount,omitempty"`
+	AllocatedAmount MoneyV2 `json:"allocatedAmount"`
 	// DiscountApplication is the discount this allocated amount originated from.
-	DiscountApplication DiscountApplication `json:"discountApplication,omitempty"`
+	DiscountApplication DiscountApplication `json:"discountApplication"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *DiscountAllocation) UnmarshalJSON(data []byte) error {
+	type alias DiscountAllocation
+
+	var raw struct {
+		*alias
+		DiscountApplication json.RawMessage `json:"discountApplication"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstract(raw.DiscountApplication, &t.DiscountApplication); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // Order: An order is a customer’s completed request to purchase one or more products from a shop. An order is created when a customer completes the checkout process, during which time they provides an email address, billing address and payment information.
 type Order struct {
 	// CancelReason is the reason for the order's cancellation. Returns `null` if the order wasn't canceled.
-	CancelReason OrderCancelReason `json:"cancelReason,omitempty"`
+	CancelReason *OrderCancelReason `json:"cancelReason"`
 	// CanceledAt is the date and time when the order was canceled. Returns null if the order wasn't canceled.
-	CanceledAt time.Time `json:"canceledAt,omitempty"`
+	CanceledAt *time.Time `json:"canceledAt"`
 	// CurrencyCode is the code of the currency used for the payment.
-	CurrencyCode string `json:"currencyCode,omitempty"`
+	CurrencyCode CurrencyCode `json:"currencyCode"`
 	// CurrentSubtotalPrice is the subtotal of line items and their discounts, excluding line items that have been removed. Does not contain order-level discounts, duties, shipping costs, or shipping discounts. Taxes are not included unless the order is a taxes-included order.
-	CurrentSubtotalPrice MoneyV2 `json:"currentSubtotalPrice,omitempty"`
+	CurrentSubtotalPrice MoneyV2 `json:"currentSubtotalPrice"`
 	// CurrentTotalDuties is the total cost of duties for the order, including refunds.
-	CurrentTotalDuties MoneyV2 `json:"currentTotalDuties,omitempty"`
+	CurrentTotalDuties *MoneyV2 `json:"currentTotalDuties"`
 	// CurrentTotalPrice is the total amount of the order, including duties, taxes and discounts, minus amounts for line items that have been removed.
-	CurrentTotalPrice MoneyV2 `json:"currentTotalPrice,omitempty"`
+	CurrentTotalPrice MoneyV2 `json:"currentTotalPrice"`
 	// CurrentTotalTax is the total of all taxes applied to the order, excluding taxes for returned line items.
-	CurrentTotalTax MoneyV2 `json:"currentTotalTax,omitempty"`
+	CurrentTotalTax MoneyV2 `json:"currentTotalTax"`
 	// CustomerLocale is the locale code in which this specific order happened.
-	CustomerLocale string `json:"customerLocale,omitempty"`
+	CustomerLocale *string `json:"customerLocale"`
 	// CustomerURL is the unique URL that the customer can use to access the order.
-	CustomerURL string `json:"customerUrl,omitempty"`
+	CustomerURL *string `json:"customerUrl"`
 	// DiscountApplications is the discounts that have been applied on the order.
-	DiscountApplications Connection[DiscountApplication] `json:"discountApplications,omitempty"`
+	DiscountApplications Connection[DiscountApplication] `json:"discountApplications"`
 	// Edited is whether the order has had any edits applied or not.
-	Edited bool `json:"edited,omitempty"`
+	Edited bool `json:"edited"`
 	// Email is the customer's email address.
-	Email string `json:"email,omitempty"`
+	Email *string `json:"email"`
 	// FinancialStatus is the financial status of the order.
-	FinancialStatus OrderFinancialStatus `json:"financialStatus,omitempty"`
+	FinancialStatus *OrderFinancialStatus `json:"financialStatus"`
 	// FulfillmentStatus is the fulfillment status for the order.
-	FulfillmentStatus OrderFulfillmentStatus `json:"fulfillmentStatus,omitempty"`
+	FulfillmentStatus OrderFulfillmentStatus `json:"fulfillmentStatus"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// LineItems is a list of the order’s line items.
-	LineItems Connection[OrderLineItem] `json:"lineItems,omitempty"`
+	LineItems Connection[OrderLineItem] `json:"lineItems"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	/*
 	   Name is the unique identifier for the order that appears on the order.
 	   For example, _#1000_ or _Store1001.
 	*/
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// OrderNumber is a unique numeric identifier for the order for use by shop owner and customer.
-	OrderNumber int `json:"orderNumber,omitempty"`
+	OrderNumber int `json:"orderNumber"`
 	// OriginalTotalDuties is the total cost of duties charged at checkout.
-	OriginalTotalDuties MoneyV2 `json:"originalTotalDuties,omitempty"`
+	OriginalTotalDuties *MoneyV2 `json:"originalTotalDuties"`
 	// OriginalTotalPrice is the total price of the order before any applied edits.
-	OriginalTotalPrice MoneyV2 `json:"originalTotalPrice,omitempty"`
+	OriginalTotalPrice MoneyV2 `json:"originalTotalPrice"`
 	// Phone is the customer's phone number for receiving SMS notifications.
-	Phone string `json:"phone,omitempty"`
+	Phone *string `json:"phone"`
 	/*
 	   ProcessedAt is the date and time when the order was imported.
 	   This value can be set to dates in the past when importing from other systems.
 	   If no value is provided, it will be auto-generated based on current date and time.
 	*/
-	ProcessedAt time.Time `json:"processedAt,omitempty"`
+	ProcessedAt time.Time `json:"processedAt"`
 	// ShippingAddress is the address to where the order will be shipped.
-	ShippingAddress MailingAddress `json:"shippingAddress,omitempty"`
+	ShippingAddress *MailingAddress `json:"shippingAddress"`
 	/*
 	   ShippingDiscountAllocations is the discounts that have been allocated onto the shipping line by discount applications.
 	*/
-	ShippingDiscountAllocations []DiscountAllocation `json:"shippingDiscountAllocations,omitempty"`
+	ShippingDiscountAllocations []DiscountAllocation `json:"shippingDiscountAllocations"`
 	// StatusURL is the unique URL for the order's status page.
-	StatusURL string `json:"statusUrl,omitempty"`
+	StatusURL string `json:"statusUrl"`
 	// SubtotalPrice is the price of the order before shipping and taxes.
-	SubtotalPrice string `json:"subtotalPrice,omitempty"`
+	SubtotalPrice *Decimal `json:"subtotalPrice"`
 	// SubtotalPriceV2 is the price of the order before duties, shipping and taxes.
-	SubtotalPriceV2 MoneyV2 `json:"subtotalPriceV2,omitempty"`
+	SubtotalPriceV2 *MoneyV2 `json:"subtotalPriceV2"`
 	// SuccessfulFulfillments is a list of the order’s successful fulfillments.
-	SuccessfulFulfillments []Fulfillment `json:"successfulFulfillments,omitempty"`
+	SuccessfulFulfillments []Fulfillment `json:"successfulFulfillments"`
 	// TotalPrice is the sum of all the prices of all the items in the order, taxes and discounts included (must be positive).
-	TotalPrice string `json:"totalPrice,omitempty"`
+	TotalPrice Decimal `json:"totalPrice"`
 	// TotalPriceV2 is the sum of all the prices of all the items in the order, duties, taxes and discounts included (must be positive).
-	TotalPriceV2 MoneyV2 `json:"totalPriceV2,omitempty"`
+	TotalPriceV2 MoneyV2 `json:"totalPriceV2"`
 	// TotalRefunded is the total amount that has been refunded.
-	TotalRefunded string `json:"totalRefunded,omitempty"`
+	TotalRefunded Decimal `json:"totalRefunded"`
 	// TotalRefundedV2 is the total amount that has been refunded.
-	TotalRefundedV2 MoneyV2 `json:"totalRefundedV2,omitempty"`
+	TotalRefundedV2 MoneyV2 `json:"totalRefundedV2"`
 	// TotalShippingPrice is the total cost of shipping.
-	TotalShippingPrice string `json:"totalShippingPrice,omitempty"`
+	TotalShippingPrice Decimal `json:"totalShippingPrice"`
 	// TotalShippingPriceV2 is the total cost of shipping.
-	TotalShippingPriceV2 MoneyV2 `json:"totalShippingPriceV2,omitempty"`
+	TotalShippingPriceV2 MoneyV2 `json:"totalShippingPriceV2"`
 	// TotalTax is the total cost of taxes.
-	TotalTax string `json:"totalTax,omitempty"`
+	TotalTax *Decimal `json:"totalTax"`
 	// TotalTaxV2 is the total cost of taxes.
-	TotalTaxV2 MoneyV2 `json:"totalTaxV2,omitempty"`
+	TotalTaxV2 *MoneyV2 `json:"totalTaxV2"`
 }
 
 // OrderCancelReason: Represents the reason for the order's cancellation.
 type OrderCancelReason string
 
 const (
+	// OrderCancelReasonUnknown is the zero value of OrderCancelReason. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	OrderCancelReasonUnknown   OrderCancelReason = ""
 	OrderCancelReasonCustomer  OrderCancelReason = "CUSTOMER"
 	OrderCancelReasonFraud     OrderCancelReason = "FRAUD"
 	OrderCancelReasonInventory OrderCancelReason = "INVENTORY"
@@ -1182,10 +2679,51 @@ const (
 	OrderCancelReasonOther     OrderCancelReason = "OTHER"
 )
 
+// Values returns all the values of OrderCancelReason, in schema order.
+func (OrderCancelReason) Values() []OrderCancelReason {
+	return []OrderCancelReason{
+		OrderCancelReasonCustomer,
+		OrderCancelReasonFraud,
+		OrderCancelReasonInventory,
+		OrderCancelReasonDeclined,
+		OrderCancelReasonOther,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e OrderCancelReason) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of OrderCancelReason.
+func (e OrderCancelReason) IsValid() bool {
+	switch e {
+	case OrderCancelReasonCustomer, OrderCancelReasonFraud, OrderCancelReasonInventory, OrderCancelReasonDeclined, OrderCancelReasonOther:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e OrderCancelReason) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// OrderCancelReasonUnknown, or returning an error if StrictEnums is set.
+func (e *OrderCancelReason) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // OrderFinancialStatus: Represents the order's current financial status.
 type OrderFinancialStatus string
 
 const (
+	// OrderFinancialStatusUnknown is the zero value of OrderFinancialStatus. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	OrderFinancialStatusUnknown           OrderFinancialStatus = ""
 	OrderFinancialStatusPending           OrderFinancialStatus = "PENDING"
 	OrderFinancialStatusAuthorized        OrderFinancialStatus = "AUTHORIZED"
 	OrderFinancialStatusPartiallyPaid     OrderFinancialStatus = "PARTIALLY_PAID"
@@ -1195,10 +2733,53 @@ const (
 	OrderFinancialStatusRefunded          OrderFinancialStatus = "REFUNDED"
 )
 
+// Values returns all the values of OrderFinancialStatus, in schema order.
+func (OrderFinancialStatus) Values() []OrderFinancialStatus {
+	return []OrderFinancialStatus{
+		OrderFinancialStatusPending,
+		OrderFinancialStatusAuthorized,
+		OrderFinancialStatusPartiallyPaid,
+		OrderFinancialStatusPartiallyRefunded,
+		OrderFinancialStatusVoided,
+		OrderFinancialStatusPaid,
+		OrderFinancialStatusRefunded,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e OrderFinancialStatus) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of OrderFinancialStatus.
+func (e OrderFinancialStatus) IsValid() bool {
+	switch e {
+	case OrderFinancialStatusPending, OrderFinancialStatusAuthorized, OrderFinancialStatusPartiallyPaid, OrderFinancialStatusPartiallyRefunded, OrderFinancialStatusVoided, OrderFinancialStatusPaid, OrderFinancialStatusRefunded:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e OrderFinancialStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// OrderFinancialStatusUnknown, or returning an error if StrictEnums is set.
+func (e *OrderFinancialStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // OrderFulfillmentStatus: Represents the order's aggregated fulfillment status for display purposes.
 type OrderFulfillmentStatus string
 
 const (
+	// OrderFulfillmentStatusUnknown is the zero value of OrderFulfillmentStatus. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	OrderFulfillmentStatusUnknown            OrderFulfillmentStatus = ""
 	OrderFulfillmentStatusUnfulfilled        OrderFulfillmentStatus = "UNFULFILLED"
 	OrderFulfillmentStatusPartiallyFulfilled OrderFulfillmentStatus = "PARTIALLY_FULFILLED"
 	OrderFulfillmentStatusFulfilled          OrderFulfillmentStatus = "FULFILLED"
@@ -1210,143 +2791,228 @@ const (
 	OrderFulfillmentStatusScheduled          OrderFulfillmentStatus = "SCHEDULED"
 )
 
+// Values returns all the values of OrderFulfillmentStatus, in schema order.
+func (OrderFulfillmentStatus) Values() []OrderFulfillmentStatus {
+	return []OrderFulfillmentStatus{
+		OrderFulfillmentStatusUnfulfilled,
+		OrderFulfillmentStatusPartiallyFulfilled,
+		OrderFulfillmentStatusFulfilled,
+		OrderFulfillmentStatusRestocked,
+		OrderFulfillmentStatusPendingFulfillment,
+		OrderFulfillmentStatusOpen,
+		OrderFulfillmentStatusInProgress,
+		OrderFulfillmentStatusOnHold,
+		OrderFulfillmentStatusScheduled,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e OrderFulfillmentStatus) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of OrderFulfillmentStatus.
+func (e OrderFulfillmentStatus) IsValid() bool {
+	switch e {
+	case OrderFulfillmentStatusUnfulfilled, OrderFulfillmentStatusPartiallyFulfilled, OrderFulfillmentStatusFulfilled, OrderFulfillmentStatusRestocked, OrderFulfillmentStatusPendingFulfillment, OrderFulfillmentStatusOpen, OrderFulfillmentStatusInProgress, OrderFulfillmentStatusOnHold, OrderFulfillmentStatusScheduled:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e OrderFulfillmentStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// OrderFulfillmentStatusUnknown, or returning an error if StrictEnums is set.
+func (e *OrderFulfillmentStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // OrderLineItem: Represents a single line in an order. There is one line item for each distinct product variant.
 type OrderLineItem struct {
 	// CurrentQuantity is the number of entries associated to the line item minus the items that have been removed.
-	CurrentQuantity int `json:"currentQuantity,omitempty"`
+	CurrentQuantity int `json:"currentQuantity"`
 	// CustomAttributes is a list of custom attributes associated to the line item.
-	CustomAttributes []Attribute `json:"customAttributes,omitempty"`
+	CustomAttributes []Attribute `json:"customAttributes"`
 	// DiscountAllocations is the discounts that have been allocated onto the order line item by discount applications.
-	DiscountAllocations []DiscountAllocation `json:"discountAllocations,omitempty"`
+	DiscountAllocations []DiscountAllocation `json:"discountAllocations"`
 	// DiscountedTotalPrice is the total price of the line item, including discounts, and displayed in the presentment currency.
-	DiscountedTotalPrice MoneyV2 `json:"discountedTotalPrice,omitempty"`
+	DiscountedTotalPrice MoneyV2 `json:"discountedTotalPrice"`
 	// OriginalTotalPrice is the total price of the line item, not including any discounts. The total price is calculated using the original unit price multiplied by the quantity, and it is displayed in the presentment currency.
-	OriginalTotalPrice MoneyV2 `json:"originalTotalPrice,omitempty"`
+	OriginalTotalPrice MoneyV2 `json:"originalTotalPrice"`
 	// Quantity is the number of products variants associated to the line item.
-	Quantity int `json:"quantity,omitempty"`
+	Quantity int `json:"quantity"`
 	// Title is the title of the product combined with title of the variant.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// Variant is the product variant object associated to the line item.
-	Variant ProductVariant `json:"variant,omitempty"`
+	Variant *ProductVariant `json:"variant"`
 }
 
 // Fulfillment: Represents a single fulfillment in an order.
 type Fulfillment struct {
 	// FulfillmentLineItems is a list of the fulfillment's line items.
-	FulfillmentLineItems Connection[FulfillmentLineItem] `json:"fulfillmentLineItems,omitempty"`
+	FulfillmentLineItems Connection[FulfillmentLineItem] `json:"fulfillmentLineItems"`
 	// TrackingCompany is the name of the tracking company.
-	TrackingCompany string `json:"trackingCompany,omitempty"`
+	TrackingCompany *string `json:"trackingCompany"`
 	/*
 	   TrackingInfo is the tracking information associated with the fulfillment,
 	   such as the tracking number and tracking URL.
 	*/
-	TrackingInfo []FulfillmentTrackingInfo `json:"trackingInfo,omitempty"`
+	TrackingInfo []FulfillmentTrackingInfo `json:"trackingInfo"`
 }
 
 // FulfillmentLineItem: Represents a single line item in a fulfillment. There is at most one fulfillment line item for each order line item.
 type FulfillmentLineItem struct {
 	// LineItem is the associated order's line item.
-	LineItem OrderLineItem `json:"lineItem,omitempty"`
+	LineItem OrderLineItem `json:"lineItem"`
 	// Quantity is the amount fulfilled in this fulfillment.
-	Quantity int `json:"quantity,omitempty"`
+	Quantity int `json:"quantity"`
 }
 
 // FulfillmentTrackingInfo: Tracking information associated with the fulfillment.
 type FulfillmentTrackingInfo struct {
 	// Number is the tracking number of the fulfillment.
-	Number string `json:"number,omitempty"`
+	Number *string `json:"number"`
 	// URL is the URL to track the fulfillment.
-	URL string `json:"url,omitempty"`
+	URL *string `json:"url"`
 }
 
 // OrderSortKeys: The set of valid sort keys for the Order query.
 type OrderSortKeys string
 
 const (
+	// OrderSortKeysUnknown is the zero value of OrderSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	OrderSortKeysUnknown     OrderSortKeys = ""
 	OrderSortKeysProcessedAt OrderSortKeys = "PROCESSED_AT"
 	OrderSortKeysTotalPrice  OrderSortKeys = "TOTAL_PRICE"
 	OrderSortKeysId          OrderSortKeys = "ID"
 	OrderSortKeysRelevance   OrderSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of OrderSortKeys, in schema order.
+func (OrderSortKeys) Values() []OrderSortKeys {
+	return []OrderSortKeys{
+		OrderSortKeysProcessedAt,
+		OrderSortKeysTotalPrice,
+		OrderSortKeysId,
+		OrderSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e OrderSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of OrderSortKeys.
+func (e OrderSortKeys) IsValid() bool {
+	switch e {
+	case OrderSortKeysProcessedAt, OrderSortKeysTotalPrice, OrderSortKeysId, OrderSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e OrderSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// OrderSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *OrderSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // Page: Shopify merchants can create pages to hold static HTML content. Each Page object represents a custom page on the online store.
 type Page struct {
 	// Body is the description of the page, complete with HTML formatting.
-	Body string `json:"body,omitempty"`
+	Body string `json:"body"`
 	// BodySummary is the summary of the page body.
-	BodySummary string `json:"bodySummary,omitempty"`
+	BodySummary string `json:"bodySummary"`
 	// CreatedAt is the timestamp of the page creation.
-	CreatedAt time.Time `json:"createdAt,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 	// Handle is a human-friendly unique string for the page automatically generated from its title.
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	// OnlineStoreURL is the URL used for viewing the resource on the shop's Online Store. Returns `null` if the resource is currently not published to the Online Store sales channel.
-	OnlineStoreURL string `json:"onlineStoreUrl,omitempty"`
+	OnlineStoreURL *string `json:"onlineStoreUrl"`
 	// SEO is the page's SEO information.
-	SEO SEO `json:"seo,omitempty"`
+	SEO *SEO `json:"seo"`
 	// Title is the title of the page.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// UpdatedAt is the timestamp of the latest page update.
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // Shop: Shop represents a collection of the general settings and information about the shop.
 type Shop struct {
 	// Description is a description of the shop.
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description"`
 	// Metafield is a metafield found by namespace and key.
-	Metafield Metafield `json:"metafield,omitempty"`
+	Metafield *Metafield `json:"metafield"`
 	// Metafields is a paginated list of metafields associated with the resource.
-	Metafields Connection[Metafield] `json:"metafields,omitempty"`
+	Metafields Connection[Metafield] `json:"metafields"`
 	// MoneyFormat is a string representing the way currency is formatted when the currency isn’t specified.
-	MoneyFormat string `json:"moneyFormat,omitempty"`
+	MoneyFormat string `json:"moneyFormat"`
 	// Name is the shop’s name.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// PaymentSettings is the settings related to payments.
-	PaymentSettings PaymentSettings `json:"paymentSettings,omitempty"`
+	PaymentSettings PaymentSettings `json:"paymentSettings"`
 	// PrimaryDomain is the shop’s primary domain.
-	PrimaryDomain Domain `json:"primaryDomain,omitempty"`
+	PrimaryDomain Domain `json:"primaryDomain"`
 	// PrivacyPolicy is the shop’s privacy policy.
-	PrivacyPolicy ShopPolicy `json:"privacyPolicy,omitempty"`
+	PrivacyPolicy *ShopPolicy `json:"privacyPolicy"`
 	// RefundPolicy is the shop’s refund policy.
-	RefundPolicy ShopPolicy `json:"refundPolicy,omitempty"`
+	RefundPolicy *ShopPolicy `json:"refundPolicy"`
 	// ShippingPolicy is the shop’s shipping policy.
-	ShippingPolicy ShopPolicy `json:"shippingPolicy,omitempty"`
+	ShippingPolicy *ShopPolicy `json:"shippingPolicy"`
 	// ShipsToCountries is the countries that the shop ships to.
-	ShipsToCountries []string `json:"shipsToCountries,omitempty"`
+	ShipsToCountries []CountryCode `json:"shipsToCountries"`
 	// SubscriptionPolicy is the shop’s subscription policy.
-	SubscriptionPolicy ShopPolicyWithDefault `json:"subscriptionPolicy,omitempty"`
+	SubscriptionPolicy *ShopPolicyWithDefault `json:"subscriptionPolicy"`
 	// TermsOfService is the shop’s terms of service.
-	TermsOfService ShopPolicy `json:"termsOfService,omitempty"`
+	TermsOfService *ShopPolicy `json:"termsOfService"`
 }
 
 // PaymentSettings: Settings related to payments.
 type PaymentSettings struct {
 	// AcceptedCardBrands is a list of the card brands which the shop accepts.
-	AcceptedCardBrands []CardBrand `json:"acceptedCardBrands,omitempty"`
+	AcceptedCardBrands []CardBrand `json:"acceptedCardBrands"`
 	// CardVaultURL is the url pointing to the endpoint to vault credit cards.
-	CardVaultURL string `json:"cardVaultUrl,omitempty"`
+	CardVaultURL string `json:"cardVaultUrl"`
 	// CountryCode is the country where the shop is located.
-	CountryCode string `json:"countryCode,omitempty"`
+	CountryCode CountryCode `json:"countryCode"`
 	// CurrencyCode is the three-letter code for the shop's primary currency.
-	CurrencyCode string `json:"currencyCode,omitempty"`
+	CurrencyCode CurrencyCode `json:"currencyCode"`
 	// EnabledPresentmentCurrencies is a list of enabled currencies (ISO 4217 format) that the shop accepts. Merchants can enable currencies from their Shopify Payments settings in the Shopify admin.
-	EnabledPresentmentCurrencies []string `json:"enabledPresentmentCurrencies,omitempty"`
+	EnabledPresentmentCurrencies []CurrencyCode `json:"enabledPresentmentCurrencies"`
 	// ShopifyPaymentsAccountId is the shop’s Shopify Payments account id.
-	ShopifyPaymentsAccountId string `json:"shopifyPaymentsAccountId,omitempty"`
+	ShopifyPaymentsAccountId *string `json:"shopifyPaymentsAccountId"`
 	// SupportedDigitalWallets is a list of the digital wallets which the shop supports.
-	SupportedDigitalWallets []DigitalWallet `json:"supportedDigitalWallets,omitempty"`
+	SupportedDigitalWallets []DigitalWallet `json:"supportedDigitalWallets"`
 }
 
 // CardBrand: Card brand, such as Visa or Mastercard, which can be used for payments.
 type CardBrand string
 
 const (
+	// CardBrandUnknown is the zero value of CardBrand. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	CardBrandUnknown         CardBrand = ""
 	CardBrandVisa            CardBrand = "VISA"
 	CardBrandMastercard      CardBrand = "MASTERCARD"
 	CardBrandDiscover        CardBrand = "DISCOVER"
@@ -1355,38 +3021,117 @@ const (
 	CardBrandJCB             CardBrand = "JCB"
 )
 
+// Values returns all the values of CardBrand, in schema order.
+func (CardBrand) Values() []CardBrand {
+	return []CardBrand{
+		CardBrandVisa,
+		CardBrandMastercard,
+		CardBrandDiscover,
+		CardBrandAmericanExpress,
+		CardBrandDinersClub,
+		CardBrandJCB,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e CardBrand) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of CardBrand.
+func (e CardBrand) IsValid() bool {
+	switch e {
+	case CardBrandVisa, CardBrandMastercard, CardBrandDiscover, CardBrandAmericanExpress, CardBrandDinersClub, CardBrandJCB:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e CardBrand) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// CardBrandUnknown, or returning an error if StrictEnums is set.
+func (e *CardBrand) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // DigitalWallet: Digital wallet, such as Apple Pay, which can be used for accelerated checkouts.
 type DigitalWallet string
 
 const (
+	// DigitalWalletUnknown is the zero value of DigitalWallet. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	DigitalWalletUnknown    DigitalWallet = ""
 	DigitalWalletApplePay   DigitalWallet = "APPLE_PAY"
 	DigitalWalletAndroidPay DigitalWallet = "ANDROID_PAY"
 	DigitalWalletGooglePay  DigitalWallet = "GOOGLE_PAY"
 	DigitalWalletShopifyPay DigitalWallet = "SHOPIFY_PAY"
 )
 
+// Values returns all the values of DigitalWallet, in schema order.
+func (DigitalWallet) Values() []DigitalWallet {
+	return []DigitalWallet{
+		DigitalWalletApplePay,
+		DigitalWalletAndroidPay,
+		DigitalWalletGooglePay,
+		DigitalWalletShopifyPay,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e DigitalWallet) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of DigitalWallet.
+func (e DigitalWallet) IsValid() bool {
+	switch e {
+	case DigitalWalletApplePay, DigitalWalletAndroidPay, DigitalWalletGooglePay, DigitalWalletShopifyPay:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e DigitalWallet) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// DigitalWalletUnknown, or returning an error if StrictEnums is set.
+func (e *DigitalWallet) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // Domain: Represents a web address.
 type Domain struct {
 	// Host is the host name of the domain (eg: `example.com`).
-	Host string `json:"host,omitempty"`
+	Host string `json:"host"`
 	// SSLEnabled is the whether SSL is enabled or not.
-	SSLEnabled bool `json:"sslEnabled,omitempty"`
+	SSLEnabled bool `json:"sslEnabled"`
 	// URL is the URL of the domain (eg: `https://example.com`).
-	URL string `json:"url,omitempty"`
+	URL string `json:"url"`
 }
 
 // ShopPolicy: Policy that a merchant has configured for their store, such as their refund or privacy policy.
 type ShopPolicy struct {
 	// Body is the policy text, maximum size of 64kb.
-	Body string `json:"body,omitempty"`
+	Body string `json:"body"`
 	// Handle is the policy’s handle.
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Title is the policy’s title.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// URL is the public URL to the policy.
-	URL string `json:"url,omitempty"`
+	URL string `json:"url"`
 }
 
 /*
@@ -1396,103 +3141,186 @@ Otherwise, the policy will return the merchant-configured value.
 */
 type ShopPolicyWithDefault struct {
 	// Body is the text of the policy. Maximum size: 64KB.
-	Body string `json:"body,omitempty"`
+	Body string `json:"body"`
 	// Handle is the handle of the policy.
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Id is the unique identifier of the policy. A default policy doesn't have an ID.
-	Id string `json:"id,omitempty"`
+	Id *string `json:"id"`
 	// Title is the title of the policy.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// URL is the public URL to the policy.
-	URL string `json:"url,omitempty"`
+	URL string `json:"url"`
+}
+
+/*
+MetafieldReference: Returns the resource which is being referred to by a metafield.
+*/
+//
+// It's implemented by pointers to its member types: MediaImage, Page, Product, ProductVariant.
+type MetafieldReference interface {
+	isMetafieldReference()
+}
+
+func (*MediaImage) isMetafieldReference() {}
+
+func (*Page) isMetafieldReference() {}
+
+func (*Product) isMetafieldReference() {}
+
+func (*ProductVariant) isMetafieldReference() {}
+
+// unmarshalMetafieldReference decodes a MetafieldReference according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalMetafieldReference(data []byte) (MetafieldReference, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "MediaImage":
+		v := new(MediaImage)
+		return v, json.Unmarshal(data, v)
+	case "Page":
+		v := new(Page)
+		return v, json.Unmarshal(data, v)
+	case "Product":
+		v := new(Product)
+		return v, json.Unmarshal(data, v)
+	case "ProductVariant":
+		v := new(ProductVariant)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // MediaImage: Represents a Shopify hosted image.
 type MediaImage struct {
 	// Alt is a word or phrase to share the nature or contents of a media.
-	Alt string `json:"alt,omitempty"`
+	Alt *string `json:"alt"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Image is the image for the media.
-	Image Image `json:"image,omitempty"`
+	Image *Image `json:"image"`
 	// MediaContentType is the media content type.
-	MediaContentType MediaContentType `json:"mediaContentType,omitempty"`
+	MediaContentType MediaContentType `json:"mediaContentType"`
 	// PreviewImage is the preview image for the media.
-	PreviewImage Image `json:"previewImage,omitempty"`
+	PreviewImage *Image `json:"previewImage"`
 }
 
 // Comment: A comment on an article.
 type Comment struct {
 	// Author is the comment’s author.
-	Author CommentAuthor `json:"author,omitempty"`
+	Author CommentAuthor `json:"author"`
 	// Content is the stripped content of the comment, single line with HTML tags removed.
-	Content string `json:"content,omitempty"`
+	Content string `json:"content"`
 	// ContentHTML is the content of the comment, complete with HTML formatting.
-	ContentHTML string `json:"contentHtml,omitempty"`
+	ContentHTML string `json:"contentHtml"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 }
 
 // CommentAuthor: The author of a comment.
 type CommentAuthor struct {
 	// Email is the author's email.
-	Email string `json:"email,omitempty"`
+	Email string `json:"email"`
 	// Name is the author’s name.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 }
 
 // BlogSortKeys: The set of valid sort keys for the Blog query.
 type BlogSortKeys string
 
 const (
+	// BlogSortKeysUnknown is the zero value of BlogSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	BlogSortKeysUnknown   BlogSortKeys = ""
 	BlogSortKeysHandle    BlogSortKeys = "HANDLE"
 	BlogSortKeysTitle     BlogSortKeys = "TITLE"
 	BlogSortKeysId        BlogSortKeys = "ID"
 	BlogSortKeysRelevance BlogSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of BlogSortKeys, in schema order.
+func (BlogSortKeys) Values() []BlogSortKeys {
+	return []BlogSortKeys{
+		BlogSortKeysHandle,
+		BlogSortKeysTitle,
+		BlogSortKeysId,
+		BlogSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e BlogSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of BlogSortKeys.
+func (e BlogSortKeys) IsValid() bool {
+	switch e {
+	case BlogSortKeysHandle, BlogSortKeysTitle, BlogSortKeysId, BlogSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e BlogSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// BlogSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *BlogSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // Cart: A cart represents the merchandise that a buyer intends to purchase, and the estimated cost associated with the cart. To learn how to interact with a cart during a customer's session, refer to [Manage a cart with the Storefront API](https://shopify.dev/custom-storefronts/cart).
 type Cart struct {
 	// Attributes is the attributes associated with the cart. Attributes are represented as key-value pairs.
-	Attributes []Attribute `json:"attributes,omitempty"`
+	Attributes []Attribute `json:"attributes"`
 	// BuyerIdentity is the information about the buyer that is interacting with the cart.
-	BuyerIdentity CartBuyerIdentity `json:"buyerIdentity,omitempty"`
+	BuyerIdentity CartBuyerIdentity `json:"buyerIdentity"`
 	// CheckoutURL is the URL of the checkout for the cart.
-	CheckoutURL string `json:"checkoutUrl,omitempty"`
+	CheckoutURL string `json:"checkoutUrl"`
 	// CreatedAt is the date and time when the cart was created.
-	CreatedAt time.Time `json:"createdAt,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
 	// DiscountCodes is the discount codes that have been applied to the cart.
-	DiscountCodes []CartDiscountCode `json:"discountCodes,omitempty"`
+	DiscountCodes []CartDiscountCode `json:"discountCodes"`
 	// EstimatedCost is the estimated costs that the buyer will pay at checkout. The estimated costs are subject to change and changes will be reflected at checkout. The `estimatedCost` field uses the `buyerIdentity` field to determine [international pricing](https://shopify.dev/custom-storefronts/products/international-pricing#create-a-cart).
-	EstimatedCost CartEstimatedCost `json:"estimatedCost,omitempty"`
+	EstimatedCost CartEstimatedCost `json:"estimatedCost"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Lines is a list of lines containing information about the items the customer intends to purchase.
-	Lines Connection[CartLine] `json:"lines,omitempty"`
+	Lines Connection[CartLine] `json:"lines"`
 	// Note is a note that is associated with the cart. For example, the note can be a personalized message to the buyer.
-	Note string `json:"note,omitempty"`
+	Note *string `json:"note"`
 	// UpdatedAt is the date and time when the cart was updated.
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // CartBuyerIdentity: Represents information about the buyer that is interacting with the cart.
 type CartBuyerIdentity struct {
 	// CountryCode is the country where the buyer is located.
-	CountryCode string `json:"countryCode,omitempty"`
+	CountryCode *CountryCode `json:"countryCode"`
 	// Customer is the customer account associated with the cart.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// Email is the email address of the buyer that is interacting with the cart.
-	Email string `json:"email,omitempty"`
+	Email *string `json:"email"`
 	// Phone is the phone number of the buyer that is interacting with the cart.
-	Phone string `json:"phone,omitempty"`
+	Phone *string `json:"phone"`
 }
 
 // CartDiscountCode: The discount codes applied to the cart.
 type CartDiscountCode struct {
 	// Applicable is whether the discount code is applicable to the cart's current contents.
-	Applicable bool `json:"applicable,omitempty"`
+	Applicable bool `json:"applicable"`
 	// Code is the code for the discount.
-	Code string `json:"code,omitempty"`
+	Code string `json:"code"`
 }
 
 /*
@@ -1502,119 +3330,377 @@ It uses [`CartBuyerIdentity`](https://shopify.dev/api/storefront/reference/cart/
 */
 type CartEstimatedCost struct {
 	// SubtotalAmount is the estimated amount, before taxes and discounts, for the customer to pay at checkout.
-	SubtotalAmount MoneyV2 `json:"subtotalAmount,omitempty"`
+	SubtotalAmount MoneyV2 `json:"subtotalAmount"`
 	// TotalAmount is the estimated total amount for the customer to pay at checkout.
-	TotalAmount MoneyV2 `json:"totalAmount,omitempty"`
+	TotalAmount MoneyV2 `json:"totalAmount"`
 	// TotalDutyAmount is the estimated duty amount for the customer to pay at checkout.
-	TotalDutyAmount MoneyV2 `json:"totalDutyAmount,omitempty"`
+	TotalDutyAmount *MoneyV2 `json:"totalDutyAmount"`
 	// TotalTaxAmount is the estimated tax amount for the customer to pay at checkout.
-	TotalTaxAmount MoneyV2 `json:"totalTaxAmount,omitempty"`
+	TotalTaxAmount *MoneyV2 `json:"totalTaxAmount"`
 }
 
 // CartLine: Represents information about the merchandise in the cart.
 type CartLine struct {
 	// Attributes is the attributes associated with the cart line. Attributes are represented as key-value pairs.
-	Attributes []Attribute `json:"attributes,omitempty"`
+	Attributes []Attribute `json:"attributes"`
 	// DiscountAllocations is the discounts that have been applied to the cart line.
-	DiscountAllocations []CartDiscountAllocation `json:"discountAllocations,omitempty"`
+	DiscountAllocations []CartDiscountAllocation `json:"discountAllocations"`
 	// EstimatedCost is the estimated cost of the merchandise that the buyer will pay for at checkout. The estimated costs are subject to change and changes will be reflected at checkout.
-	EstimatedCost CartLineEstimatedCost `json:"estimatedCost,omitempty"`
+	EstimatedCost CartLineEstimatedCost `json:"estimatedCost"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// Merchandise is the merchandise that the buyer intends to purchase.
-	Merchandise string `json:"merchandise,omitempty"`
+	Merchandise Merchandise `json:"merchandise"`
 	// Quantity is the quantity of the merchandise that the customer intends to purchase.
-	Quantity int `json:"quantity,omitempty"`
+	Quantity int `json:"quantity"`
 	// SellingPlanAllocation is the selling plan associated with the cart line and the effect that each selling plan has on variants when they're purchased.
-	SellingPlanAllocation SellingPlanAllocation `json:"sellingPlanAllocation,omitempty"`
+	SellingPlanAllocation *SellingPlanAllocation `json:"sellingPlanAllocation"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *CartLine) UnmarshalJSON(data []byte) error {
+	type alias CartLine
+
+	var raw struct {
+		*alias
+		DiscountAllocations json.RawMessage `json:"discountAllocations"`
+		Merchandise         json.RawMessage `json:"merchandise"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstractList(raw.DiscountAllocations, &t.DiscountAllocations); err != nil {
+		return err
+	}
+	if err := unmarshalAbstract(raw.Merchandise, &t.Merchandise); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // CartDiscountAllocation: The discounts that have been applied to the cart line.
-type CartDiscountAllocation struct {
-	// DiscountedAmount is the discounted amount that has been applied to the cart line.
-	DiscountedAmount MoneyV2 `json:"discountedAmount,omitempty"`
+//
+// It's implemented by pointers to the types implementing it: CartAutomaticDiscountAllocation, CartCodeDiscountAllocation.
+type CartDiscountAllocation interface {
+	isCartDiscountAllocation()
+	// GetDiscountedAmount returns the discountedAmount field shared by the types implementing it.
+	GetDiscountedAmount() MoneyV2
+}
+
+func (*CartAutomaticDiscountAllocation) isCartDiscountAllocation() {}
+
+// GetDiscountedAmount returns the DiscountedAmount of t, implementing CartDiscountAllocation.
+func (t *CartAutomaticDiscountAllocation) GetDiscountedAmount() MoneyV2 {
+	return t.DiscountedAmount
+}
+
+func (*CartCodeDiscountAllocation) isCartDiscountAllocation() {}
+
+// GetDiscountedAmount returns the DiscountedAmount of t, implementing CartDiscountAllocation.
+func (t *CartCodeDiscountAllocation) GetDiscountedAmount() MoneyV2 {
+	return t.DiscountedAmount
+}
+
+// unmarshalCartDiscountAllocation decodes a CartDiscountAllocation according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalCartDiscountAllocation(data []byte) (CartDiscountAllocation, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "CartAutomaticDiscountAllocation":
+		v := new(CartAutomaticDiscountAllocation)
+		return v, json.Unmarshal(data, v)
+	case "CartCodeDiscountAllocation":
+		v := new(CartCodeDiscountAllocation)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // CartLineEstimatedCost: The estimated cost of the merchandise line that the buyer will pay at checkout.
 type CartLineEstimatedCost struct {
 	// SubtotalAmount is the estimated cost of the merchandise line before discounts.
-	SubtotalAmount MoneyV2 `json:"subtotalAmount,omitempty"`
+	SubtotalAmount MoneyV2 `json:"subtotalAmount"`
 	// TotalAmount is the estimated total cost of the merchandise line.
-	TotalAmount MoneyV2 `json:"totalAmount,omitempty"`
+	TotalAmount MoneyV2 `json:"totalAmount"`
+}
+
+// Merchandise: The merchandise to be purchased at checkout.
+//
+// It's implemented by pointers to its member types: ProductVariant.
+type Merchandise interface {
+	isMerchandise()
+}
+
+func (*ProductVariant) isMerchandise() {}
+
+// unmarshalMerchandise decodes a Merchandise according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalMerchandise(data []byte) (Merchandise, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "ProductVariant":
+		v := new(ProductVariant)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // CollectionSortKeys: The set of valid sort keys for the Collection query.
 type CollectionSortKeys string
 
 const (
+	// CollectionSortKeysUnknown is the zero value of CollectionSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	CollectionSortKeysUnknown   CollectionSortKeys = ""
 	CollectionSortKeysTitle     CollectionSortKeys = "TITLE"
 	CollectionSortKeysUpdatedAt CollectionSortKeys = "UPDATED_AT"
 	CollectionSortKeysId        CollectionSortKeys = "ID"
 	CollectionSortKeysRelevance CollectionSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of CollectionSortKeys, in schema order.
+func (CollectionSortKeys) Values() []CollectionSortKeys {
+	return []CollectionSortKeys{
+		CollectionSortKeysTitle,
+		CollectionSortKeysUpdatedAt,
+		CollectionSortKeysId,
+		CollectionSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e CollectionSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of CollectionSortKeys.
+func (e CollectionSortKeys) IsValid() bool {
+	switch e {
+	case CollectionSortKeysTitle, CollectionSortKeysUpdatedAt, CollectionSortKeysId, CollectionSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e CollectionSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// CollectionSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *CollectionSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // Localization: Information about the localized experiences configured for the shop.
 type Localization struct {
 	// AvailableCountries is a list of countries with enabled localized experiences.
-	AvailableCountries []Country `json:"availableCountries,omitempty"`
+	AvailableCountries []Country `json:"availableCountries"`
 	// Country is the country of the active localized experience. Use the `@inContext` directive to change this value.
-	Country Country `json:"country,omitempty"`
+	Country Country `json:"country"`
 }
 
 // Country: A country.
 type Country struct {
 	// Currency is the currency of the country.
-	Currency Currency `json:"currency,omitempty"`
+	Currency Currency `json:"currency"`
 	// IsoCode is the ISO code of the country.
-	IsoCode string `json:"isoCode,omitempty"`
+	IsoCode CountryCode `json:"isoCode"`
 	// Name is the name of the country.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// UnitSystem is the unit system used in the country.
-	UnitSystem UnitSystem `json:"unitSystem,omitempty"`
+	UnitSystem UnitSystem `json:"unitSystem"`
 }
 
 // Currency: A currency.
 type Currency struct {
 	// IsoCode is the ISO code of the currency.
-	IsoCode string `json:"isoCode,omitempty"`
+	IsoCode CurrencyCode `json:"isoCode"`
 	// Name is the name of the currency.
-	Name string `json:"name,omitempty"`
+	Name string `json:"name"`
 	// Symbol is the symbol of the currency.
-	Symbol string `json:"symbol,omitempty"`
+	Symbol string `json:"symbol"`
 }
 
 // UnitSystem: Systems of weights and measures.
 type UnitSystem string
 
 const (
+	// UnitSystemUnknown is the zero value of UnitSystem. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	UnitSystemUnknown        UnitSystem = ""
 	UnitSystemImperialSystem UnitSystem = "IMPERIAL_SYSTEM"
 	UnitSystemMetricSystem   UnitSystem = "METRIC_SYSTEM"
 )
 
+// Values returns all the values of UnitSystem, in schema order.
+func (UnitSystem) Values() []UnitSystem {
+	return []UnitSystem{
+		UnitSystemImperialSystem,
+		UnitSystemMetricSystem,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e UnitSystem) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of UnitSystem.
+func (e UnitSystem) IsValid() bool {
+	switch e {
+	case UnitSystemImperialSystem, UnitSystemMetricSystem:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e UnitSystem) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// UnitSystemUnknown, or returning an error if StrictEnums is set.
+func (e *UnitSystem) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // LocationSortKeys: The set of valid sort keys for the Location query.
 type LocationSortKeys string
 
 const (
+	// LocationSortKeysUnknown is the zero value of LocationSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	LocationSortKeysUnknown  LocationSortKeys = ""
 	LocationSortKeysId       LocationSortKeys = "ID"
 	LocationSortKeysName     LocationSortKeys = "NAME"
 	LocationSortKeysCity     LocationSortKeys = "CITY"
 	LocationSortKeysDistance LocationSortKeys = "DISTANCE"
 )
 
+// Values returns all the values of LocationSortKeys, in schema order.
+func (LocationSortKeys) Values() []LocationSortKeys {
+	return []LocationSortKeys{
+		LocationSortKeysId,
+		LocationSortKeysName,
+		LocationSortKeysCity,
+		LocationSortKeysDistance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e LocationSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of LocationSortKeys.
+func (e LocationSortKeys) IsValid() bool {
+	switch e {
+	case LocationSortKeysId, LocationSortKeysName, LocationSortKeysCity, LocationSortKeysDistance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e LocationSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// LocationSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *LocationSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+// GeoCoordinateInput: Used to specify a geographical location.
+type GeoCoordinateInput struct {
+	// Latitude is the coordinate's latitude value.
+	Latitude float64 `json:"latitude"`
+	// Longitude is the coordinate's longitude value.
+	Longitude float64 `json:"longitude"`
+}
+
 // PageSortKeys: The set of valid sort keys for the Page query.
 type PageSortKeys string
 
 const (
+	// PageSortKeysUnknown is the zero value of PageSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	PageSortKeysUnknown   PageSortKeys = ""
 	PageSortKeysTitle     PageSortKeys = "TITLE"
 	PageSortKeysUpdatedAt PageSortKeys = "UPDATED_AT"
 	PageSortKeysId        PageSortKeys = "ID"
 	PageSortKeysRelevance PageSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of PageSortKeys, in schema order.
+func (PageSortKeys) Values() []PageSortKeys {
+	return []PageSortKeys{
+		PageSortKeysTitle,
+		PageSortKeysUpdatedAt,
+		PageSortKeysId,
+		PageSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e PageSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of PageSortKeys.
+func (e PageSortKeys) IsValid() bool {
+	switch e {
+	case PageSortKeysTitle, PageSortKeysUpdatedAt, PageSortKeysId, PageSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e PageSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// PageSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *PageSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // ProductSortKeys: The set of valid sort keys for the Product query.
 type ProductSortKeys string
 
 const (
+	// ProductSortKeysUnknown is the zero value of ProductSortKeys. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	ProductSortKeysUnknown     ProductSortKeys = ""
 	ProductSortKeysTitle       ProductSortKeys = "TITLE"
 	ProductSortKeysProductType ProductSortKeys = "PRODUCT_TYPE"
 	ProductSortKeysVendor      ProductSortKeys = "VENDOR"
@@ -1626,174 +3712,304 @@ const (
 	ProductSortKeysRelevance   ProductSortKeys = "RELEVANCE"
 )
 
+// Values returns all the values of ProductSortKeys, in schema order.
+func (ProductSortKeys) Values() []ProductSortKeys {
+	return []ProductSortKeys{
+		ProductSortKeysTitle,
+		ProductSortKeysProductType,
+		ProductSortKeysVendor,
+		ProductSortKeysUpdatedAt,
+		ProductSortKeysCreatedAt,
+		ProductSortKeysBestSelling,
+		ProductSortKeysPrice,
+		ProductSortKeysId,
+		ProductSortKeysRelevance,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e ProductSortKeys) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of ProductSortKeys.
+func (e ProductSortKeys) IsValid() bool {
+	switch e {
+	case ProductSortKeysTitle, ProductSortKeysProductType, ProductSortKeysVendor, ProductSortKeysUpdatedAt, ProductSortKeysCreatedAt, ProductSortKeysBestSelling, ProductSortKeysPrice, ProductSortKeysId, ProductSortKeysRelevance:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e ProductSortKeys) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// ProductSortKeysUnknown, or returning an error if StrictEnums is set.
+func (e *ProductSortKeys) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 /*
 ApiVersion: A version of the API, as defined by [Shopify API versioning](https://shopify.dev/api/usage/versioning).
 Versions are commonly referred to by their handle (for example, `2021-10`).
 */
 type ApiVersion struct {
 	// DisplayName is the human-readable name of the version.
-	DisplayName string `json:"displayName,omitempty"`
+	DisplayName string `json:"displayName"`
 	// Handle is the unique identifier of an ApiVersion. All supported API versions have a date-based (YYYY-MM) or `unstable` handle.
-	Handle string `json:"handle,omitempty"`
+	Handle string `json:"handle"`
 	// Supported is whether the version is actively supported by Shopify. Supported API versions are guaranteed to be stable. Unsupported API versions include unstable, release candidate, and end-of-life versions that are marked as unsupported. For more information, refer to [Versioning](https://shopify.dev/api/usage/versioning).
-	Supported bool `json:"supported,omitempty"`
+	Supported bool `json:"supported"`
 }
 
 // Mutation: The schema’s entry-point for mutations. This acts as the public, top-level API from which all mutation queries must start.
 type Mutation struct {
 	// CartAttributesUpdate updates the attributes on a cart.
-	CartAttributesUpdate CartAttributesUpdatePayload `json:"cartAttributesUpdate,omitempty"`
+	CartAttributesUpdate *CartAttributesUpdatePayload `json:"cartAttributesUpdate"`
 	/*
 	   CartBuyerIdentityUpdate is the updates customer information associated with a cart.
 	   Buyer identity is used to determine
 	   [international pricing](https://shopify.dev/custom-storefronts/products/international-pricing#create-a-checkout)
 	   and should match the customer's shipping address.
 	*/
-	CartBuyerIdentityUpdate CartBuyerIdentityUpdatePayload `json:"cartBuyerIdentityUpdate,omitempty"`
+	CartBuyerIdentityUpdate *CartBuyerIdentityUpdatePayload `json:"cartBuyerIdentityUpdate"`
 	// CartCreate creates a new cart.
-	CartCreate CartCreatePayload `json:"cartCreate,omitempty"`
+	CartCreate *CartCreatePayload `json:"cartCreate"`
 	// CartDiscountCodesUpdate updates the discount codes applied to the cart.
-	CartDiscountCodesUpdate CartDiscountCodesUpdatePayload `json:"cartDiscountCodesUpdate,omitempty"`
+	CartDiscountCodesUpdate *CartDiscountCodesUpdatePayload `json:"cartDiscountCodesUpdate"`
 	// CartLinesAdd adds a merchandise line to the cart.
-	CartLinesAdd CartLinesAddPayload `json:"cartLinesAdd,omitempty"`
+	CartLinesAdd *CartLinesAddPayload `json:"cartLinesAdd"`
 	// CartLinesRemove is the removes one or more merchandise lines from the cart.
-	CartLinesRemove CartLinesRemovePayload `json:"cartLinesRemove,omitempty"`
+	CartLinesRemove *CartLinesRemovePayload `json:"cartLinesRemove"`
 	// CartLinesUpdate is the updates one or more merchandise lines on a cart.
-	CartLinesUpdate CartLinesUpdatePayload `json:"cartLinesUpdate,omitempty"`
+	CartLinesUpdate *CartLinesUpdatePayload `json:"cartLinesUpdate"`
 	// CartNoteUpdate updates the note on the cart.
-	CartNoteUpdate CartNoteUpdatePayload `json:"cartNoteUpdate,omitempty"`
+	CartNoteUpdate *CartNoteUpdatePayload `json:"cartNoteUpdate"`
 	// CheckoutAttributesUpdate updates the attributes of a checkout if `allowPartialAddresses` is `true`.
-	CheckoutAttributesUpdate CheckoutAttributesUpdatePayload `json:"checkoutAttributesUpdate,omitempty"`
+	CheckoutAttributesUpdate *CheckoutAttributesUpdatePayload `json:"checkoutAttributesUpdate"`
 	// CheckoutAttributesUpdateV2 updates the attributes of a checkout if `allowPartialAddresses` is `true`.
-	CheckoutAttributesUpdateV2 CheckoutAttributesUpdateV2Payload `json:"checkoutAttributesUpdateV2,omitempty"`
+	CheckoutAttributesUpdateV2 *CheckoutAttributesUpdateV2Payload `json:"checkoutAttributesUpdateV2"`
 	// CheckoutCompleteFree completes a checkout without providing payment information. You can use this mutation for free items or items whose purchase price is covered by a gift card.
-	CheckoutCompleteFree CheckoutCompleteFreePayload `json:"checkoutCompleteFree,omitempty"`
+	CheckoutCompleteFree *CheckoutCompleteFreePayload `json:"checkoutCompleteFree"`
 	// CheckoutCompleteWithCreditCard completes a checkout using a credit card token from Shopify's Vault.
-	CheckoutCompleteWithCreditCard CheckoutCompleteWithCreditCardPayload `json:"checkoutCompleteWithCreditCard,omitempty"`
+	CheckoutCompleteWithCreditCard *CheckoutCompleteWithCreditCardPayload `json:"checkoutCompleteWithCreditCard"`
 	// CheckoutCompleteWithCreditCardV2 completes a checkout using a credit card token from Shopify's card vault. Before you can complete checkouts using CheckoutCompleteWithCreditCardV2, you need to  [_request payment processing_](https://shopify.dev/apps/channels/getting-started#request-payment-processing).
-	CheckoutCompleteWithCreditCardV2 CheckoutCompleteWithCreditCardV2Payload `json:"checkoutCompleteWithCreditCardV2,omitempty"`
+	CheckoutCompleteWithCreditCardV2 *CheckoutCompleteWithCreditCardV2Payload `json:"checkoutCompleteWithCreditCardV2"`
 	// CheckoutCompleteWithTokenizedPayment completes a checkout with a tokenized payment.
-	CheckoutCompleteWithTokenizedPayment CheckoutCompleteWithTokenizedPaymentPayload `json:"checkoutCompleteWithTokenizedPayment,omitempty"`
+	CheckoutCompleteWithTokenizedPayment *CheckoutCompleteWithTokenizedPaymentPayload `json:"checkoutCompleteWithTokenizedPayment"`
 	// CheckoutCompleteWithTokenizedPaymentV2 completes a checkout with a tokenized payment.
-	CheckoutCompleteWithTokenizedPaymentV2 CheckoutCompleteWithTokenizedPaymentV2Payload `json:"checkoutCompleteWithTokenizedPaymentV2,omitempty"`
+	CheckoutCompleteWithTokenizedPaymentV2 *CheckoutCompleteWithTokenizedPaymentV2Payload `json:"checkoutCompleteWithTokenizedPaymentV2"`
 	// CheckoutCompleteWithTokenizedPaymentV3 completes a checkout with a tokenized payment.
-	CheckoutCompleteWithTokenizedPaymentV3 CheckoutCompleteWithTokenizedPaymentV3Payload `json:"checkoutCompleteWithTokenizedPaymentV3,omitempty"`
+	CheckoutCompleteWithTokenizedPaymentV3 *CheckoutCompleteWithTokenizedPaymentV3Payload `json:"checkoutCompleteWithTokenizedPaymentV3"`
 	// CheckoutCreate creates a new checkout.
-	CheckoutCreate CheckoutCreatePayload `json:"checkoutCreate,omitempty"`
+	CheckoutCreate *CheckoutCreatePayload `json:"checkoutCreate"`
 	// CheckoutCustomerAssociate associates a customer to the checkout.
-	CheckoutCustomerAssociate CheckoutCustomerAssociatePayload `json:"checkoutCustomerAssociate,omitempty"`
+	CheckoutCustomerAssociate *CheckoutCustomerAssociatePayload `json:"checkoutCustomerAssociate"`
 	// CheckoutCustomerAssociateV2 associates a customer to the checkout.
-	CheckoutCustomerAssociateV2 CheckoutCustomerAssociateV2Payload `json:"checkoutCustomerAssociateV2,omitempty"`
+	CheckoutCustomerAssociateV2 *CheckoutCustomerAssociateV2Payload `json:"checkoutCustomerAssociateV2"`
 	// CheckoutCustomerDisassociate disassociates the current checkout customer from the checkout.
-	CheckoutCustomerDisassociate CheckoutCustomerDisassociatePayload `json:"checkoutCustomerDisassociate,omitempty"`
+	CheckoutCustomerDisassociate *CheckoutCustomerDisassociatePayload `json:"checkoutCustomerDisassociate"`
 	// CheckoutCustomerDisassociateV2 disassociates the current checkout customer from the checkout.
-	CheckoutCustomerDisassociateV2 CheckoutCustomerDisassociateV2Payload `json:"checkoutCustomerDisassociateV2,omitempty"`
+	CheckoutCustomerDisassociateV2 *CheckoutCustomerDisassociateV2Payload `json:"checkoutCustomerDisassociateV2"`
 	// CheckoutDiscountCodeApply applies a discount to an existing checkout using a discount code.
-	CheckoutDiscountCodeApply CheckoutDiscountCodeApplyPayload `json:"checkoutDiscountCodeApply,omitempty"`
+	CheckoutDiscountCodeApply *CheckoutDiscountCodeApplyPayload `json:"checkoutDiscountCodeApply"`
 	// CheckoutDiscountCodeApplyV2 applies a discount to an existing checkout using a discount code.
-	CheckoutDiscountCodeApplyV2 CheckoutDiscountCodeApplyV2Payload `json:"checkoutDiscountCodeApplyV2,omitempty"`
+	CheckoutDiscountCodeApplyV2 *CheckoutDiscountCodeApplyV2Payload `json:"checkoutDiscountCodeApplyV2"`
 	// CheckoutDiscountCodeRemove removes the applied discount from an existing checkout.
-	CheckoutDiscountCodeRemove CheckoutDiscountCodeRemovePayload `json:"checkoutDiscountCodeRemove,omitempty"`
+	CheckoutDiscountCodeRemove *CheckoutDiscountCodeRemovePayload `json:"checkoutDiscountCodeRemove"`
 	// CheckoutEmailUpdate updates the email on an existing checkout.
-	CheckoutEmailUpdate CheckoutEmailUpdatePayload `json:"checkoutEmailUpdate,omitempty"`
+	CheckoutEmailUpdate *CheckoutEmailUpdatePayload `json:"checkoutEmailUpdate"`
 	// CheckoutEmailUpdateV2 updates the email on an existing checkout.
-	CheckoutEmailUpdateV2 CheckoutEmailUpdateV2Payload `json:"checkoutEmailUpdateV2,omitempty"`
+	CheckoutEmailUpdateV2 *CheckoutEmailUpdateV2Payload `json:"checkoutEmailUpdateV2"`
 	// CheckoutGiftCardApply applies a gift card to an existing checkout using a gift card code. This will replace all currently applied gift cards.
-	CheckoutGiftCardApply CheckoutGiftCardApplyPayload `json:"checkoutGiftCardApply,omitempty"`
+	CheckoutGiftCardApply *CheckoutGiftCardApplyPayload `json:"checkoutGiftCardApply"`
 	// CheckoutGiftCardRemove removes an applied gift card from the checkout.
-	CheckoutGiftCardRemove CheckoutGiftCardRemovePayload `json:"checkoutGiftCardRemove,omitempty"`
+	CheckoutGiftCardRemove *CheckoutGiftCardRemovePayload `json:"checkoutGiftCardRemove"`
 	// CheckoutGiftCardRemoveV2 removes an applied gift card from the checkout.
-	CheckoutGiftCardRemoveV2 CheckoutGiftCardRemoveV2Payload `json:"checkoutGiftCardRemoveV2,omitempty"`
+	CheckoutGiftCardRemoveV2 *CheckoutGiftCardRemoveV2Payload `json:"checkoutGiftCardRemoveV2"`
 	// CheckoutGiftCardsAppend is appends gift cards to an existing checkout.
-	CheckoutGiftCardsAppend CheckoutGiftCardsAppendPayload `json:"checkoutGiftCardsAppend,omitempty"`
+	CheckoutGiftCardsAppend *CheckoutGiftCardsAppendPayload `json:"checkoutGiftCardsAppend"`
 	// CheckoutLineItemsAdd adds a list of line items to a checkout.
-	CheckoutLineItemsAdd CheckoutLineItemsAddPayload `json:"checkoutLineItemsAdd,omitempty"`
+	CheckoutLineItemsAdd *CheckoutLineItemsAddPayload `json:"checkoutLineItemsAdd"`
 	// CheckoutLineItemsRemove is the removes line items from an existing checkout.
-	CheckoutLineItemsRemove CheckoutLineItemsRemovePayload `json:"checkoutLineItemsRemove,omitempty"`
+	CheckoutLineItemsRemove *CheckoutLineItemsRemovePayload `json:"checkoutLineItemsRemove"`
 	// CheckoutLineItemsReplace sets a list of line items to a checkout.
-	CheckoutLineItemsReplace CheckoutLineItemsReplacePayload `json:"checkoutLineItemsReplace,omitempty"`
+	CheckoutLineItemsReplace *CheckoutLineItemsReplacePayload `json:"checkoutLineItemsReplace"`
 	// CheckoutLineItemsUpdate is the updates line items on a checkout.
-	CheckoutLineItemsUpdate CheckoutLineItemsUpdatePayload `json:"checkoutLineItemsUpdate,omitempty"`
+	CheckoutLineItemsUpdate *CheckoutLineItemsUpdatePayload `json:"checkoutLineItemsUpdate"`
 	// CheckoutShippingAddressUpdate updates the shipping address of an existing checkout.
-	CheckoutShippingAddressUpdate CheckoutShippingAddressUpdatePayload `json:"checkoutShippingAddressUpdate,omitempty"`
+	CheckoutShippingAddressUpdate *CheckoutShippingAddressUpdatePayload `json:"checkoutShippingAddressUpdate"`
 	// CheckoutShippingAddressUpdateV2 updates the shipping address of an existing checkout.
-	CheckoutShippingAddressUpdateV2 CheckoutShippingAddressUpdateV2Payload `json:"checkoutShippingAddressUpdateV2,omitempty"`
+	CheckoutShippingAddressUpdateV2 *CheckoutShippingAddressUpdateV2Payload `json:"checkoutShippingAddressUpdateV2"`
 	// CheckoutShippingLineUpdate updates the shipping lines on an existing checkout.
-	CheckoutShippingLineUpdate CheckoutShippingLineUpdatePayload `json:"checkoutShippingLineUpdate,omitempty"`
+	CheckoutShippingLineUpdate *CheckoutShippingLineUpdatePayload `json:"checkoutShippingLineUpdate"`
 	/*
 	   CustomerAccessTokenCreate creates a customer access token.
 	   The customer access token is required to modify the customer object in any way.
 	*/
-	CustomerAccessTokenCreate CustomerAccessTokenCreatePayload `json:"customerAccessTokenCreate,omitempty"`
+	CustomerAccessTokenCreate *CustomerAccessTokenCreatePayload `json:"customerAccessTokenCreate"`
 	/*
 	   CustomerAccessTokenCreateWithMultipass creates a customer access token using a multipass token instead of email and password.
 	   A customer record is created if customer does not exist. If a customer record already
 	   exists but the record is disabled, then it's enabled.
 	*/
-	CustomerAccessTokenCreateWithMultipass CustomerAccessTokenCreateWithMultipassPayload `json:"customerAccessTokenCreateWithMultipass,omitempty"`
+	CustomerAccessTokenCreateWithMultipass *CustomerAccessTokenCreateWithMultipassPayload `json:"customerAccessTokenCreateWithMultipass"`
 	// CustomerAccessTokenDelete is the permanently destroys a customer access token.
-	CustomerAccessTokenDelete CustomerAccessTokenDeletePayload `json:"customerAccessTokenDelete,omitempty"`
+	CustomerAccessTokenDelete *CustomerAccessTokenDeletePayload `json:"customerAccessTokenDelete"`
 	/*
 	   CustomerAccessTokenRenew is the renews a customer access token.
 
 	   Access token renewal must happen *before* a token expires.
 	   If a token has already expired, a new one should be created instead via `customerAccessTokenCreate`.
 	*/
-	CustomerAccessTokenRenew CustomerAccessTokenRenewPayload `json:"customerAccessTokenRenew,omitempty"`
+	CustomerAccessTokenRenew *CustomerAccessTokenRenewPayload `json:"customerAccessTokenRenew"`
 	// CustomerActivate activates a customer.
-	CustomerActivate CustomerActivatePayload `json:"customerActivate,omitempty"`
+	CustomerActivate *CustomerActivatePayload `json:"customerActivate"`
 	// CustomerActivateByURL activates a customer with the activation url received from `customerCreate`.
-	CustomerActivateByURL CustomerActivateByURLPayload `json:"customerActivateByUrl,omitempty"`
+	CustomerActivateByURL *CustomerActivateByURLPayload `json:"customerActivateByUrl"`
 	// CustomerAddressCreate creates a new address for a customer.
-	CustomerAddressCreate CustomerAddressCreatePayload `json:"customerAddressCreate,omitempty"`
+	CustomerAddressCreate *CustomerAddressCreatePayload `json:"customerAddressCreate"`
 	// CustomerAddressDelete is the permanently deletes the address of an existing customer.
-	CustomerAddressDelete CustomerAddressDeletePayload `json:"customerAddressDelete,omitempty"`
+	CustomerAddressDelete *CustomerAddressDeletePayload `json:"customerAddressDelete"`
 	// CustomerAddressUpdate updates the address of an existing customer.
-	CustomerAddressUpdate CustomerAddressUpdatePayload `json:"customerAddressUpdate,omitempty"`
+	CustomerAddressUpdate *CustomerAddressUpdatePayload `json:"customerAddressUpdate"`
 	// CustomerCreate creates a new customer.
-	CustomerCreate CustomerCreatePayload `json:"customerCreate,omitempty"`
+	CustomerCreate *CustomerCreatePayload `json:"customerCreate"`
 	// CustomerDefaultAddressUpdate updates the default address of an existing customer.
-	CustomerDefaultAddressUpdate CustomerDefaultAddressUpdatePayload `json:"customerDefaultAddressUpdate,omitempty"`
+	CustomerDefaultAddressUpdate *CustomerDefaultAddressUpdatePayload `json:"customerDefaultAddressUpdate"`
 	// CustomerRecover sends a reset password email to the customer, as the first step in the reset password process.
-	CustomerRecover CustomerRecoverPayload `json:"customerRecover,omitempty"`
+	CustomerRecover *CustomerRecoverPayload `json:"customerRecover"`
 	// CustomerReset resets a customer’s password with a token received from `CustomerRecover`.
-	CustomerReset CustomerResetPayload `json:"customerReset,omitempty"`
+	CustomerReset *CustomerResetPayload `json:"customerReset"`
 	// CustomerResetByURL resets a customer’s password with the reset password url received from `CustomerRecover`.
-	CustomerResetByURL CustomerResetByURLPayload `json:"customerResetByUrl,omitempty"`
+	CustomerResetByURL *CustomerResetByURLPayload `json:"customerResetByUrl"`
 	// CustomerUpdate updates an existing customer.
-	CustomerUpdate CustomerUpdatePayload `json:"customerUpdate,omitempty"`
+	CustomerUpdate *CustomerUpdatePayload `json:"customerUpdate"`
+}
+
+// AttributeInput: Specifies the input fields required for an attribute.
+type AttributeInput struct {
+	// Key is the key or name of the attribute.
+	Key string `json:"key"`
+	// Value is the value of the attribute.
+	Value string `json:"value"`
 }
 
 // CartAttributesUpdatePayload: Return type for `cartAttributesUpdate` mutation.
 type CartAttributesUpdatePayload struct {
 	// Cart is the updated cart.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CartUserError `json:"userErrors,omitempty"`
+	UserErrors []CartUserError `json:"userErrors"`
 }
 
 // CartUserError: Represents an error that happens during execution of a cart mutation.
 type CartUserError struct {
 	// Code is the error code.
-	Code CartErrorCode `json:"code,omitempty"`
+	Code *CartErrorCode `json:"code"`
 	// Field is the path to the input field that caused the error.
-	Field []string `json:"field,omitempty"`
+	Field []string `json:"field"`
 	// Message is the error message.
-	Message string `json:"message,omitempty"`
+	Message string `json:"message"`
+}
+
+// DisplayableError: Represents an error in the input of a mutation.
+//
+// It's implemented by pointers to the types implementing it: CartUserError, CheckoutUserError, UserError, CustomerUserError.
+type DisplayableError interface {
+	isDisplayableError()
+	// GetField returns the field field shared by the types implementing it.
+	GetField() []string
+	// GetMessage returns the message field shared by the types implementing it.
+	GetMessage() string
+}
+
+func (*CartUserError) isDisplayableError() {}
+
+// GetField returns the Field of t, implementing DisplayableError.
+func (t *CartUserError) GetField() []string {
+	return t.Field
+}
+
+// GetMessage returns the Message of t, implementing DisplayableError.
+func (t *CartUserError) GetMessage() string {
+	return t.Message
+}
+
+func (*CheckoutUserError) isDisplayableError() {}
+
+// GetField returns the Field of t, implementing DisplayableError.
+func (t *CheckoutUserError) GetField() []string {
+	return t.Field
+}
+
+// GetMessage returns the Message of t, implementing DisplayableError.
+func (t *CheckoutUserError) GetMessage() string {
+	return t.Message
+}
+
+func (*UserError) isDisplayableError() {}
+
+// GetField returns the Field of t, implementing DisplayableError.
+func (t *UserError) GetField() []string {
+	return t.Field
+}
+
+// GetMessage returns the Message of t, implementing DisplayableError.
+func (t *UserError) GetMessage() string {
+	return t.Message
+}
+
+func (*CustomerUserError) isDisplayableError() {}
+
+// GetField returns the Field of t, implementing DisplayableError.
+func (t *CustomerUserError) GetField() []string {
+	return t.Field
+}
+
+// GetMessage returns the Message of t, implementing DisplayableError.
+func (t *CustomerUserError) GetMessage() string {
+	return t.Message
 }
 
-// DisplayableError: Represents an error in the input of a mutation.
-type DisplayableError struct {
-	// Field is the path to the input field that caused the error.
-	Field []string `json:"field,omitempty"`
-	// Message is the error message.
-	Message string `json:"message,omitempty"`
+// unmarshalDisplayableError decodes a DisplayableError according to its __typename. An unknown
+// __typename, as may be introduced by a newer API version, decodes to nil.
+func unmarshalDisplayableError(data []byte) (DisplayableError, error) {
+	typename, err := unmarshalTypename(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typename {
+	case "CartUserError":
+		v := new(CartUserError)
+		return v, json.Unmarshal(data, v)
+	case "CheckoutUserError":
+		v := new(CheckoutUserError)
+		return v, json.Unmarshal(data, v)
+	case "UserError":
+		v := new(UserError)
+		return v, json.Unmarshal(data, v)
+	case "CustomerUserError":
+		v := new(CustomerUserError)
+		return v, json.Unmarshal(data, v)
+	}
+
+	return nil, nil
 }
 
 // CartErrorCode: Possible error codes that can be returned by `CartUserError`.
 type CartErrorCode string
 
 const (
+	// CartErrorCodeUnknown is the zero value of CartErrorCode. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	CartErrorCodeUnknown                CartErrorCode = ""
 	CartErrorCodeInvalid                CartErrorCode = "INVALID"
 	CartErrorCodeLessThan               CartErrorCode = "LESS_THAN"
 	CartErrorCodeInvalidMerchandiseLine CartErrorCode = "INVALID_MERCHANDISE_LINE"
@@ -1801,86 +4017,224 @@ const (
 	CartErrorCodeMissingNote            CartErrorCode = "MISSING_NOTE"
 )
 
+// Values returns all the values of CartErrorCode, in schema order.
+func (CartErrorCode) Values() []CartErrorCode {
+	return []CartErrorCode{
+		CartErrorCodeInvalid,
+		CartErrorCodeLessThan,
+		CartErrorCodeInvalidMerchandiseLine,
+		CartErrorCodeMissingDiscountCode,
+		CartErrorCodeMissingNote,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e CartErrorCode) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of CartErrorCode.
+func (e CartErrorCode) IsValid() bool {
+	switch e {
+	case CartErrorCodeInvalid, CartErrorCodeLessThan, CartErrorCodeInvalidMerchandiseLine, CartErrorCodeMissingDiscountCode, CartErrorCodeMissingNote:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e CartErrorCode) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// CartErrorCodeUnknown, or returning an error if StrictEnums is set.
+func (e *CartErrorCode) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+/*
+CartBuyerIdentityInput: Specifies the input fields to update the buyer information associated with a cart.
+Buyer identity is used to determine
+[international pricing](https://shopify.dev/custom-storefronts/products/international-pricing#create-a-checkout)
+and should match the customer's shipping address.
+*/
+type CartBuyerIdentityInput struct {
+	// Email is the email address of the buyer that is interacting with the cart.
+	Email Optional[string] `json:"email"`
+	// Phone is the phone number of the buyer that is interacting with the cart.
+	Phone Optional[string] `json:"phone"`
+	// CountryCode is the country where the buyer is located.
+	CountryCode Optional[CountryCode] `json:"countryCode"`
+	// CustomerAccessToken is the access token used to identify the customer associated with the cart.
+	CustomerAccessToken Optional[string] `json:"customerAccessToken"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CartBuyerIdentityInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
 // CartBuyerIdentityUpdatePayload: Return type for `cartBuyerIdentityUpdate` mutation.
 type CartBuyerIdentityUpdatePayload struct {
 	// Cart is the updated cart.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CartUserError `json:"userErrors,omitempty"`
+	UserErrors []CartUserError `json:"userErrors"`
+}
+
+// CartInput: Specifies the input fields to create a cart.
+type CartInput struct {
+	// Attributes is an array of key-value pairs that contains additional information about the cart.
+	Attributes Optional[[]AttributeInput] `json:"attributes"`
+	// Lines is a list of merchandise lines to add to the cart.
+	Lines Optional[[]CartLineInput] `json:"lines"`
+	// DiscountCodes is the discount codes to apply to the cart.
+	DiscountCodes Optional[[]string] `json:"discountCodes"`
+	// Note is a note that is associated with the cart. For example, the note can be a personalized message to the buyer.
+	Note Optional[string] `json:"note"`
+	// BuyerIdentity is the customer associated with the cart. Used to determine [international pricing](https://shopify.dev/custom-storefronts/products/international-pricing#create-a-checkout). Buyer identity should match the customer's shipping address.
+	BuyerIdentity Optional[CartBuyerIdentityInput] `json:"buyerIdentity"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CartInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
+// CartLineInput: Specifies the input fields to create a merchandise line on a cart.
+type CartLineInput struct {
+	// Attributes is an array of key-value pairs that contains additional information about the merchandise line.
+	Attributes Optional[[]AttributeInput] `json:"attributes"`
+	// Quantity is the quantity of the merchandise.
+	Quantity Optional[int] `json:"quantity"`
+	// MerchandiseId is the identifier of the merchandise that the buyer intends to purchase.
+	MerchandiseId string `json:"merchandiseId"`
+	// SellingPlanId is the identifier of the selling plan that the merchandise is being purchased with.
+	SellingPlanId Optional[string] `json:"sellingPlanId"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CartLineInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CartCreatePayload: Return type for `cartCreate` mutation.
 type CartCreatePayload struct {
 	// Cart is the new cart.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CartUserError `json:"userErrors,omitempty"`
+	UserErrors []CartUserError `json:"userErrors"`
 }
 
 // CartDiscountCodesUpdatePayload: Return type for `cartDiscountCodesUpdate` mutation.
 type CartDiscountCodesUpdatePayload struct {
 	// Cart is the updated cart.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CartUserError `json:"userErrors,omitempty"`
+	UserErrors []CartUserError `json:"userErrors"`
 }
 
 // CartLinesAddPayload: Return type for `cartLinesAdd` mutation.
 type CartLinesAddPayload struct {
 	// Cart is the updated cart.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CartUserError `json:"userErrors,omitempty"`
+	UserErrors []CartUserError `json:"userErrors"`
 }
 
 // CartLinesRemovePayload: Return type for `cartLinesRemove` mutation.
 type CartLinesRemovePayload struct {
 	// Cart is the updated cart.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CartUserError `json:"userErrors,omitempty"`
+	UserErrors []CartUserError `json:"userErrors"`
+}
+
+// CartLineUpdateInput: Specifies the input fields to update a line item on a cart.
+type CartLineUpdateInput struct {
+	// Id is the identifier of the merchandise line.
+	Id string `json:"id"`
+	// Quantity is the quantity of the line item.
+	Quantity Optional[int] `json:"quantity"`
+	// MerchandiseId is the identifier of the merchandise for the line item.
+	MerchandiseId Optional[string] `json:"merchandiseId"`
+	// Attributes is an array of key-value pairs that contains additional information about the merchandise line.
+	Attributes Optional[[]AttributeInput] `json:"attributes"`
+	// SellingPlanId is the identifier of the selling plan that the merchandise is being purchased with.
+	SellingPlanId Optional[string] `json:"sellingPlanId"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CartLineUpdateInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CartLinesUpdatePayload: Return type for `cartLinesUpdate` mutation.
 type CartLinesUpdatePayload struct {
 	// Cart is the updated cart.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CartUserError `json:"userErrors,omitempty"`
+	UserErrors []CartUserError `json:"userErrors"`
 }
 
 // CartNoteUpdatePayload: Return type for `cartNoteUpdate` mutation.
 type CartNoteUpdatePayload struct {
 	// Cart is the updated cart.
-	Cart Cart `json:"cart,omitempty"`
+	Cart *Cart `json:"cart"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CartUserError `json:"userErrors,omitempty"`
+	UserErrors []CartUserError `json:"userErrors"`
+}
+
+// CheckoutAttributesUpdateInput: Specifies the fields required to update a checkout's attributes.
+type CheckoutAttributesUpdateInput struct {
+	// Note is the text of an optional note that a shop owner can attach to the checkout.
+	Note Optional[string] `json:"note"`
+	// CustomAttributes is a list of extra information that is added to the checkout.
+	CustomAttributes Optional[[]AttributeInput] `json:"customAttributes"`
+	/*
+	   AllowPartialAddresses is allows setting partial addresses on a Checkout, skipping the full validation of attributes.
+	   The required attributes are city, province, and country.
+	   Full validation of the addresses is still done at completion time. Defaults to `false` with
+	   each operation.
+	*/
+	AllowPartialAddresses Optional[bool] `json:"allowPartialAddresses"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CheckoutAttributesUpdateInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CheckoutAttributesUpdatePayload: Return type for `checkoutAttributesUpdate` mutation.
 type CheckoutAttributesUpdatePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutUserError: Represents an error that happens during execution of a checkout mutation.
 type CheckoutUserError struct {
 	// Code is the error code.
-	Code CheckoutErrorCode `json:"code,omitempty"`
+	Code *CheckoutErrorCode `json:"code"`
 	// Field is the path to the input field that caused the error.
-	Field []string `json:"field,omitempty"`
+	Field []string `json:"field"`
 	// Message is the error message.
-	Message string `json:"message,omitempty"`
+	Message string `json:"message"`
 }
 
 // CheckoutErrorCode: Possible error codes that can be returned by `CheckoutUserError`.
 type CheckoutErrorCode string
 
 const (
+	// CheckoutErrorCodeUnknown is the zero value of CheckoutErrorCode. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	CheckoutErrorCodeUnknown                                          CheckoutErrorCode = ""
 	CheckoutErrorCodeBlank                                            CheckoutErrorCode = "BLANK"
 	CheckoutErrorCodeInvalid                                          CheckoutErrorCode = "INVALID"
 	CheckoutErrorCodeTooLong                                          CheckoutErrorCode = "TOO_LONG"
@@ -1925,118 +4279,279 @@ const (
 	CheckoutErrorCodeInvalidCountryAndCurrency                        CheckoutErrorCode = "INVALID_COUNTRY_AND_CURRENCY"
 )
 
+// Values returns all the values of CheckoutErrorCode, in schema order.
+func (CheckoutErrorCode) Values() []CheckoutErrorCode {
+	return []CheckoutErrorCode{
+		CheckoutErrorCodeBlank,
+		CheckoutErrorCodeInvalid,
+		CheckoutErrorCodeTooLong,
+		CheckoutErrorCodePresent,
+		CheckoutErrorCodeLessThan,
+		CheckoutErrorCodeGreaterThanOrEqualTo,
+		CheckoutErrorCodeLessThanOrEqualTo,
+		CheckoutErrorCodeAlreadyCompleted,
+		CheckoutErrorCodeLocked,
+		CheckoutErrorCodeNotSupported,
+		CheckoutErrorCodeBadDomain,
+		CheckoutErrorCodeInvalidForCountry,
+		CheckoutErrorCodeInvalidForCountryAndProvince,
+		CheckoutErrorCodeInvalidStateInCountry,
+		CheckoutErrorCodeInvalidProvinceInCountry,
+		CheckoutErrorCodeInvalidRegionInCountry,
+		CheckoutErrorCodeShippingRateExpired,
+		CheckoutErrorCodeGiftCardUnusable,
+		CheckoutErrorCodeGiftCardDisabled,
+		CheckoutErrorCodeGiftCardCodeInvalid,
+		CheckoutErrorCodeGiftCardAlreadyApplied,
+		CheckoutErrorCodeGiftCardCurrencyMismatch,
+		CheckoutErrorCodeGiftCardExpired,
+		CheckoutErrorCodeGiftCardDepleted,
+		CheckoutErrorCodeGiftCardNotFound,
+		CheckoutErrorCodeCartDoesNotMeetDiscountRequirementsNotice,
+		CheckoutErrorCodeDiscountExpired,
+		CheckoutErrorCodeDiscountDisabled,
+		CheckoutErrorCodeDiscountLimitReached,
+		CheckoutErrorCodeDiscountNotFound,
+		CheckoutErrorCodeCustomerAlreadyUsedOncePerCustomerDiscountNotice,
+		CheckoutErrorCodeEmpty,
+		CheckoutErrorCodeNotEnoughInStock,
+		CheckoutErrorCodeMissingPaymentInput,
+		CheckoutErrorCodeTotalPriceMismatch,
+		CheckoutErrorCodeLineItemNotFound,
+		CheckoutErrorCodeUnableToApply,
+		CheckoutErrorCodeDiscountAlreadyApplied,
+		CheckoutErrorCodeThrottledDuringCheckout,
+		CheckoutErrorCodeExpiredQueueToken,
+		CheckoutErrorCodeInvalidQueueToken,
+		CheckoutErrorCodeInvalidCountryAndCurrency,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e CheckoutErrorCode) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of CheckoutErrorCode.
+func (e CheckoutErrorCode) IsValid() bool {
+	switch e {
+	case CheckoutErrorCodeBlank, CheckoutErrorCodeInvalid, CheckoutErrorCodeTooLong, CheckoutErrorCodePresent, CheckoutErrorCodeLessThan, CheckoutErrorCodeGreaterThanOrEqualTo, CheckoutErrorCodeLessThanOrEqualTo, CheckoutErrorCodeAlreadyCompleted, CheckoutErrorCodeLocked, CheckoutErrorCodeNotSupported, CheckoutErrorCodeBadDomain, CheckoutErrorCodeInvalidForCountry, CheckoutErrorCodeInvalidForCountryAndProvince, CheckoutErrorCodeInvalidStateInCountry, CheckoutErrorCodeInvalidProvinceInCountry, CheckoutErrorCodeInvalidRegionInCountry, CheckoutErrorCodeShippingRateExpired, CheckoutErrorCodeGiftCardUnusable, CheckoutErrorCodeGiftCardDisabled, CheckoutErrorCodeGiftCardCodeInvalid, CheckoutErrorCodeGiftCardAlreadyApplied, CheckoutErrorCodeGiftCardCurrencyMismatch, CheckoutErrorCodeGiftCardExpired, CheckoutErrorCodeGiftCardDepleted, CheckoutErrorCodeGiftCardNotFound, CheckoutErrorCodeCartDoesNotMeetDiscountRequirementsNotice, CheckoutErrorCodeDiscountExpired, CheckoutErrorCodeDiscountDisabled, CheckoutErrorCodeDiscountLimitReached, CheckoutErrorCodeDiscountNotFound, CheckoutErrorCodeCustomerAlreadyUsedOncePerCustomerDiscountNotice, CheckoutErrorCodeEmpty, CheckoutErrorCodeNotEnoughInStock, CheckoutErrorCodeMissingPaymentInput, CheckoutErrorCodeTotalPriceMismatch, CheckoutErrorCodeLineItemNotFound, CheckoutErrorCodeUnableToApply, CheckoutErrorCodeDiscountAlreadyApplied, CheckoutErrorCodeThrottledDuringCheckout, CheckoutErrorCodeExpiredQueueToken, CheckoutErrorCodeInvalidQueueToken, CheckoutErrorCodeInvalidCountryAndCurrency:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e CheckoutErrorCode) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// CheckoutErrorCodeUnknown, or returning an error if StrictEnums is set.
+func (e *CheckoutErrorCode) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // UserError: Represents an error in the input of a mutation.
 type UserError struct {
 	// Field is the path to the input field that caused the error.
-	Field []string `json:"field,omitempty"`
+	Field []string `json:"field"`
 	// Message is the error message.
-	Message string `json:"message,omitempty"`
+	Message string `json:"message"`
+}
+
+// CheckoutAttributesUpdateV2Input: Specifies the fields required to update a checkout's attributes.
+type CheckoutAttributesUpdateV2Input struct {
+	// Note is the text of an optional note that a shop owner can attach to the checkout.
+	Note Optional[string] `json:"note"`
+	// CustomAttributes is a list of extra information that is added to the checkout.
+	CustomAttributes Optional[[]AttributeInput] `json:"customAttributes"`
+	/*
+	   AllowPartialAddresses is allows setting partial addresses on a Checkout, skipping the full validation of attributes.
+	   The required attributes are city, province, and country.
+	   Full validation of the addresses is still done at completion time. Defaults to `false` with
+	   each operation.
+	*/
+	AllowPartialAddresses Optional[bool] `json:"allowPartialAddresses"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CheckoutAttributesUpdateV2Input) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CheckoutAttributesUpdateV2Payload: Return type for `checkoutAttributesUpdateV2` mutation.
 type CheckoutAttributesUpdateV2Payload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutCompleteFreePayload: Return type for `checkoutCompleteFree` mutation.
 type CheckoutCompleteFreePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+/*
+CreditCardPaymentInput: Specifies the fields required to complete a checkout with
+a Shopify vaulted credit card payment.
+*/
+type CreditCardPaymentInput struct {
+	// Amount is the amount of the payment.
+	Amount Decimal `json:"amount"`
+	// IdempotencyKey is a unique client generated key used to avoid duplicate charges. When a duplicate payment is found, the original is returned instead of creating a new one. For more information, refer to [Idempotent requests](https://shopify.dev/api/usage/idempotent-requests).
+	IdempotencyKey string `json:"idempotencyKey"`
+	// BillingAddress is the billing address for the payment.
+	BillingAddress MailingAddressInput `json:"billingAddress"`
+	// VaultId is the ID returned by Shopify's Card Vault.
+	VaultId string `json:"vaultId"`
+	// Test is the executes the payment in test mode if possible. Defaults to `false`.
+	Test Optional[bool] `json:"test"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CreditCardPaymentInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
+// MailingAddressInput: Specifies the fields accepted to create or update a mailing address.
+type MailingAddressInput struct {
+	/*
+	   Address1 is the first line of the address. Typically the street address or PO Box number.
+	*/
+	Address1 Optional[string] `json:"address1"`
+	/*
+	   Address2 is the second line of the address. Typically the number of the apartment, suite, or unit.
+	*/
+	Address2 Optional[string] `json:"address2"`
+	/*
+	   City is the name of the city, district, village, or town.
+	*/
+	City Optional[string] `json:"city"`
+	/*
+	   Company is the name of the customer's company or organization.
+	*/
+	Company Optional[string] `json:"company"`
+	// Country is the name of the country.
+	Country Optional[string] `json:"country"`
+	// FirstName is the first name of the customer.
+	FirstName Optional[string] `json:"firstName"`
+	// LastName is the last name of the customer.
+	LastName Optional[string] `json:"lastName"`
+	/*
+	   Phone is a unique phone number for the customer.
+
+	   Formatted using E.164 standard. For example, _+16135551111_.
+	*/
+	Phone Optional[string] `json:"phone"`
+	// Province is the region of the address, such as the province, state, or district.
+	Province Optional[string] `json:"province"`
+	// ZIP is the zip or postal code of the address.
+	ZIP Optional[string] `json:"zip"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t MailingAddressInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CheckoutCompleteWithCreditCardPayload: Return type for `checkoutCompleteWithCreditCard` mutation.
 type CheckoutCompleteWithCreditCardPayload struct {
 	// Checkout is the checkout on which the payment was applied.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// Payment is a representation of the attempted payment.
-	Payment Payment `json:"payment,omitempty"`
+	Payment *Payment `json:"payment"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // Payment: A payment applied to a checkout.
 type Payment struct {
 	// Amount is the amount of the payment.
-	Amount string `json:"amount,omitempty"`
+	Amount Decimal `json:"amount"`
 	// AmountV2 is the amount of the payment.
-	AmountV2 MoneyV2 `json:"amountV2,omitempty"`
+	AmountV2 MoneyV2 `json:"amountV2"`
 	// BillingAddress is the billing address for the payment.
-	BillingAddress MailingAddress `json:"billingAddress,omitempty"`
+	BillingAddress *MailingAddress `json:"billingAddress"`
 	// Checkout is the checkout to which the payment belongs.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CreditCard is the credit card used for the payment in the case of direct payments.
-	CreditCard CreditCard `json:"creditCard,omitempty"`
+	CreditCard *CreditCard `json:"creditCard"`
 	// ErrorMessage is a message describing a processing error during asynchronous processing.
-	ErrorMessage string `json:"errorMessage,omitempty"`
+	ErrorMessage *string `json:"errorMessage"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	/*
 	   IdempotencyKey is a client-side generated token to identify a payment and perform idempotent operations.
 	   For more information, refer to
 	   [Idempotent requests](https://shopify.dev/api/usage/idempotent-requests).
 	*/
-	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+	IdempotencyKey *string `json:"idempotencyKey"`
 	// NextActionURL is the URL where the customer needs to be redirected so they can complete the 3D Secure payment flow.
-	NextActionURL string `json:"nextActionUrl,omitempty"`
+	NextActionURL *string `json:"nextActionUrl"`
 	// Ready is the whether or not the payment is still processing asynchronously.
-	Ready bool `json:"ready,omitempty"`
+	Ready bool `json:"ready"`
 	// Test is a flag to indicate if the payment is to be done in test mode for gateways that support it.
-	Test bool `json:"test,omitempty"`
+	Test bool `json:"test"`
 	// Transaction is the actual transaction recorded by Shopify after having processed the payment with the gateway.
-	Transaction Transaction `json:"transaction,omitempty"`
+	Transaction *Transaction `json:"transaction"`
 }
 
 // CreditCard: Credit card information used for a payment.
 type CreditCard struct {
 	// Brand is the brand of the credit card.
-	Brand string `json:"brand,omitempty"`
+	Brand *string `json:"brand"`
 	// ExpiryMonth is the expiry month of the credit card.
-	ExpiryMonth int `json:"expiryMonth,omitempty"`
+	ExpiryMonth *int `json:"expiryMonth"`
 	// ExpiryYear is the expiry year of the credit card.
-	ExpiryYear int `json:"expiryYear,omitempty"`
+	ExpiryYear *int `json:"expiryYear"`
 	// FirstDigits is the credit card's BIN number.
-	FirstDigits string `json:"firstDigits,omitempty"`
+	FirstDigits *string `json:"firstDigits"`
 	// FirstName is the first name of the card holder.
-	FirstName string `json:"firstName,omitempty"`
+	FirstName *string `json:"firstName"`
 	// LastDigits is the last 4 digits of the credit card.
-	LastDigits string `json:"lastDigits,omitempty"`
+	LastDigits *string `json:"lastDigits"`
 	// LastName is the last name of the card holder.
-	LastName string `json:"lastName,omitempty"`
+	LastName *string `json:"lastName"`
 	// MaskedNumber is the masked credit card number with only the last 4 digits displayed.
-	MaskedNumber string `json:"maskedNumber,omitempty"`
+	MaskedNumber *string `json:"maskedNumber"`
 }
 
 // Transaction: An object representing exchange of money for a product or service.
 type Transaction struct {
 	// Amount is the amount of money that the transaction was for.
-	Amount string `json:"amount,omitempty"`
+	Amount Decimal `json:"amount"`
 	// AmountV2 is the amount of money that the transaction was for.
-	AmountV2 MoneyV2 `json:"amountV2,omitempty"`
+	AmountV2 MoneyV2 `json:"amountV2"`
 	// Kind is the kind of the transaction.
-	Kind TransactionKind `json:"kind,omitempty"`
+	Kind TransactionKind `json:"kind"`
 	// Status is the status of the transaction.
-	Status TransactionStatus `json:"status,omitempty"`
+	Status TransactionStatus `json:"status"`
 	// StatusV2 is the status of the transaction.
-	StatusV2 TransactionStatus `json:"statusV2,omitempty"`
+	StatusV2 *TransactionStatus `json:"statusV2"`
 	// Test is whether the transaction was done in test mode or not.
-	Test bool `json:"test,omitempty"`
+	Test bool `json:"test"`
 }
 
 // TransactionKind: The different kinds of order transactions.
 type TransactionKind string
 
 const (
+	// TransactionKindUnknown is the zero value of TransactionKind. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	TransactionKindUnknown          TransactionKind = ""
 	TransactionKindSale             TransactionKind = "SALE"
 	TransactionKindCapture          TransactionKind = "CAPTURE"
 	TransactionKindAuthorization    TransactionKind = "AUTHORIZATION"
@@ -2044,56 +4559,245 @@ const (
 	TransactionKindChange           TransactionKind = "CHANGE"
 )
 
+// Values returns all the values of TransactionKind, in schema order.
+func (TransactionKind) Values() []TransactionKind {
+	return []TransactionKind{
+		TransactionKindSale,
+		TransactionKindCapture,
+		TransactionKindAuthorization,
+		TransactionKindEmvAuthorization,
+		TransactionKindChange,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e TransactionKind) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of TransactionKind.
+func (e TransactionKind) IsValid() bool {
+	switch e {
+	case TransactionKindSale, TransactionKindCapture, TransactionKindAuthorization, TransactionKindEmvAuthorization, TransactionKindChange:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e TransactionKind) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// TransactionKindUnknown, or returning an error if StrictEnums is set.
+func (e *TransactionKind) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // TransactionStatus: Transaction statuses describe the status of a transaction.
 type TransactionStatus string
 
 const (
+	// TransactionStatusUnknown is the zero value of TransactionStatus. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	TransactionStatusUnknown TransactionStatus = ""
 	TransactionStatusPending TransactionStatus = "PENDING"
 	TransactionStatusSuccess TransactionStatus = "SUCCESS"
 	TransactionStatusFailure TransactionStatus = "FAILURE"
 	TransactionStatusError   TransactionStatus = "ERROR"
 )
 
+// Values returns all the values of TransactionStatus, in schema order.
+func (TransactionStatus) Values() []TransactionStatus {
+	return []TransactionStatus{
+		TransactionStatusPending,
+		TransactionStatusSuccess,
+		TransactionStatusFailure,
+		TransactionStatusError,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e TransactionStatus) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of TransactionStatus.
+func (e TransactionStatus) IsValid() bool {
+	switch e {
+	case TransactionStatusPending, TransactionStatusSuccess, TransactionStatusFailure, TransactionStatusError:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e TransactionStatus) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// TransactionStatusUnknown, or returning an error if StrictEnums is set.
+func (e *TransactionStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
+/*
+CreditCardPaymentInputV2: Specifies the fields required to complete a checkout with
+a Shopify vaulted credit card payment.
+*/
+type CreditCardPaymentInputV2 struct {
+	// PaymentAmount is the amount and currency of the payment.
+	PaymentAmount MoneyInput `json:"paymentAmount"`
+	// IdempotencyKey is a unique client generated key used to avoid duplicate charges. When a duplicate payment is found, the original is returned instead of creating a new one. For more information, refer to [Idempotent requests](https://shopify.dev/api/usage/idempotent-requests).
+	IdempotencyKey string `json:"idempotencyKey"`
+	// BillingAddress is the billing address for the payment.
+	BillingAddress MailingAddressInput `json:"billingAddress"`
+	// VaultId is the ID returned by Shopify's Card Vault.
+	VaultId string `json:"vaultId"`
+	// Test is the executes the payment in test mode if possible. Defaults to `false`.
+	Test Optional[bool] `json:"test"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CreditCardPaymentInputV2) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
+// MoneyInput: Specifies the fields for a monetary value with currency.
+type MoneyInput struct {
+	// Amount is the decimal money amount.
+	Amount Decimal `json:"amount"`
+	// CurrencyCode is the currency of the money.
+	CurrencyCode CurrencyCode `json:"currencyCode"`
+}
+
 // CheckoutCompleteWithCreditCardV2Payload: Return type for `checkoutCompleteWithCreditCardV2` mutation.
 type CheckoutCompleteWithCreditCardV2Payload struct {
 	// Checkout is the checkout on which the payment was applied.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// Payment is a representation of the attempted payment.
-	Payment Payment `json:"payment,omitempty"`
+	Payment *Payment `json:"payment"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+/*
+TokenizedPaymentInput: Specifies the fields required to complete a checkout with
+a tokenized payment.
+*/
+type TokenizedPaymentInput struct {
+	// Amount is the amount of the payment.
+	Amount Decimal `json:"amount"`
+	// IdempotencyKey is a unique client generated key used to avoid duplicate charges. When a duplicate payment is found, the original is returned instead of creating a new one. For more information, refer to [Idempotent requests](https://shopify.dev/api/usage/idempotent-requests).
+	IdempotencyKey string `json:"idempotencyKey"`
+	// BillingAddress is the billing address for the payment.
+	BillingAddress MailingAddressInput `json:"billingAddress"`
+	// Type is the type of payment token.
+	Type string `json:"type"`
+	// PaymentData is a simple string or JSON containing the required payment data for the tokenized payment.
+	PaymentData string `json:"paymentData"`
+	// Test is the executes the payment in test mode if possible. Defaults to `false`.
+	Test Optional[bool] `json:"test"`
+	// Identifier is the public Hash Key used for AndroidPay payments only.
+	Identifier Optional[string] `json:"identifier"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t TokenizedPaymentInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CheckoutCompleteWithTokenizedPaymentPayload: Return type for `checkoutCompleteWithTokenizedPayment` mutation.
 type CheckoutCompleteWithTokenizedPaymentPayload struct {
 	// Checkout is the checkout on which the payment was applied.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// Payment is a representation of the attempted payment.
-	Payment Payment `json:"payment,omitempty"`
+	Payment *Payment `json:"payment"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+/*
+TokenizedPaymentInputV2: Specifies the fields required to complete a checkout with
+a tokenized payment.
+*/
+type TokenizedPaymentInputV2 struct {
+	// PaymentAmount is the amount and currency of the payment.
+	PaymentAmount MoneyInput `json:"paymentAmount"`
+	// IdempotencyKey is a unique client generated key used to avoid duplicate charges. When a duplicate payment is found, the original is returned instead of creating a new one. For more information, refer to [Idempotent requests](https://shopify.dev/api/usage/idempotent-requests).
+	IdempotencyKey string `json:"idempotencyKey"`
+	// BillingAddress is the billing address for the payment.
+	BillingAddress MailingAddressInput `json:"billingAddress"`
+	// PaymentData is a simple string or JSON containing the required payment data for the tokenized payment.
+	PaymentData string `json:"paymentData"`
+	// Test is the whether to execute the payment in test mode, if possible. Test mode is not supported in production stores. Defaults to `false`.
+	Test Optional[bool] `json:"test"`
+	// Identifier is the public Hash Key used for AndroidPay payments only.
+	Identifier Optional[string] `json:"identifier"`
+	// Type is the type of payment token.
+	Type string `json:"type"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t TokenizedPaymentInputV2) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CheckoutCompleteWithTokenizedPaymentV2Payload: Return type for `checkoutCompleteWithTokenizedPaymentV2` mutation.
 type CheckoutCompleteWithTokenizedPaymentV2Payload struct {
 	// Checkout is the checkout on which the payment was applied.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// Payment is a representation of the attempted payment.
-	Payment Payment `json:"payment,omitempty"`
+	Payment *Payment `json:"payment"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+/*
+TokenizedPaymentInputV3: Specifies the fields required to complete a checkout with
+a tokenized payment.
+*/
+type TokenizedPaymentInputV3 struct {
+	// PaymentAmount is the amount and currency of the payment.
+	PaymentAmount MoneyInput `json:"paymentAmount"`
+	// IdempotencyKey is a unique client generated key used to avoid duplicate charges. When a duplicate payment is found, the original is returned instead of creating a new one. For more information, refer to [Idempotent requests](https://shopify.dev/api/usage/idempotent-requests).
+	IdempotencyKey string `json:"idempotencyKey"`
+	// BillingAddress is the billing address for the payment.
+	BillingAddress MailingAddressInput `json:"billingAddress"`
+	// PaymentData is a simple string or JSON containing the required payment data for the tokenized payment.
+	PaymentData string `json:"paymentData"`
+	// Test is the whether to execute the payment in test mode, if possible. Test mode is not supported in production stores. Defaults to `false`.
+	Test Optional[bool] `json:"test"`
+	// Identifier is the public Hash Key used for AndroidPay payments only.
+	Identifier Optional[string] `json:"identifier"`
+	// Type is the type of payment token.
+	Type PaymentTokenType `json:"type"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t TokenizedPaymentInputV3) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // PaymentTokenType: The valid values for the types of payment token.
 type PaymentTokenType string
 
 const (
+	// PaymentTokenTypeUnknown is the zero value of PaymentTokenType. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	PaymentTokenTypeUnknown          PaymentTokenType = ""
 	PaymentTokenTypeApplePay         PaymentTokenType = "APPLE_PAY"
 	PaymentTokenTypeVault            PaymentTokenType = "VAULT"
 	PaymentTokenTypeShopifyPay       PaymentTokenType = "SHOPIFY_PAY"
@@ -2101,262 +4805,387 @@ const (
 	PaymentTokenTypeStripeVaultToken PaymentTokenType = "STRIPE_VAULT_TOKEN"
 )
 
+// Values returns all the values of PaymentTokenType, in schema order.
+func (PaymentTokenType) Values() []PaymentTokenType {
+	return []PaymentTokenType{
+		PaymentTokenTypeApplePay,
+		PaymentTokenTypeVault,
+		PaymentTokenTypeShopifyPay,
+		PaymentTokenTypeGooglePay,
+		PaymentTokenTypeStripeVaultToken,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e PaymentTokenType) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of PaymentTokenType.
+func (e PaymentTokenType) IsValid() bool {
+	switch e {
+	case PaymentTokenTypeApplePay, PaymentTokenTypeVault, PaymentTokenTypeShopifyPay, PaymentTokenTypeGooglePay, PaymentTokenTypeStripeVaultToken:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e PaymentTokenType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// PaymentTokenTypeUnknown, or returning an error if StrictEnums is set.
+func (e *PaymentTokenType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // CheckoutCompleteWithTokenizedPaymentV3Payload: Return type for `checkoutCompleteWithTokenizedPaymentV3` mutation.
 type CheckoutCompleteWithTokenizedPaymentV3Payload struct {
 	// Checkout is the checkout on which the payment was applied.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// Payment is a representation of the attempted payment.
-	Payment Payment `json:"payment,omitempty"`
+	Payment *Payment `json:"payment"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+// CheckoutCreateInput: Specifies the fields required to create a checkout.
+type CheckoutCreateInput struct {
+	// Email is the email with which the customer wants to checkout.
+	Email Optional[string] `json:"email"`
+	// LineItems is a list of line item objects, each one containing information about an item in the checkout.
+	LineItems Optional[[]CheckoutLineItemInput] `json:"lineItems"`
+	// ShippingAddress is the shipping address to where the line items will be shipped.
+	ShippingAddress Optional[MailingAddressInput] `json:"shippingAddress"`
+	// Note is the text of an optional note that a shop owner can attach to the checkout.
+	Note Optional[string] `json:"note"`
+	// CustomAttributes is a list of extra information that is added to the checkout.
+	CustomAttributes Optional[[]AttributeInput] `json:"customAttributes"`
+	/*
+	   AllowPartialAddresses is allows setting partial addresses on a Checkout, skipping the full validation of attributes.
+	   The required attributes are city, province, and country.
+	   Full validation of addresses is still done at completion time. Defaults to `null`.
+	*/
+	AllowPartialAddresses Optional[bool] `json:"allowPartialAddresses"`
+	/*
+	   PresentmentCurrencyCode is the three-letter currency code of one of the shop's enabled presentment currencies.
+	   Including this field creates a checkout in the specified currency. By default, new
+	   checkouts are created in the shop's primary currency.
+	    This argument is deprecated: Use `country` field instead.
+	*/
+	PresentmentCurrencyCode Optional[CurrencyCode] `json:"presentmentCurrencyCode"`
+	// BuyerIdentity is the identity of the customer associated with the checkout.
+	BuyerIdentity Optional[CheckoutBuyerIdentityInput] `json:"buyerIdentity"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CheckoutCreateInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
+// CheckoutLineItemInput: Specifies the input fields to create a line item on a checkout.
+type CheckoutLineItemInput struct {
+	// CustomAttributes is the extra information in the form of an array of Key-Value pairs about the line item.
+	CustomAttributes Optional[[]AttributeInput] `json:"customAttributes"`
+	// Quantity is the quantity of the line item.
+	Quantity int `json:"quantity"`
+	// VariantId is the identifier of the product variant for the line item.
+	VariantId string `json:"variantId"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CheckoutLineItemInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
+}
+
+// CheckoutBuyerIdentityInput: Specifies the identity of the customer associated with the checkout.
+type CheckoutBuyerIdentityInput struct {
+	/*
+	   CountryCode is the country code of one of the shop's
+	   [enabled countries](https://help.shopify.com/en/manual/payments/shopify-payments/multi-currency/setup).
+	   For example, `CA`. Including this field creates a checkout in the specified country's currency.
+	*/
+	CountryCode CountryCode `json:"countryCode"`
 }
 
 // CheckoutCreatePayload: Return type for `checkoutCreate` mutation.
 type CheckoutCreatePayload struct {
 	// Checkout is the new checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// QueueToken is the checkout queue token. Available only to selected stores.
-	QueueToken string `json:"queueToken,omitempty"`
+	QueueToken *string `json:"queueToken"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutCustomerAssociatePayload: Return type for `checkoutCustomerAssociate` mutation.
 type CheckoutCustomerAssociatePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// Customer is the associated customer object.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutCustomerAssociateV2Payload: Return type for `checkoutCustomerAssociateV2` mutation.
 type CheckoutCustomerAssociateV2Payload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// Customer is the associated customer object.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutCustomerDisassociatePayload: Return type for `checkoutCustomerDisassociate` mutation.
 type CheckoutCustomerDisassociatePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutCustomerDisassociateV2Payload: Return type for `checkoutCustomerDisassociateV2` mutation.
 type CheckoutCustomerDisassociateV2Payload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutDiscountCodeApplyPayload: Return type for `checkoutDiscountCodeApply` mutation.
 type CheckoutDiscountCodeApplyPayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutDiscountCodeApplyV2Payload: Return type for `checkoutDiscountCodeApplyV2` mutation.
 type CheckoutDiscountCodeApplyV2Payload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutDiscountCodeRemovePayload: Return type for `checkoutDiscountCodeRemove` mutation.
 type CheckoutDiscountCodeRemovePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutEmailUpdatePayload: Return type for `checkoutEmailUpdate` mutation.
 type CheckoutEmailUpdatePayload struct {
 	// Checkout is the checkout object with the updated email.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutEmailUpdateV2Payload: Return type for `checkoutEmailUpdateV2` mutation.
 type CheckoutEmailUpdateV2Payload struct {
 	// Checkout is the checkout object with the updated email.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutGiftCardApplyPayload: Return type for `checkoutGiftCardApply` mutation.
 type CheckoutGiftCardApplyPayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutGiftCardRemovePayload: Return type for `checkoutGiftCardRemove` mutation.
 type CheckoutGiftCardRemovePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutGiftCardRemoveV2Payload: Return type for `checkoutGiftCardRemoveV2` mutation.
 type CheckoutGiftCardRemoveV2Payload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutGiftCardsAppendPayload: Return type for `checkoutGiftCardsAppend` mutation.
 type CheckoutGiftCardsAppendPayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutLineItemsAddPayload: Return type for `checkoutLineItemsAdd` mutation.
 type CheckoutLineItemsAddPayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutLineItemsRemovePayload: Return type for `checkoutLineItemsRemove` mutation.
 type CheckoutLineItemsRemovePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutLineItemsReplacePayload: Return type for `checkoutLineItemsReplace` mutation.
 type CheckoutLineItemsReplacePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []CheckoutUserError `json:"userErrors,omitempty"`
+	UserErrors []CheckoutUserError `json:"userErrors"`
+}
+
+// CheckoutLineItemUpdateInput: Specifies the input fields to update a line item on the checkout.
+type CheckoutLineItemUpdateInput struct {
+	// Id is the identifier of the line item.
+	Id Optional[string] `json:"id"`
+	// VariantId is the variant identifier of the line item.
+	VariantId Optional[string] `json:"variantId"`
+	// Quantity is the quantity of the line item.
+	Quantity Optional[int] `json:"quantity"`
+	// CustomAttributes is the extra information in the form of an array of Key-Value pairs about the line item.
+	CustomAttributes Optional[[]AttributeInput] `json:"customAttributes"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CheckoutLineItemUpdateInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CheckoutLineItemsUpdatePayload: Return type for `checkoutLineItemsUpdate` mutation.
 type CheckoutLineItemsUpdatePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutShippingAddressUpdatePayload: Return type for `checkoutShippingAddressUpdate` mutation.
 type CheckoutShippingAddressUpdatePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutShippingAddressUpdateV2Payload: Return type for `checkoutShippingAddressUpdateV2` mutation.
 type CheckoutShippingAddressUpdateV2Payload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CheckoutShippingLineUpdatePayload: Return type for `checkoutShippingLineUpdate` mutation.
 type CheckoutShippingLineUpdatePayload struct {
 	// Checkout is the updated checkout object.
-	Checkout Checkout `json:"checkout,omitempty"`
+	Checkout *Checkout `json:"checkout"`
 	// CheckoutUserErrors is the list of errors that occurred from executing the mutation.
-	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors,omitempty"`
+	CheckoutUserErrors []CheckoutUserError `json:"checkoutUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+// CustomerAccessTokenCreateInput: Specifies the input fields required to create a customer access token.
+type CustomerAccessTokenCreateInput struct {
+	// Email is the email associated to the customer.
+	Email string `json:"email"`
+	// Password is the login password to be used by the customer.
+	Password string `json:"password"`
 }
 
 // CustomerAccessTokenCreatePayload: Return type for `customerAccessTokenCreate` mutation.
 type CustomerAccessTokenCreatePayload struct {
 	// CustomerAccessToken is the newly created customer access token object.
-	CustomerAccessToken CustomerAccessToken `json:"customerAccessToken,omitempty"`
+	CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CustomerAccessToken: A CustomerAccessToken represents the unique token required to make modifications to the customer object.
 type CustomerAccessToken struct {
 	// AccessToken is the customer’s access token.
-	AccessToken string `json:"accessToken,omitempty"`
+	AccessToken string `json:"accessToken"`
 	// ExpiresAt is the date and time when the customer access token expires.
-	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt"`
 }
 
 // CustomerUserError: Represents an error that happens during execution of a customer mutation.
 type CustomerUserError struct {
 	// Code is the error code.
-	Code CustomerErrorCode `json:"code,omitempty"`
+	Code *CustomerErrorCode `json:"code"`
 	// Field is the path to the input field that caused the error.
-	Field []string `json:"field,omitempty"`
+	Field []string `json:"field"`
 	// Message is the error message.
-	Message string `json:"message,omitempty"`
+	Message string `json:"message"`
 }
 
 // CustomerErrorCode: Possible error codes that can be returned by `CustomerUserError`.
 type CustomerErrorCode string
 
 const (
+	// CustomerErrorCodeUnknown is the zero value of CustomerErrorCode. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	CustomerErrorCodeUnknown                            CustomerErrorCode = ""
 	CustomerErrorCodeBlank                              CustomerErrorCode = "BLANK"
 	CustomerErrorCodeInvalid                            CustomerErrorCode = "INVALID"
 	CustomerErrorCodeTaken                              CustomerErrorCode = "TAKEN"
@@ -2374,149 +5203,263 @@ const (
 	CustomerErrorCodeInvalidMultipassRequest            CustomerErrorCode = "INVALID_MULTIPASS_REQUEST"
 )
 
+// Values returns all the values of CustomerErrorCode, in schema order.
+func (CustomerErrorCode) Values() []CustomerErrorCode {
+	return []CustomerErrorCode{
+		CustomerErrorCodeBlank,
+		CustomerErrorCodeInvalid,
+		CustomerErrorCodeTaken,
+		CustomerErrorCodeTooLong,
+		CustomerErrorCodeTooShort,
+		CustomerErrorCodeUnidentifiedCustomer,
+		CustomerErrorCodeCustomerDisabled,
+		CustomerErrorCodePasswordStartsOrEndsWithWhitespace,
+		CustomerErrorCodeContainsHTMLTags,
+		CustomerErrorCodeContainsURL,
+		CustomerErrorCodeTokenInvalid,
+		CustomerErrorCodeAlreadyEnabled,
+		CustomerErrorCodeNotFound,
+		CustomerErrorCodeBadDomain,
+		CustomerErrorCodeInvalidMultipassRequest,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e CustomerErrorCode) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of CustomerErrorCode.
+func (e CustomerErrorCode) IsValid() bool {
+	switch e {
+	case CustomerErrorCodeBlank, CustomerErrorCodeInvalid, CustomerErrorCodeTaken, CustomerErrorCodeTooLong, CustomerErrorCodeTooShort, CustomerErrorCodeUnidentifiedCustomer, CustomerErrorCodeCustomerDisabled, CustomerErrorCodePasswordStartsOrEndsWithWhitespace, CustomerErrorCodeContainsHTMLTags, CustomerErrorCodeContainsURL, CustomerErrorCodeTokenInvalid, CustomerErrorCodeAlreadyEnabled, CustomerErrorCodeNotFound, CustomerErrorCodeBadDomain, CustomerErrorCodeInvalidMultipassRequest:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e CustomerErrorCode) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// CustomerErrorCodeUnknown, or returning an error if StrictEnums is set.
+func (e *CustomerErrorCode) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 // CustomerAccessTokenCreateWithMultipassPayload: Return type for `customerAccessTokenCreateWithMultipass` mutation.
 type CustomerAccessTokenCreateWithMultipassPayload struct {
 	// CustomerAccessToken is an access token object associated with the customer.
-	CustomerAccessToken CustomerAccessToken `json:"customerAccessToken,omitempty"`
+	CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 }
 
 // CustomerAccessTokenDeletePayload: Return type for `customerAccessTokenDelete` mutation.
 type CustomerAccessTokenDeletePayload struct {
 	// DeletedAccessToken is the destroyed access token.
-	DeletedAccessToken string `json:"deletedAccessToken,omitempty"`
+	DeletedAccessToken *string `json:"deletedAccessToken"`
 	// DeletedCustomerAccessTokenId is the iD of the destroyed customer access token.
-	DeletedCustomerAccessTokenId string `json:"deletedCustomerAccessTokenId,omitempty"`
+	DeletedCustomerAccessTokenId *string `json:"deletedCustomerAccessTokenId"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CustomerAccessTokenRenewPayload: Return type for `customerAccessTokenRenew` mutation.
 type CustomerAccessTokenRenewPayload struct {
 	// CustomerAccessToken is the renewed customer access token object.
-	CustomerAccessToken CustomerAccessToken `json:"customerAccessToken,omitempty"`
+	CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+// CustomerActivateInput: Specifies the input fields required to activate a customer.
+type CustomerActivateInput struct {
+	// ActivationToken is the activation token required to activate the customer.
+	ActivationToken string `json:"activationToken"`
+	// Password is the new password that will be set during activation.
+	Password string `json:"password"`
 }
 
 // CustomerActivatePayload: Return type for `customerActivate` mutation.
 type CustomerActivatePayload struct {
 	// Customer is the customer object.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// CustomerAccessToken is a newly created customer access token object for the customer.
-	CustomerAccessToken CustomerAccessToken `json:"customerAccessToken,omitempty"`
+	CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CustomerActivateByURLPayload: Return type for `customerActivateByUrl` mutation.
 type CustomerActivateByURLPayload struct {
 	// Customer is the customer that was activated.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// CustomerAccessToken is a new customer access token for the customer.
-	CustomerAccessToken CustomerAccessToken `json:"customerAccessToken,omitempty"`
+	CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 }
 
 // CustomerAddressCreatePayload: Return type for `customerAddressCreate` mutation.
 type CustomerAddressCreatePayload struct {
 	// CustomerAddress is the new customer address object.
-	CustomerAddress MailingAddress `json:"customerAddress,omitempty"`
+	CustomerAddress *MailingAddress `json:"customerAddress"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CustomerAddressDeletePayload: Return type for `customerAddressDelete` mutation.
 type CustomerAddressDeletePayload struct {
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// DeletedCustomerAddressId is the iD of the deleted customer address.
-	DeletedCustomerAddressId string `json:"deletedCustomerAddressId,omitempty"`
+	DeletedCustomerAddressId *string `json:"deletedCustomerAddressId"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CustomerAddressUpdatePayload: Return type for `customerAddressUpdate` mutation.
 type CustomerAddressUpdatePayload struct {
 	// CustomerAddress is the customer’s updated mailing address.
-	CustomerAddress MailingAddress `json:"customerAddress,omitempty"`
+	CustomerAddress *MailingAddress `json:"customerAddress"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+// CustomerCreateInput: The fields required to create a new customer.
+type CustomerCreateInput struct {
+	// FirstName is the customer’s first name.
+	FirstName Optional[string] `json:"firstName"`
+	// LastName is the customer’s last name.
+	LastName Optional[string] `json:"lastName"`
+	// Email is the customer’s email.
+	Email string `json:"email"`
+	/*
+	   Phone is a unique phone number for the customer.
+
+	   Formatted using E.164 standard. For example, _+16135551111_.
+	*/
+	Phone Optional[string] `json:"phone"`
+	// Password is the login password used by the customer.
+	Password string `json:"password"`
+	// AcceptsMarketing indicates whether the customer has consented to be sent marketing material via email.
+	AcceptsMarketing Optional[bool] `json:"acceptsMarketing"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CustomerCreateInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CustomerCreatePayload: Return type for `customerCreate` mutation.
 type CustomerCreatePayload struct {
 	// Customer is the created customer object.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CustomerDefaultAddressUpdatePayload: Return type for `customerDefaultAddressUpdate` mutation.
 type CustomerDefaultAddressUpdatePayload struct {
 	// Customer is the updated customer object.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CustomerRecoverPayload: Return type for `customerRecover` mutation.
 type CustomerRecoverPayload struct {
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+// CustomerResetInput: Specifies the fields required to reset a customer’s password.
+type CustomerResetInput struct {
+	// ResetToken is the reset token required to reset the customer’s password.
+	ResetToken string `json:"resetToken"`
+	// Password is the new password that will be set as part of the reset password process.
+	Password string `json:"password"`
 }
 
 // CustomerResetPayload: Return type for `customerReset` mutation.
 type CustomerResetPayload struct {
 	// Customer is the customer object which was reset.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// CustomerAccessToken is a newly created customer access token object for the customer.
-	CustomerAccessToken CustomerAccessToken `json:"customerAccessToken,omitempty"`
+	CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 // CustomerResetByURLPayload: Return type for `customerResetByUrl` mutation.
 type CustomerResetByURLPayload struct {
 	// Customer is the customer object which was reset.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	// CustomerAccessToken is a newly created customer access token object for the customer.
-	CustomerAccessToken CustomerAccessToken `json:"customerAccessToken,omitempty"`
+	CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
+}
+
+// CustomerUpdateInput: Specifies the fields required to update the Customer information.
+type CustomerUpdateInput struct {
+	// FirstName is the customer’s first name.
+	FirstName Optional[string] `json:"firstName"`
+	// LastName is the customer’s last name.
+	LastName Optional[string] `json:"lastName"`
+	// Email is the customer’s email.
+	Email Optional[string] `json:"email"`
+	/*
+	   Phone is a unique phone number for the customer.
+
+	   Formatted using E.164 standard. For example, _+16135551111_. To remove the phone number, specify `null`.
+	*/
+	Phone Optional[string] `json:"phone"`
+	// Password is the login password used by the customer.
+	Password Optional[string] `json:"password"`
+	// AcceptsMarketing indicates whether the customer has consented to be sent marketing material via email.
+	AcceptsMarketing Optional[bool] `json:"acceptsMarketing"`
+}
+
+// MarshalJSON implements json.Marshaler, omitting fields which are unset.
+func (t CustomerUpdateInput) MarshalJSON() ([]byte, error) {
+	return marshalInput(t)
 }
 
 // CustomerUpdatePayload: Return type for `customerUpdate` mutation.
 type CustomerUpdatePayload struct {
 	// Customer is the updated customer object.
-	Customer Customer `json:"customer,omitempty"`
+	Customer *Customer `json:"customer"`
 	/*
 	   CustomerAccessToken is the newly created customer access token. If the customer's password is updated, all previous access tokens
 	   (including the one used to perform this mutation) become invalid, and a new token is generated.
 	*/
-	CustomerAccessToken CustomerAccessToken `json:"customerAccessToken,omitempty"`
+	CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
 	// CustomerUserErrors is the list of errors that occurred from executing the mutation.
-	CustomerUserErrors []CustomerUserError `json:"customerUserErrors,omitempty"`
+	CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
 	// UserErrors is the list of errors that occurred from executing the mutation.
-	UserErrors []UserError `json:"userErrors,omitempty"`
+	UserErrors []UserError `json:"userErrors"`
 }
 
 /*
@@ -2524,31 +5467,53 @@ AutomaticDiscountApplication: Automatic discount applications capture the intent
 */
 type AutomaticDiscountApplication struct {
 	// AllocationMethod is the method by which the discount's value is allocated to its entitled items.
-	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod,omitempty"`
+	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod"`
 	// TargetSelection is the which lines of targetType that the discount is allocated over.
-	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection,omitempty"`
+	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection"`
 	// TargetType is the type of line that the discount is applicable towards.
-	TargetType DiscountApplicationTargetType `json:"targetType,omitempty"`
+	TargetType DiscountApplicationTargetType `json:"targetType"`
 	// Title is the title of the application.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// Value is the value of the discount application.
-	Value string `json:"value,omitempty"`
+	Value PricingValue `json:"value"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *AutomaticDiscountApplication) UnmarshalJSON(data []byte) error {
+	type alias AutomaticDiscountApplication
+
+	var raw struct {
+		*alias
+		Value json.RawMessage `json:"value"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstract(raw.Value, &t.Value); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // CartAutomaticDiscountAllocation: The discounts automatically applied to the cart line based on prerequisites that have been met.
 type CartAutomaticDiscountAllocation struct {
 	// DiscountedAmount is the discounted amount that has been applied to the cart line.
-	DiscountedAmount MoneyV2 `json:"discountedAmount,omitempty"`
+	DiscountedAmount MoneyV2 `json:"discountedAmount"`
 	// Title is the title of the allocated discount.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 }
 
 // CartCodeDiscountAllocation: The discount that has been applied to the cart line using a discount code.
 type CartCodeDiscountAllocation struct {
 	// Code is the code used to apply the discount.
-	Code string `json:"code,omitempty"`
+	Code string `json:"code"`
 	// DiscountedAmount is the discounted amount that has been applied to the cart line.
-	DiscountedAmount MoneyV2 `json:"discountedAmount,omitempty"`
+	DiscountedAmount MoneyV2 `json:"discountedAmount"`
 }
 
 /*
@@ -2557,85 +5522,167 @@ the time that it is applied.
 */
 type DiscountCodeApplication struct {
 	// AllocationMethod is the method by which the discount's value is allocated to its entitled items.
-	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod,omitempty"`
+	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod"`
 	// Applicable is the specifies whether the discount code was applied successfully.
-	Applicable bool `json:"applicable,omitempty"`
+	Applicable bool `json:"applicable"`
 	// Code is the string identifying the discount code that was used at the time of application.
-	Code string `json:"code,omitempty"`
+	Code string `json:"code"`
 	// TargetSelection is the which lines of targetType that the discount is allocated over.
-	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection,omitempty"`
+	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection"`
 	// TargetType is the type of line that the discount is applicable towards.
-	TargetType DiscountApplicationTargetType `json:"targetType,omitempty"`
+	TargetType DiscountApplicationTargetType `json:"targetType"`
 	// Value is the value of the discount application.
-	Value string `json:"value,omitempty"`
+	Value PricingValue `json:"value"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *DiscountCodeApplication) UnmarshalJSON(data []byte) error {
+	type alias DiscountCodeApplication
+
+	var raw struct {
+		*alias
+		Value json.RawMessage `json:"value"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstract(raw.Value, &t.Value); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // ExternalVideo: Represents a video hosted outside of Shopify.
 type ExternalVideo struct {
 	// Alt is a word or phrase to share the nature or contents of a media.
-	Alt string `json:"alt,omitempty"`
+	Alt *string `json:"alt"`
 	// EmbeddedURL is the URL.
-	EmbeddedURL string `json:"embeddedUrl,omitempty"`
+	EmbeddedURL string `json:"embeddedUrl"`
 	// Host is the host of the external video.
-	Host MediaHost `json:"host,omitempty"`
+	Host MediaHost `json:"host"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// MediaContentType is the media content type.
-	MediaContentType MediaContentType `json:"mediaContentType,omitempty"`
+	MediaContentType MediaContentType `json:"mediaContentType"`
 	// PreviewImage is the preview image for the media.
-	PreviewImage Image `json:"previewImage,omitempty"`
+	PreviewImage *Image `json:"previewImage"`
 }
 
 // MediaHost: Host for a Media Resource.
 type MediaHost string
 
 const (
+	// MediaHostUnknown is the zero value of MediaHost. Values unknown to
+	// this package are decoded as it, unless StrictEnums is set.
+	MediaHostUnknown MediaHost = ""
 	MediaHostYouTube MediaHost = "YOUTUBE"
 	MediaHostVimeo   MediaHost = "VIMEO"
 )
 
+// Values returns all the values of MediaHost, in schema order.
+func (MediaHost) Values() []MediaHost {
+	return []MediaHost{
+		MediaHostYouTube,
+		MediaHostVimeo,
+	}
+}
+
+// String implements fmt.Stringer.
+func (e MediaHost) String() string {
+	return string(e)
+}
+
+// IsValid reports whether e is one of the values of MediaHost.
+func (e MediaHost) IsValid() bool {
+	switch e {
+	case MediaHostYouTube, MediaHostVimeo:
+		return true
+	}
+
+	return false
+}
+
+// MarshalJSON implements json.Marshaler. The Unknown sentinel is encoded as
+// null; invalid values are rejected when sent as variables.
+func (e MediaHost) MarshalJSON() ([]byte, error) {
+	return marshalEnum(e)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding unknown values as
+// MediaHostUnknown, or returning an error if StrictEnums is set.
+func (e *MediaHost) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, e)
+}
+
 /*
 ManualDiscountApplication: Manual discount applications capture the intentions of a discount that was manually created.
 */
 type ManualDiscountApplication struct {
 	// AllocationMethod is the method by which the discount's value is allocated to its entitled items.
-	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod,omitempty"`
+	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod"`
 	// Description is the description of the application.
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description"`
 	// TargetSelection is the which lines of targetType that the discount is allocated over.
-	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection,omitempty"`
+	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection"`
 	// TargetType is the type of line that the discount is applicable towards.
-	TargetType DiscountApplicationTargetType `json:"targetType,omitempty"`
+	TargetType DiscountApplicationTargetType `json:"targetType"`
 	// Title is the title of the application.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// Value is the value of the discount application.
-	Value string `json:"value,omitempty"`
+	Value PricingValue `json:"value"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *ManualDiscountApplication) UnmarshalJSON(data []byte) error {
+	type alias ManualDiscountApplication
+
+	var raw struct {
+		*alias
+		Value json.RawMessage `json:"value"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstract(raw.Value, &t.Value); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // Model3D: Represents a Shopify hosted 3D model.
 type Model3D struct {
 	// Alt is a word or phrase to share the nature or contents of a media.
-	Alt string `json:"alt,omitempty"`
+	Alt *string `json:"alt"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// MediaContentType is the media content type.
-	MediaContentType MediaContentType `json:"mediaContentType,omitempty"`
+	MediaContentType MediaContentType `json:"mediaContentType"`
 	// PreviewImage is the preview image for the media.
-	PreviewImage Image `json:"previewImage,omitempty"`
+	PreviewImage *Image `json:"previewImage"`
 	// Sources is the sources for a 3d model.
-	Sources []Model3DSource `json:"sources,omitempty"`
+	Sources []Model3DSource `json:"sources"`
 }
 
 // Model3DSource: Represents a source for a Shopify hosted 3d model.
 type Model3DSource struct {
 	// Filesize is the filesize of the 3d model.
-	Filesize int `json:"filesize,omitempty"`
+	Filesize int `json:"filesize"`
 	// Format is the format of the 3d model.
-	Format string `json:"format,omitempty"`
+	Format string `json:"format"`
 	// MimeType is the MIME type of the 3d model.
-	MimeType string `json:"mimeType,omitempty"`
+	MimeType string `json:"mimeType"`
 	// URL is the URL of the 3d model.
-	URL string `json:"url,omitempty"`
+	URL string `json:"url"`
 }
 
 /*
@@ -2644,41 +5691,78 @@ was created by a Shopify Script.
 */
 type ScriptDiscountApplication struct {
 	// AllocationMethod is the method by which the discount's value is allocated to its entitled items.
-	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod,omitempty"`
+	AllocationMethod DiscountApplicationAllocationMethod `json:"allocationMethod"`
 	// TargetSelection is the which lines of targetType that the discount is allocated over.
-	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection,omitempty"`
+	TargetSelection DiscountApplicationTargetSelection `json:"targetSelection"`
 	// TargetType is the type of line that the discount is applicable towards.
-	TargetType DiscountApplicationTargetType `json:"targetType,omitempty"`
+	TargetType DiscountApplicationTargetType `json:"targetType"`
 	// Title is the title of the application as defined by the Script.
-	Title string `json:"title,omitempty"`
+	Title string `json:"title"`
 	// Value is the value of the discount application.
-	Value string `json:"value,omitempty"`
+	Value PricingValue `json:"value"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding fields of abstract types
+// according to their __typename.
+func (t *ScriptDiscountApplication) UnmarshalJSON(data []byte) error {
+	type alias ScriptDiscountApplication
+
+	var raw struct {
+		*alias
+		Value json.RawMessage `json:"value"`
+	}
+	raw.alias = (*alias)(t)
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if err := unmarshalAbstract(raw.Value, &t.Value); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // Video: Represents a Shopify hosted video.
 type Video struct {
 	// Alt is a word or phrase to share the nature or contents of a media.
-	Alt string `json:"alt,omitempty"`
+	Alt *string `json:"alt"`
 	// Id is a globally-unique identifier.
-	Id string `json:"id,omitempty"`
+	Id string `json:"id"`
 	// MediaContentType is the media content type.
-	MediaContentType MediaContentType `json:"mediaContentType,omitempty"`
+	MediaContentType MediaContentType `json:"mediaContentType"`
 	// PreviewImage is the preview image for the media.
-	PreviewImage Image `json:"previewImage,omitempty"`
+	PreviewImage *Image `json:"previewImage"`
 	// Sources is the sources for a video.
-	Sources []VideoSource `json:"sources,omitempty"`
+	Sources []VideoSource `json:"sources"`
 }
 
 // VideoSource: Represents a source for a Shopify hosted video.
 type VideoSource struct {
 	// Format is the format of the video source.
-	Format string `json:"format,omitempty"`
+	Format string `json:"format"`
 	// Height is the height of the video.
-	Height int `json:"height,omitempty"`
+	Height int `json:"height"`
 	// MimeType is the video MIME type.
-	MimeType string `json:"mimeType,omitempty"`
+	MimeType string `json:"mimeType"`
 	// URL is the URL of the video.
-	URL string `json:"url,omitempty"`
+	URL string `json:"url"`
 	// Width is the width of the video.
-	Width int `json:"width,omitempty"`
+	Width int `json:"width"`
+}
+
+func init() {
+	registerAbstract(unmarshalNode)
+	registerAbstract(unmarshalHasMetafields)
+	registerAbstract(unmarshalMetafieldParentResource)
+	registerAbstract(unmarshalOnlineStorePublishable)
+	registerAbstract(unmarshalMedia)
+	registerAbstract(unmarshalSellingPlanPriceAdjustmentValue)
+	registerAbstract(unmarshalDiscountApplication)
+	registerAbstract(unmarshalPricingValue)
+	registerAbstract(unmarshalMetafieldReference)
+	registerAbstract(unmarshalCartDiscountAllocation)
+	registerAbstract(unmarshalMerchandise)
+	registerAbstract(unmarshalDisplayableError)
 }
@@ -0,0 +1,132 @@
+package storefront
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/boatilus/storefront-go/internal/graphql"
+)
+
+var (
+	// ErrInvalidQuery matches the QueryErrors returned by Validate for queries
+	// which aren't valid against the schema.
+	ErrInvalidQuery = errors.New("storefront: invalid query")
+	// ErrNoSchema indicates that the package was generated without embedding
+	// the schema, so queries can't be validated.
+	ErrNoSchema = errors.New("storefront: no schema embedded")
+)
+
+// QueryError describes a problem with a query document at a position within
+// it, as found by Validate.
+type QueryError struct {
+	// Message is a description of the problem.
+	Message string
+	// Location is the position in the query document of the problem.
+	Location ErrorLocation
+}
+
+// Error implements the error interface.
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("storefront: %d:%d: %s", e.Location.Line, e.Location.Column, e.Message)
+}
+
+// QueryErrors describes all the problems with a query document found by
+// Validate.
+type QueryErrors []*QueryError
+
+// Error implements the error interface.
+func (errs QueryErrors) Error() string {
+	return joinErrors(errs)
+}
+
+// Is reports whether target is ErrInvalidQuery, which the QueryErrors returned
+// by Validate match.
+func (errs QueryErrors) Is(target error) bool {
+	return target == ErrInvalidQuery
+}
+
+var (
+	schemaOnce sync.Once
+	schema     *graphql.Schema
+	schemaErr  error
+)
+
+// loadSchema parses the embedded schema the first time it's called.
+func loadSchema() (*graphql.Schema, error) {
+	schemaOnce.Do(func() {
+		if schemaSDL == "" {
+			schemaErr = ErrNoSchema
+			return
+		}
+
+		schema, schemaErr = graphql.ParseSDL(schemaSDL)
+	})
+
+	return schema, schemaErr
+}
+
+// Validate parses query and validates it against the schema of SchemaVersion,
+// which is embedded in the package, without contacting the API. Syntax
+// errors, unknown fields and types, arguments of the wrong type, missing
+// required arguments, impossible fragments and misused variables are returned
+// as QueryErrors, positioned by line and column.
+func Validate(query string) error {
+	_, err := ValidateWithWarnings(query)
+	return err
+}
+
+// ValidateWithWarnings is as Validate, additionally returning warnings of any
+// uses of deprecated fields and enum values, which don't make a query invalid.
+func ValidateWithWarnings(query string) (warnings QueryErrors, err error) {
+	s, err := loadSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := parseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return validateDocument(s, doc)
+}
+
+// parseQuery parses query, returning any syntax error as QueryErrors.
+func parseQuery(query string) (*graphql.Document, error) {
+	doc, err := graphql.ParseQuery(query)
+	if err != nil {
+		var se *graphql.SyntaxError
+		if errors.As(err, &se) {
+			return nil, QueryErrors{newQueryError(se.Pos, "syntax error: "+se.Msg)}
+		}
+
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// validateDocument validates doc against s, as ValidateWithWarnings does.
+func validateDocument(s *graphql.Schema, doc *graphql.Document) (warnings QueryErrors, err error) {
+	ws, err := graphql.Validate(s, doc)
+	for _, w := range ws {
+		warnings = append(warnings, newQueryError(w.Pos, w.Msg))
+	}
+
+	if err != nil {
+		var errs QueryErrors
+		for _, e := range err.(graphql.ValidationErrors) {
+			errs = append(errs, newQueryError(e.Pos, e.Msg))
+		}
+
+		return warnings, errs
+	}
+
+	return warnings, nil
+}
+
+// newQueryError returns a QueryError describing a problem at pos.
+func newQueryError(pos graphql.Position, msg string) *QueryError {
+	return &QueryError{Message: msg, Location: ErrorLocation{Line: pos.Line, Column: pos.Column}}
+}
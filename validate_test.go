@@ -0,0 +1,111 @@
+package storefront
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/boatilus/storefront-go/internal/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("Valid", func(t *testing.T) {
+		for _, filename := range []string{"test/collections_query.graphql", "test/shop_query.graphql"} {
+			q, err := LoadQuery(filename)
+			if assert.NoError(err) {
+				assert.NoError(Validate(q), filename)
+			}
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		err := Validate(`query ($handle: String) {
+  shop { nme }
+  collectionByHandle(handle: $handle) { title }
+  productByHandle { title }
+  products(first: 1, sortKey: BEST) { edges { node { id } } }
+}`)
+
+		assert.True(errors.Is(err, ErrInvalidQuery))
+
+		var errs QueryErrors
+		if assert.True(errors.As(err, &errs)) && assert.Len(errs, 4) {
+			assert.Equal(`Cannot query field "nme" on type "Shop".`, errs[0].Message)
+			assert.Equal(ErrorLocation{Line: 2, Column: 10}, errs[0].Location)
+
+			assert.Equal(`Argument "handle" of type "String!" is required on field "QueryRoot.productByHandle", but it was not provided.`, errs[1].Message)
+			assert.Equal(ErrorLocation{Line: 4, Column: 3}, errs[1].Location)
+
+			assert.Equal(`Invalid value BEST for argument "sortKey" of field "QueryRoot.products": BEST isn't a value of enum ProductSortKeys.`, errs[2].Message)
+
+			assert.Equal(`Variable "$handle" of type "String" used in position expecting type "String!".`, errs[3].Message)
+			assert.Equal(ErrorLocation{Line: 3, Column: 3}, errs[3].Location)
+		}
+	})
+
+	t.Run("IntRange", func(t *testing.T) {
+		assert.NoError(Validate(`{ products(first: 2147483647) { edges { cursor } } }`))
+
+		err := Validate(`{ products(first: 2147483648) { edges { cursor } } }`)
+		assert.True(errors.Is(err, ErrInvalidQuery))
+		assert.EqualError(err, `storefront: 1:3: Invalid value 2147483648 for argument "first" of field "QueryRoot.products": 2147483648 is out of range for Int.`)
+	})
+
+	t.Run("Overlapping", func(t *testing.T) {
+		assert.NoError(Validate(`{
+  node(id: "gid://shopify/Product/1") { ... on Product { x: title } ... on Collection { x: handle } }
+}`))
+
+		err := Validate(`{
+  node(id: "gid://shopify/Product/1") { ... on Product { x: title } ... on Collection { x: image { url } } }
+}`)
+
+		var errs QueryErrors
+		if assert.True(errors.As(err, &errs)) && assert.Len(errs, 1) {
+			assert.Equal(`Fields "x" conflict because they return conflicting types "String!" and "Image". Use different aliases on the fields to fetch both if this was intentional.`, errs[0].Message)
+			assert.Equal(ErrorLocation{Line: 2, Column: 58}, errs[0].Location)
+		}
+	})
+
+	t.Run("Introspection", func(t *testing.T) {
+		assert.NoError(Validate(graphql.IntrospectionQuery))
+	})
+
+	t.Run("SyntaxError", func(t *testing.T) {
+		err := Validate("{ shop { name }")
+
+		assert.True(errors.Is(err, ErrInvalidQuery))
+		assert.EqualError(err, `storefront: 1:16: syntax error: expected name, found end of document`)
+	})
+
+	t.Run("Fragments", func(t *testing.T) {
+		err := Validate(`{
+  node(id: "gid://shopify/Product/1") { ...Product ...Shop }
+}
+
+fragment Product on Product { title }
+fragment Shop on Shop { name }`)
+
+		var errs QueryErrors
+		if assert.True(errors.As(err, &errs)) && assert.Len(errs, 1) {
+			assert.Equal(`Fragment "Shop" cannot be spread here as objects of type "Node" can never be of type "Shop".`, errs[0].Message)
+			assert.Equal(ErrorLocation{Line: 2, Column: 52}, errs[0].Location)
+		}
+	})
+
+	t.Run("Deprecated", func(t *testing.T) {
+		warnings, err := ValidateWithWarnings(`{
+  product(handle: "x") { variants(first: 1) { edges { node { price } } } }
+}`)
+
+		assert.NoError(err)
+		if assert.Len(warnings, 1) {
+			assert.Equal(&QueryError{
+				Message:  "The field \"ProductVariant.price\" is deprecated. Use `priceV2` instead",
+				Location: ErrorLocation{Line: 2, Column: 62},
+			}, warnings[0])
+		}
+	})
+}